@@ -0,0 +1,21 @@
+#!/bin/sh
+# SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+# Compiles the protobuf files of api in to the Go packages of the repository; the onos-api
+# files they import are taken from the onos-api release listed in go.mod
+
+set -e
+
+onos_api_version=$(go list -m -f '{{ .Version }}' github.com/onosproject/onos-api/go)
+onos_api=build/_output/onos-api
+rm -rf ${onos_api}
+git clone --quiet --depth 1 --branch go/${onos_api_version} https://github.com/onosproject/onos-api.git ${onos_api}
+
+proto_imports="api:${onos_api}/api:${GOPATH}/src/github.com/gogo/protobuf:${GOPATH}/src/github.com/gogo/protobuf/protobuf"
+go_import_paths="Monos/config/v2/value.proto=github.com/onosproject/onos-api/go/onos/config/v2"
+
+protoc -I=${proto_imports} --gogofaster_out=${go_import_paths},plugins=grpc:build/_output \
+    api/onos/config/validation/delta.proto
+cp build/_output/github.com/onosproject/config-models/pkg/validation/delta.pb.go pkg/validation/
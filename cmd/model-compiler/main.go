@@ -5,9 +5,15 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/compat"
 	"github.com/onosproject/config-models/pkg/compiler"
 	"github.com/spf13/cobra"
 	"os"
+	"os/signal"
+	"runtime"
+	"strings"
 )
 
 const (
@@ -22,17 +28,332 @@ func main() {
 }
 
 func getCmd() *cobra.Command {
+	var check, force bool
+	var only, skip, searchPaths []string
+	var workspace, report, reportFile string
+	var jobs int
 	cmd := &cobra.Command{
-		Use:   "model-compiler",
-		Short: "Compiles the specified config model",
+		Use:   "model-compiler [model-dir...]",
+		Short: "Compiles the specified config models",
+		Long: "Compiles the specified config models; the model directories may be glob patterns, and are " +
+			"compiled concurrently along with the ones listed in the workspace file, if any",
+		Args: modelDirArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := compiler.NewCompiler()
+			c.SelectStages(only, skip)
+			c.SetSearchPaths(searchPaths)
+			c.Force(force)
+			if report != "" && report != "json" {
+				return fmt.Errorf("unknown report format %s", report)
+			}
+
+			if workspace == "" && len(args) <= 1 && !strings.ContainsAny(strings.Join(args, ""), "*?[") {
+				path := defaultModelPath
+				if len(args) > 0 {
+					path = args[0]
+				}
+				var err error
+				if check {
+					err = c.Check(path, os.Stdout)
+				} else {
+					err = c.Compile(path)
+				}
+				if report != "" {
+					builds := make([]*compiler.BuildReport, 0, 1)
+					if c.Report() != nil {
+						builds = append(builds, c.Report())
+					}
+					if reportErr := writeBuildReports(reportFile, builds); reportErr != nil && err == nil {
+						err = reportErr
+					}
+				}
+				return err
+			}
+
+			paths, err := compiler.ExpandModelPaths(args)
+			if err != nil {
+				return err
+			}
+			if workspace != "" {
+				workspacePaths, err := compiler.LoadWorkspace(workspace)
+				if err != nil {
+					return err
+				}
+				paths, err = compiler.ExpandModelPaths(append(paths, workspacePaths...))
+				if err != nil {
+					return err
+				}
+			}
+			reports := c.CompileModels(paths, jobs, check, os.Stdout)
+			if report != "" {
+				builds := make([]*compiler.BuildReport, 0, len(reports))
+				for _, r := range reports {
+					if r.Build != nil {
+						builds = append(builds, r.Build)
+					}
+				}
+				if err := writeBuildReports(reportFile, builds); err != nil {
+					return err
+				}
+			}
+			return compiler.WriteModelReports(os.Stdout, reports)
+		},
+	}
+	cmd.Flags().BoolVar(&check, "check", false, "compile in to a temporary directory and fail if the committed artifacts differ")
+	cmd.Flags().BoolVar(&force, "force", false, "run all the enabled stages, even when their inputs did not change")
+	cmd.Flags().StringSliceVar(&only, "only", nil, fmt.Sprintf("run only the given stages and the ones they depend on (%s)", strings.Join(compiler.StageNames(), ", ")))
+	cmd.Flags().StringSliceVar(&skip, "skip", nil, "do not run the given stages")
+	cmd.Flags().StringVarP(&workspace, "workspace", "w", "", "workspace file listing the model directories to compile")
+	cmd.Flags().IntVarP(&jobs, "jobs", "j", runtime.NumCPU(), "maximum number of models compiled at the same time")
+	cmd.Flags().StringSliceVarP(&searchPaths, "search-path", "I", nil, "directories searched for the imported YANG modules missing from the model")
+	cmd.Flags().StringVar(&report, "report", "", "write a build report with the diagnostics, inputs, outputs and timings of each model, in the given format (json)")
+	cmd.Flags().StringVar(&reportFile, "report-file", "model-compiler-report.json", "file the build report is written to, - for the standard output")
+	cmd.AddCommand(getInitCmd())
+	cmd.AddCommand(getMigrateMetaDataCmd())
+	cmd.AddCommand(getMetaDataSchemaCmd())
+	cmd.AddCommand(getVersionCheckCmd())
+	cmd.AddCommand(getCompatCmd())
+	cmd.AddCommand(getPackageCmd())
+	cmd.AddCommand(getVerifyPackageCmd())
+	cmd.AddCommand(getWatchCmd())
+	return cmd
+}
+
+// modelDirArgs checks that the arguments of the root command are model directories, or glob
+// patterns, rather than mistyped sub-commands, which cobra would otherwise report as unknown
+// commands had the root command taken no arguments
+func modelDirArgs(cmd *cobra.Command, args []string) error {
+	for _, arg := range args {
+		if strings.ContainsAny(arg, "*?[") {
+			continue
+		}
+		if _, err := os.Stat(arg); err == nil {
+			continue
+		}
+		if strings.ContainsRune(arg, os.PathSeparator) {
+			return fmt.Errorf("model directory %s not found", arg)
+		}
+		msg := fmt.Sprintf("unknown command %q for %q", arg, cmd.CommandPath())
+		if suggestions := cmd.SuggestionsFor(arg); len(suggestions) > 0 {
+			msg += "\n\nDid you mean this?\n\t" + strings.Join(suggestions, "\n\t")
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
+
+// writeBuildReports writes the build reports to file, or to the standard output for -
+func writeBuildReports(file string, reports []*compiler.BuildReport) error {
+	if file == "-" {
+		return compiler.WriteBuildReports(os.Stdout, reports)
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return compiler.WriteBuildReports(f, reports)
+}
+
+func getInitCmd() *cobra.Command {
+	var opts compiler.InitOptions
+	cmd := &cobra.Command{
+		Use:   "init <yang-dir> <model-dir>",
+		Short: "Creates a new config model from a directory of YANG files",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := compiler.InitModel(args[0], args[1], opts)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&opts.Name, "name", "", "name of the model (defaults to the name of the model directory)")
+	cmd.Flags().StringVar(&opts.Version, "version", "", "version of the model (defaults to 1.0.x)")
+	cmd.Flags().StringVar(&opts.GoPackage, "go-package", "", "Go package of the model plugin")
+	cmd.Flags().StringVar(&opts.PluginVersion, "plugin-version", "", "version of the model plugin written to VERSION (defaults to 1.0.0-dev)")
+	return cmd
+}
+
+func getMigrateMetaDataCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate-metadata [model-dir...]",
+		Short: fmt.Sprintf("Upgrades the meta-data of config models to the %s format", compiler.MetaDataAPIVersion),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				args = []string{defaultModelPath}
+			}
+			for _, path := range args {
+				if _, err := compiler.MigrateMetaData(path); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func getMetaDataSchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata-schema",
+		Short: "Prints the JSON Schema of the config model meta-data",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema, err := compiler.MetaDataJSONSchema()
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(schema)
+			return err
+		},
+	}
+}
+
+func getVersionCheckCmd() *cobra.Command {
+	var against, snapshot, save string
+	cmd := &cobra.Command{
+		Use:   "version-check [model-dir]",
+		Short: "Checks that the VERSION of a config model reflects the changes of its schema since a release",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := defaultModelPath
+			if len(args) > 0 {
+				path = args[0]
+			}
+			if save != "" {
+				current, err := compiler.TakeSchemaSnapshot(path)
+				if err != nil {
+					return err
+				}
+				return compiler.SaveSchemaSnapshot(current, save)
+			}
+
+			var previous *compiler.SchemaSnapshot
+			var err error
+			switch {
+			case against != "" && snapshot != "":
+				return fmt.Errorf("--against and --snapshot are mutually exclusive")
+			case snapshot != "":
+				previous, err = compiler.LoadSchemaSnapshot(snapshot)
+			case against != "":
+				previous, err = compiler.GitSchemaSnapshot(path, against)
+			default:
+				return fmt.Errorf("one of --against or --snapshot is required")
+			}
+			if err != nil {
+				return err
+			}
+			return compiler.CheckModelVersion(path, previous, os.Stdout)
+		},
+	}
+	cmd.Flags().StringVar(&against, "against", "", "git ref of the release to compare the model with, e.g. origin/master")
+	cmd.Flags().StringVar(&snapshot, "snapshot", "", "schema snapshot of the release to compare the model with")
+	cmd.Flags().StringVar(&save, "save-snapshot", "", "save the schema snapshot of the model to the given file instead of checking it")
+	return cmd
+}
+
+func getCompatCmd() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "compat <old-model-dir> <new-model-dir>",
+		Short: "Reports the changes between the schemas of two config models and fails if any breaks the clients of the old one",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			old, err := compiler.ModelSchemaTree(args[0])
+			if err != nil {
+				return err
+			}
+			new, err := compiler.ModelSchemaTree(args[1])
+			if err != nil {
+				return err
+			}
+			report := compat.Compare(old, new)
+			switch output {
+			case "text":
+				err = report.WriteText(os.Stdout)
+			case "json":
+				err = report.WriteJSON(os.Stdout)
+			default:
+				return fmt.Errorf("unknown output format %s", output)
+			}
+			if err != nil {
+				return err
+			}
+			if incompatible := report.Incompatible(); len(incompatible) > 0 {
+				return fmt.Errorf("%d backward incompatible changes", len(incompatible))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "format of the report, text or json")
+	return cmd
+}
+
+func getPackageCmd() *cobra.Command {
+	var opts compiler.PackageOptions
+	cmd := &cobra.Command{
+		Use:   "package [model-dir]",
+		Short: "Packages a compiled config model, with its plugin binary, in to a bundle which does not need a registry",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := defaultModelPath
+			if len(args) > 0 {
+				path = args[0]
+			}
+			bundle, err := compiler.PackageModel(path, opts)
+			if err != nil {
+				return err
+			}
+			fmt.Println(bundle)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "bundle file (defaults to <artifactName>-<VERSION>.tar.gz)")
+	cmd.Flags().StringVar(&opts.Binary, "binary", "", "plugin binary already built from the model, instead of building it")
+	cmd.Flags().StringVar(&opts.GOOS, "goos", "", "operating system the plugin is built for (defaults to the one of the compiler)")
+	cmd.Flags().StringVar(&opts.GOARCH, "goarch", "", "architecture the plugin is built for (defaults to the one of the compiler)")
+	return cmd
+}
+
+func getVerifyPackageCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-package <bundle>",
+		Short: "Checks the files of a config model bundle against the checksums of its manifest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := compiler.VerifyBundle(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: %s %s, plugin %s for %s, %d files verified\n", args[0], manifest.Name,
+				manifest.Version, manifest.PluginVersion, manifest.Platform, len(manifest.Files))
+			return nil
+		},
+	}
+}
+
+func getWatchCmd() *cobra.Command {
+	var opts compiler.WatchOptions
+	var only, skip, searchPaths []string
+	cmd := &cobra.Command{
+		Use:   "watch [model-dir]",
+		Short: "Compiles a config model and runs its tests each time its meta-data, YANG files, templates or test data change",
 		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			path := defaultModelPath
 			if len(args) > 0 {
 				path = args[0]
 			}
-			return compiler.NewCompiler().Compile(path)
+			c := compiler.NewCompiler()
+			c.SelectStages(only, skip)
+			c.SetSearchPaths(searchPaths)
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			return c.Watch(ctx, path, opts, os.Stdout)
 		},
 	}
+	cmd.Flags().BoolVar(&opts.SkipTests, "skip-tests", false, "do not run the tests of the model after each compilation")
+	cmd.Flags().DurationVar(&opts.Delay, "delay", 0, "how long to wait for the edited files to settle before compiling (defaults to 200ms)")
+	cmd.Flags().StringSliceVar(&only, "only", nil, "run only the given stages and the ones they depend on")
+	cmd.Flags().StringSliceVar(&skip, "skip", nil, "do not run the given stages")
+	cmd.Flags().StringSliceVarP(&searchPaths, "search-path", "I", nil, "directories searched for the imported YANG modules missing from the model")
 	return cmd
 }
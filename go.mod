@@ -5,7 +5,9 @@ go 1.16
 require (
 	github.com/SeanCondon/xpath v0.0.0-20220628084621-97cfdefbc266
 	github.com/atomix/atomix-go-framework v0.10.1 // indirect
+	github.com/fsnotify/fsnotify v1.5.1
 	github.com/getkin/kin-openapi v0.20.0
+	github.com/ghodss/yaml v1.0.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/onosproject/onos-api/go v0.9.14
@@ -13,12 +15,15 @@ require (
 	github.com/openconfig/gnmi v0.0.0-20210914185457-51254b657b7d
 	github.com/openconfig/goyang v1.0.0
 	github.com/openconfig/ygot v0.22.1
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/spf13/cobra v1.2.1
 	github.com/spf13/viper v1.9.0
 	github.com/stretchr/testify v1.7.0
 	golang.org/x/text v0.3.7
+	google.golang.org/genproto v0.0.0-20210828152312-66f60bf46e71
 	google.golang.org/grpc v1.41.0
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 	gotest.tools v2.2.0+incompatible
 )
@@ -0,0 +1,395 @@
+// Code generated by model-compiler. DO NOT EDIT.
+
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+)
+
+var readOnlyPaths = []*admin.ReadOnlyPath{
+	{Path: "/components/component[name=*]/properties/property[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/configurable", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Indication whether the property is user-configurable", Units: "", IsAKey: false, AttrName: "configurable"},
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-supplied name of the property -- this is typically\nnon-configurable", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/value", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Property values can take on a variety of types.  Signed and\nunsigned integer types may be provided in smaller sizes,\ne.g., int8, uint16, etc.", Units: "", IsAKey: false, AttrName: "value"},
+	}},
+	{Path: "/components/component[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/description", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-supplied description of the component", Units: "", IsAKey: false, AttrName: "description"},
+		{SubPath: "/id", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Unique identifier assigned by the system for the\ncomponent", Units: "", IsAKey: false, AttrName: "id"},
+		{SubPath: "/mfg-name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-supplied identifier for the manufacturer of the\ncomponent.  This data is particularly useful when a\ncomponent manufacturer is different than the overall\ndevice vendor.", Units: "", IsAKey: false, AttrName: "mfg-name"},
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Device name for the component -- this will not be a\nconfigurable parameter on many implementations", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/part-no", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-assigned part number for the component.  This should\nbe present in particular if the component is also an FRU\n(field replacable unit)", Units: "", IsAKey: false, AttrName: "part-no"},
+		{SubPath: "/serial-no", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-assigned serial number of the component.", Units: "", IsAKey: false, AttrName: "serial-no"},
+		{SubPath: "/temperature/avg", ValueType: configapi.ValueType_DECIMAL, TypeOpts: []uint64{1}, Description: "The arithmetic mean value of the statistic over the\nsampling period.", Units: "", IsAKey: false, AttrName: "avg"},
+		{SubPath: "/temperature/instant", ValueType: configapi.ValueType_DECIMAL, TypeOpts: []uint64{1}, Description: "The instantaneous value of the statistic.", Units: "", IsAKey: false, AttrName: "instant"},
+		{SubPath: "/temperature/max", ValueType: configapi.ValueType_DECIMAL, TypeOpts: []uint64{1}, Description: "The maximum value of the statistic over the sampling\nperiod", Units: "", IsAKey: false, AttrName: "max"},
+		{SubPath: "/temperature/min", ValueType: configapi.ValueType_DECIMAL, TypeOpts: []uint64{1}, Description: "The minimum value of the statistic over the sampling\nperiod", Units: "", IsAKey: false, AttrName: "min"},
+		{SubPath: "/type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Type of component as identified by the system", Units: "", IsAKey: false, AttrName: "type"},
+		{SubPath: "/version", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "System-defined version string for a hardware, firmware,\nor software component.", Units: "", IsAKey: false, AttrName: "version"},
+	}},
+	{Path: "/components/component[name=*]/subcomponents/subcomponent[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Reference to the name of the subcomponent", Units: "", IsAKey: false, AttrName: "name"},
+	}},
+	{Path: "/interfaces/interface[name=*]/hold-time/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/down", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Dampens advertisement when the interface transitions from\nup to down.  A zero value means dampening is turned off,\ni.e., immediate notification.", Units: "", IsAKey: false, AttrName: "down"},
+		{SubPath: "/up", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Dampens advertisement when the interface\ntransitions from down to up.  A zero value means dampening\nis turned off, i.e., immediate notification.", Units: "", IsAKey: false, AttrName: "up"},
+	}},
+	{Path: "/interfaces/interface[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/admin-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The desired state of the interface.  In RFC 7223 this leaf\nhas the same read semantics as ifAdminStatus.  Here, it\nreflects the administrative state as set by enabling or\ndisabling the interface.", Units: "", IsAKey: false, AttrName: "admin-status"},
+		{SubPath: "/counters/carrier-transitions", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of times the interface state has transitioned\nbetween up and down since the time the device restarted\nor the last-clear time, whichever is most recent.", Units: "", IsAKey: false, AttrName: "carrier-transitions"},
+		{SubPath: "/counters/in-broadcast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were addressed to a broadcast\naddress at this sub-layer.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-broadcast-pkts"},
+		{SubPath: "/counters/in-discards", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of inbound packets that were chosen to be\ndiscarded even though no errors had been detected to\nprevent their being deliverable to a higher-layer\nprotocol.  One possible reason for discarding such a\npacket could be to free up buffer space.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-discards"},
+		{SubPath: "/counters/in-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of inbound\npackets that contained errors preventing them from being\ndeliverable to a higher-layer protocol.  For character-\noriented or fixed-length interfaces, the number of\ninbound transmission units that contained errors\npreventing them from being deliverable to a higher-layer\nprotocol.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-errors"},
+		{SubPath: "/counters/in-fcs-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of received packets which had errors in the\nframe check sequence (FCS), i.e., framing errors.\n\nDiscontinuities in the value of this counter can occur\nwhen the device is re-initialization as indicated by the\nvalue of 'last-clear'.", Units: "", IsAKey: false, AttrName: "in-fcs-errors"},
+		{SubPath: "/counters/in-multicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were addressed to a multicast\naddress at this sub-layer.  For a MAC-layer protocol,\nthis includes both Group and Functional addresses.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-multicast-pkts"},
+		{SubPath: "/counters/in-octets", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of octets received on the interface,\nincluding framing characters.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-octets"},
+		{SubPath: "/counters/in-unicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were not addressed to a\nmulticast or broadcast address at this sub-layer.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-unicast-pkts"},
+		{SubPath: "/counters/in-unknown-protos", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of packets\nreceived via the interface that were discarded because\nof an unknown or unsupported protocol.  For\ncharacter-oriented or fixed-length interfaces that\nsupport protocol multiplexing, the number of\ntransmission units received via the interface that were\ndiscarded because of an unknown or unsupported protocol.\nFor any interface that does not support protocol\nmultiplexing, this counter is not present.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-unknown-protos"},
+		{SubPath: "/counters/last-clear", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Timestamp of the last time the interface counters were\ncleared.\n\nThe value is the timestamp in nanoseconds relative to\nthe Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "last-clear"},
+		{SubPath: "/counters/out-broadcast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were addressed to a\nbroadcast address at this sub-layer, including those\nthat were discarded or not sent.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-broadcast-pkts"},
+		{SubPath: "/counters/out-discards", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of outbound packets that were chosen to be\ndiscarded even though no errors had been detected to\nprevent their being transmitted.  One possible reason\nfor discarding such a packet could be to free up buffer\nspace.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-discards"},
+		{SubPath: "/counters/out-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of outbound\npackets that could not be transmitted because of errors.\nFor character-oriented or fixed-length interfaces, the\nnumber of outbound transmission units that could not be\ntransmitted because of errors.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-errors"},
+		{SubPath: "/counters/out-multicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were addressed to a\nmulticast address at this sub-layer, including those\nthat were discarded or not sent.  For a MAC-layer\nprotocol, this includes both Group and Functional\naddresses.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-multicast-pkts"},
+		{SubPath: "/counters/out-octets", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of octets transmitted out of the\ninterface, including framing characters.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-octets"},
+		{SubPath: "/counters/out-unicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were not addressed\nto a multicast or broadcast address at this sub-layer,\nincluding those that were discarded or not sent.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-unicast-pkts"},
+		{SubPath: "/description", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "A textual description of the interface.\n\nA server implementation MAY map this leaf to the ifAlias\nMIB object.  Such an implementation needs to use some\nmechanism to handle the differences in size and characters\nallowed between this leaf and ifAlias.  The definition of\nsuch a mechanism is outside the scope of this document.\n\nSince ifAlias is defined to be stored in non-volatile\nstorage, the MIB implementation MUST map ifAlias to the\nvalue of 'description' in the persistently stored\ndatastore.\n\nSpecifically, if the device supports ':startup', when\nifAlias is read the device MUST return the value of\n'description' in the 'startup' datastore, and when it is\nwritten, it MUST be written to the 'running' and 'startup'\ndatastores.  Note that it is up to the implementation to\n\ndecide whether to modify this single leaf in 'startup' or\nperform an implicit copy-config from 'running' to\n'startup'.\n\nIf the device does not support ':startup', ifAlias MUST\nbe mapped to the 'description' leaf in the 'running'\ndatastore.", Units: "", IsAKey: false, AttrName: "description"},
+		{SubPath: "/enabled", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "This leaf contains the configured, desired state of the\ninterface.\n\nSystems that implement the IF-MIB use the value of this\nleaf in the 'running' datastore to set\nIF-MIB.ifAdminStatus to 'up' or 'down' after an ifEntry\nhas been initialized, as described in RFC 2863.\n\nChanges in this leaf in the 'running' datastore are\nreflected in ifAdminStatus, but if ifAdminStatus is\nchanged over SNMP, this leaf is not affected.", Units: "", IsAKey: false, AttrName: "enabled"},
+		{SubPath: "/hardware-port", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "References the hardware port in the device inventory", Units: "", IsAKey: false, AttrName: "hardware-port"},
+		{SubPath: "/ifindex", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "System assigned number for each interface.  Corresponds to\nifIndex object in SNMP Interface MIB", Units: "", IsAKey: false, AttrName: "ifindex"},
+		{SubPath: "/last-change", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "This timestamp indicates the time of the last state change\nof the interface (e.g., up-to-down transition). This\ncorresponds to the ifLastChange object in the standard\ninterface MIB.\n\nThe value is the timestamp in nanoseconds relative to\nthe Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "last-change"},
+		{SubPath: "/mtu", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set the max transmission unit size in octets\nfor the physical interface.  If this is not set, the mtu is\nset to the operational default -- e.g., 1514 bytes on an\nEthernet interface.", Units: "", IsAKey: false, AttrName: "mtu"},
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The name of the interface.\n\nA device MAY restrict the allowed values for this leaf,\npossibly depending on the type of the interface.\nFor system-controlled interfaces, this leaf is the\ndevice-specific name of the interface.  The 'config false'\nlist interfaces/interface[name]/state contains the currently\nexisting interfaces on the device.\n\nIf a client tries to create configuration for a\nsystem-controlled interface that is not present in the\ncorresponding state list, the server MAY reject\nthe request if the implementation does not support\npre-provisioning of interfaces or if the name refers to\nan interface that can never exist in the system.  A\nNETCONF server MUST reply with an rpc-error with the\nerror-tag 'invalid-value' in this case.\n\nThe IETF model in RFC 7223 provides YANG features for the\nfollowing (i.e., pre-provisioning and arbitrary-names),\nhowever they are omitted here:\n\n If the device supports pre-provisioning of interface\n configuration, the 'pre-provisioning' feature is\n advertised.\n\n If the device allows arbitrarily named user-controlled\n interfaces, the 'arbitrary-names' feature is advertised.\n\nWhen a configured user-controlled interface is created by\nthe system, it is instantiated with the same name in the\n/interfaces/interface[name]/state list.", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/oper-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The current operational state of the interface.\n\nThis leaf has the same semantics as ifOperStatus.", Units: "", IsAKey: false, AttrName: "oper-status"},
+		{SubPath: "/type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The type of the interface.\n\nWhen an interface entry is created, a server MAY\ninitialize the type leaf with a valid value, e.g., if it\nis possible to derive the type from the name of the\ninterface.\n\nIf a client tries to set the type of an interface to a\nvalue that can never be used by the system, e.g., if the\ntype is not supported or if the type does not match the\nname of the interface, the server MUST reject the request.\nA NETCONF server MUST reply with an rpc-error with the\nerror-tag 'invalid-value' in this case.", Units: "", IsAKey: false, AttrName: "type"},
+	}},
+	{Path: "/interfaces/interface[name=*]/subinterfaces/subinterface[index=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/admin-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The desired state of the interface.  In RFC 7223 this leaf\nhas the same read semantics as ifAdminStatus.  Here, it\nreflects the administrative state as set by enabling or\ndisabling the interface.", Units: "", IsAKey: false, AttrName: "admin-status"},
+		{SubPath: "/counters/carrier-transitions", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of times the interface state has transitioned\nbetween up and down since the time the device restarted\nor the last-clear time, whichever is most recent.", Units: "", IsAKey: false, AttrName: "carrier-transitions"},
+		{SubPath: "/counters/in-broadcast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were addressed to a broadcast\naddress at this sub-layer.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-broadcast-pkts"},
+		{SubPath: "/counters/in-discards", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of inbound packets that were chosen to be\ndiscarded even though no errors had been detected to\nprevent their being deliverable to a higher-layer\nprotocol.  One possible reason for discarding such a\npacket could be to free up buffer space.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-discards"},
+		{SubPath: "/counters/in-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of inbound\npackets that contained errors preventing them from being\ndeliverable to a higher-layer protocol.  For character-\noriented or fixed-length interfaces, the number of\ninbound transmission units that contained errors\npreventing them from being deliverable to a higher-layer\nprotocol.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-errors"},
+		{SubPath: "/counters/in-fcs-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of received packets which had errors in the\nframe check sequence (FCS), i.e., framing errors.\n\nDiscontinuities in the value of this counter can occur\nwhen the device is re-initialization as indicated by the\nvalue of 'last-clear'.", Units: "", IsAKey: false, AttrName: "in-fcs-errors"},
+		{SubPath: "/counters/in-multicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were addressed to a multicast\naddress at this sub-layer.  For a MAC-layer protocol,\nthis includes both Group and Functional addresses.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-multicast-pkts"},
+		{SubPath: "/counters/in-octets", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of octets received on the interface,\nincluding framing characters.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-octets"},
+		{SubPath: "/counters/in-unicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of packets, delivered by this sub-layer to a\nhigher (sub-)layer, that were not addressed to a\nmulticast or broadcast address at this sub-layer.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-unicast-pkts"},
+		{SubPath: "/counters/in-unknown-protos", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of packets\nreceived via the interface that were discarded because\nof an unknown or unsupported protocol.  For\ncharacter-oriented or fixed-length interfaces that\nsupport protocol multiplexing, the number of\ntransmission units received via the interface that were\ndiscarded because of an unknown or unsupported protocol.\nFor any interface that does not support protocol\nmultiplexing, this counter is not present.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "in-unknown-protos"},
+		{SubPath: "/counters/last-clear", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Timestamp of the last time the interface counters were\ncleared.\n\nThe value is the timestamp in nanoseconds relative to\nthe Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "last-clear"},
+		{SubPath: "/counters/out-broadcast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were addressed to a\nbroadcast address at this sub-layer, including those\nthat were discarded or not sent.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-broadcast-pkts"},
+		{SubPath: "/counters/out-discards", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The number of outbound packets that were chosen to be\ndiscarded even though no errors had been detected to\nprevent their being transmitted.  One possible reason\nfor discarding such a packet could be to free up buffer\nspace.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-discards"},
+		{SubPath: "/counters/out-errors", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "For packet-oriented interfaces, the number of outbound\npackets that could not be transmitted because of errors.\nFor character-oriented or fixed-length interfaces, the\nnumber of outbound transmission units that could not be\ntransmitted because of errors.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-errors"},
+		{SubPath: "/counters/out-multicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were addressed to a\nmulticast address at this sub-layer, including those\nthat were discarded or not sent.  For a MAC-layer\nprotocol, this includes both Group and Functional\naddresses.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-multicast-pkts"},
+		{SubPath: "/counters/out-octets", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of octets transmitted out of the\ninterface, including framing characters.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-octets"},
+		{SubPath: "/counters/out-unicast-pkts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The total number of packets that higher-level protocols\nrequested be transmitted, and that were not addressed\nto a multicast or broadcast address at this sub-layer,\nincluding those that were discarded or not sent.\n\nDiscontinuities in the value of this counter can occur\nat re-initialization of the management system, and at\nother times as indicated by the value of\n'last-clear'.", Units: "", IsAKey: false, AttrName: "out-unicast-pkts"},
+		{SubPath: "/description", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "A textual description of the interface.\n\nA server implementation MAY map this leaf to the ifAlias\nMIB object.  Such an implementation needs to use some\nmechanism to handle the differences in size and characters\nallowed between this leaf and ifAlias.  The definition of\nsuch a mechanism is outside the scope of this document.\n\nSince ifAlias is defined to be stored in non-volatile\nstorage, the MIB implementation MUST map ifAlias to the\nvalue of 'description' in the persistently stored\ndatastore.\n\nSpecifically, if the device supports ':startup', when\nifAlias is read the device MUST return the value of\n'description' in the 'startup' datastore, and when it is\nwritten, it MUST be written to the 'running' and 'startup'\ndatastores.  Note that it is up to the implementation to\n\ndecide whether to modify this single leaf in 'startup' or\nperform an implicit copy-config from 'running' to\n'startup'.\n\nIf the device does not support ':startup', ifAlias MUST\nbe mapped to the 'description' leaf in the 'running'\ndatastore.", Units: "", IsAKey: false, AttrName: "description"},
+		{SubPath: "/enabled", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "This leaf contains the configured, desired state of the\ninterface.\n\nSystems that implement the IF-MIB use the value of this\nleaf in the 'running' datastore to set\nIF-MIB.ifAdminStatus to 'up' or 'down' after an ifEntry\nhas been initialized, as described in RFC 2863.\n\nChanges in this leaf in the 'running' datastore are\nreflected in ifAdminStatus, but if ifAdminStatus is\nchanged over SNMP, this leaf is not affected.", Units: "", IsAKey: false, AttrName: "enabled"},
+		{SubPath: "/ifindex", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "System assigned number for each interface.  Corresponds to\nifIndex object in SNMP Interface MIB", Units: "", IsAKey: false, AttrName: "ifindex"},
+		{SubPath: "/index", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "The index of the subinterface, or logical interface number.\nOn systems with no support for subinterfaces, or not using\nsubinterfaces, this value should default to 0, i.e., the\ndefault subinterface.", Units: "", IsAKey: false, AttrName: "index"},
+		{SubPath: "/last-change", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "This timestamp indicates the time of the last state change\nof the interface (e.g., up-to-down transition). This\ncorresponds to the ifLastChange object in the standard\ninterface MIB.\n\nThe value is the timestamp in nanoseconds relative to\nthe Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "last-change"},
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The system-assigned name for the sub-interface.  This MAY\nbe a combination of the base interface name and the\nsubinterface index, or some other convention used by the\nsystem.", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/oper-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The current operational state of the interface.\n\nThis leaf has the same semantics as ifOperStatus.", Units: "", IsAKey: false, AttrName: "oper-status"},
+	}},
+	{Path: "/system/aaa/accounting/events/event[event-type=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/event-type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The type of activity to record at the AAA accounting\nserver", Units: "", IsAKey: false, AttrName: "event-type"},
+		{SubPath: "/record", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Type of record to send to the accounting server for this\nactivity type", Units: "", IsAKey: false, AttrName: "record"},
+	}},
+	{Path: "/system/aaa/accounting/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/accounting-method", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "The method used for AAA accounting for this event\ntype.  The method is defined by the destination for\naccounting data, which may be specified as the group of\nall TACACS+/RADIUS servers, a defined server group, or\nthe local system.", Units: "", IsAKey: false, AttrName: "accounting-method"},
+	}},
+	{Path: "/system/aaa/authentication/admin-user/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/admin-password", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The admin/root password, supplied as a cleartext string.\nThe system should hash and only store the password as a\nhashed value.", Units: "", IsAKey: false, AttrName: "admin-password"},
+		{SubPath: "/admin-password-hashed", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The admin/root password, supplied as a hashed value\nusing the notation described in the definition of the\ncrypt-password-type.", Units: "", IsAKey: false, AttrName: "admin-password-hashed"},
+		{SubPath: "/admin-username", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Name of the administrator user account, e.g., admin, root,\netc.", Units: "", IsAKey: false, AttrName: "admin-username"},
+	}},
+	{Path: "/system/aaa/authentication/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/authentication-method", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "Ordered list of authentication methods for users.  This\ncan be either a reference to a server group, or a well-\ndefined designation in the AAA_METHOD_TYPE identity.  If\nauthentication fails with one method, the next defined\nmethod is tried -- failure of all methods results in the\nuser being denied access.", Units: "", IsAKey: false, AttrName: "authentication-method"},
+	}},
+	{Path: "/system/aaa/authentication/users/user[username=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/password", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The user password, supplied as cleartext.  The system\nmust hash the value and only store the hashed value.", Units: "", IsAKey: false, AttrName: "password"},
+		{SubPath: "/password-hashed", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The user password, supplied as a hashed value\nusing the notation described in the definition of the\ncrypt-password-type.", Units: "", IsAKey: false, AttrName: "password-hashed"},
+		{SubPath: "/role", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Role assigned to the user.  The role may be supplied\nas a string or a role defined by the SYSTEM_DEFINED_ROLES\nidentity.", Units: "", IsAKey: false, AttrName: "role"},
+		{SubPath: "/ssh-key", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "SSH public key for the user (RSA or DSA)", Units: "", IsAKey: false, AttrName: "ssh-key"},
+		{SubPath: "/username", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Assigned username for this user", Units: "", IsAKey: false, AttrName: "username"},
+	}},
+	{Path: "/system/aaa/authorization/events/event[event-type=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/event-type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The type of event to record at the AAA authorization\nserver", Units: "", IsAKey: false, AttrName: "event-type"},
+	}},
+	{Path: "/system/aaa/authorization/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/authorization-method", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "Ordered list of methods for authorizing commands.  The first\nmethod that provides a response (positive or negative) should\nbe used.  The list may contain a well-defined method such\nas the set of all TACACS or RADIUS servers, or the name of\na defined AAA server group.  The system must validate\nthat the named server group exists.", Units: "", IsAKey: false, AttrName: "authorization-method"},
+	}},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/acct-port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Port number for accounting requests", Units: "", IsAKey: false, AttrName: "acct-port"},
+		{SubPath: "/auth-port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Port number for authentication requests", Units: "", IsAKey: false, AttrName: "auth-port"},
+		{SubPath: "/counters/access-accepts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Received Access-Accept messages.", Units: "", IsAKey: false, AttrName: "access-accepts"},
+		{SubPath: "/counters/access-rejects", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Received Access-Reject messages.", Units: "", IsAKey: false, AttrName: "access-rejects"},
+		{SubPath: "/counters/retried-access-requests", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Retransmitted Access-Request messages.", Units: "", IsAKey: false, AttrName: "retried-access-requests"},
+		{SubPath: "/counters/timeout-access-requests", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Access-Request messages that have timed-out,\nrequiring retransmission.", Units: "", IsAKey: false, AttrName: "timeout-access-requests"},
+		{SubPath: "/retransmit-attempts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "Number of times the system may resend a request to the\nRADIUS server when it is unresponsive", Units: "", IsAKey: false, AttrName: "retransmit-attempts"},
+		{SubPath: "/secret-key", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The unencrypted shared key used between the authentication\nserver and the device.", Units: "", IsAKey: false, AttrName: "secret-key"},
+		{SubPath: "/source-address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Source IP address to use in messages to the RADIUS server", Units: "", IsAKey: false, AttrName: "source-address"},
+	}},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Address of the authentication server", Units: "", IsAKey: false, AttrName: "address"},
+		{SubPath: "/connection-aborts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of aborted connections to the server.  These do\nnot include connections that are close gracefully.", Units: "", IsAKey: false, AttrName: "connection-aborts"},
+		{SubPath: "/connection-closes", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of connection close requests sent to the server, e.g.\nsocket close", Units: "", IsAKey: false, AttrName: "connection-closes"},
+		{SubPath: "/connection-failures", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of connection failures to the server", Units: "", IsAKey: false, AttrName: "connection-failures"},
+		{SubPath: "/connection-opens", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of new connection requests sent to the server, e.g.\nsocket open", Units: "", IsAKey: false, AttrName: "connection-opens"},
+		{SubPath: "/connection-timeouts", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of connection timeouts to the server", Units: "", IsAKey: false, AttrName: "connection-timeouts"},
+		{SubPath: "/errors-received", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of error messages received from the server", Units: "", IsAKey: false, AttrName: "errors-received"},
+		{SubPath: "/messages-received", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of messages received by the server", Units: "", IsAKey: false, AttrName: "messages-received"},
+		{SubPath: "/messages-sent", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Number of messages sent to the server", Units: "", IsAKey: false, AttrName: "messages-sent"},
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Name assigned to the server", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/timeout", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set the timeout in seconds on responses from the AAA\nserver", Units: "", IsAKey: false, AttrName: "timeout"},
+	}},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/tacacs/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "The port number on which to contact the TACACS server", Units: "", IsAKey: false, AttrName: "port"},
+		{SubPath: "/secret-key", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The unencrypted shared key used between the authentication\nserver and the device.", Units: "", IsAKey: false, AttrName: "secret-key"},
+		{SubPath: "/source-address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Source IP address to use in messages to the TACACS server", Units: "", IsAKey: false, AttrName: "source-address"},
+	}},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Name for the server group", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "AAA server type -- all servers in the group must be of this\ntype", Units: "", IsAKey: false, AttrName: "type"},
+	}},
+	{Path: "/system/clock/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/timezone-name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The TZ database name to use for the system, such\nas 'Europe/Stockholm'.", Units: "", IsAKey: false, AttrName: "timezone-name"},
+	}},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/alias", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "Additional aliases for the hostname", Units: "", IsAKey: false, AttrName: "alias"},
+		{SubPath: "/hostname", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Hostname for the static DNS entry", Units: "", IsAKey: false, AttrName: "hostname"},
+		{SubPath: "/ipv4-address", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "List of IPv4 addressses for the host entry", Units: "", IsAKey: false, AttrName: "ipv4-address"},
+		{SubPath: "/ipv6-address", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "List of IPv6 addresses for the host entry", Units: "", IsAKey: false, AttrName: "ipv6-address"},
+	}},
+	{Path: "/system/dns/servers/server[address=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The address of the DNS server, can be either IPv4\nor IPv6.", Units: "", IsAKey: false, AttrName: "address"},
+		{SubPath: "/port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "The port number of the DNS server.", Units: "", IsAKey: false, AttrName: "port"},
+	}},
+	{Path: "/system/dns/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/search", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "An ordered list of domains to search when resolving\na host name.", Units: "", IsAKey: false, AttrName: "search"},
+	}},
+	{Path: "/system/logging/console/selectors/selector[facility=*][severity=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/facility", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Specifies the facility, or class of messages to log", Units: "", IsAKey: false, AttrName: "facility"},
+		{SubPath: "/severity", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Specifies that only messages of the given severity (or\ngreater severity) for the corresonding facility are logged", Units: "", IsAKey: false, AttrName: "severity"},
+	}},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/selectors/selector[facility=*][severity=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/facility", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Specifies the facility, or class of messages to log", Units: "", IsAKey: false, AttrName: "facility"},
+		{SubPath: "/severity", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Specifies that only messages of the given severity (or\ngreater severity) for the corresonding facility are logged", Units: "", IsAKey: false, AttrName: "severity"},
+	}},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/host", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "IP address or hostname of the remote log server", Units: "", IsAKey: false, AttrName: "host"},
+		{SubPath: "/remote-port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Sets the destination port number for syslog UDP messages to\nthe server.  The default for syslog is 514.", Units: "", IsAKey: false, AttrName: "remote-port"},
+		{SubPath: "/source-address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Source IP address for packets to the log server", Units: "", IsAKey: false, AttrName: "source-address"},
+	}},
+	{Path: "/system/memory/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/physical", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Reports the total physical memory available on the\nsystem.", Units: "", IsAKey: false, AttrName: "physical"},
+		{SubPath: "/reserved", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Memory reserved for system use", Units: "", IsAKey: false, AttrName: "reserved"},
+	}},
+	{Path: "/system/ntp/ntp-keys/ntp-key[key-id=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/key-id", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Integer identifier used by the client and server to\ndesignate a secret key.  The client and server must use\nthe same key id.", Units: "", IsAKey: false, AttrName: "key-id"},
+		{SubPath: "/key-type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Encryption type used for the NTP authentication key", Units: "", IsAKey: false, AttrName: "key-type"},
+		{SubPath: "/key-value", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "NTP authentication key value", Units: "", IsAKey: false, AttrName: "key-value"},
+	}},
+	{Path: "/system/ntp/servers/server[address=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The address or hostname of the NTP server.", Units: "", IsAKey: false, AttrName: "address"},
+		{SubPath: "/association-type", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The desired association type for this NTP server.", Units: "", IsAKey: false, AttrName: "association-type"},
+		{SubPath: "/iburst", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Indicates whether this server should enable burst\nsynchronization or not.", Units: "", IsAKey: false, AttrName: "iburst"},
+		{SubPath: "/offset", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Estimate of the current time offset from the peer.  This is\nthe time difference between the local and reference clock.", Units: "", IsAKey: false, AttrName: "offset"},
+		{SubPath: "/poll-interval", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Polling interval of the peer", Units: "", IsAKey: false, AttrName: "poll-interval"},
+		{SubPath: "/port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "The port number of the NTP server.", Units: "", IsAKey: false, AttrName: "port"},
+		{SubPath: "/prefer", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Indicates whether this server should be preferred\nor not.", Units: "", IsAKey: false, AttrName: "prefer"},
+		{SubPath: "/root-delay", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "The round-trip delay to the server, in milliseconds.", Units: "", IsAKey: false, AttrName: "root-delay"},
+		{SubPath: "/root-dispersion", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Dispersion (epsilon) represents the maximum error inherent\nin the measurement", Units: "", IsAKey: false, AttrName: "root-dispersion"},
+		{SubPath: "/stratum", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "Indicates the level of the server in the NTP hierarchy. As\nstratum number increases, the accuracy is degraded.  Primary\nservers are stratum while a maximum value of 16 indicates\nunsynchronized.  The values have the following specific\nsemantics:\n\n| 0      | unspecified or invalid\n| 1      | primary server (e.g., equipped with a GPS receiver)\n| 2-15   | secondary server (via NTP)\n| 16     | unsynchronized\n| 17-255 | reserved", Units: "", IsAKey: false, AttrName: "stratum"},
+		{SubPath: "/version", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "Version number to put in outgoing NTP packets", Units: "", IsAKey: false, AttrName: "version"},
+	}},
+	{Path: "/system/ntp/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/auth-mismatch", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Count of the number of NTP packets received that were not\nprocessed due to authentication mismatch.", Units: "", IsAKey: false, AttrName: "auth-mismatch"},
+		{SubPath: "/enable-ntp-auth", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Enable or disable NTP authentication -- when enabled, the\nsystem will only use packets containing a trusted\nauthentication key to synchronize the time.", Units: "", IsAKey: false, AttrName: "enable-ntp-auth"},
+		{SubPath: "/enabled", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Enables the NTP protocol and indicates that the system should\nattempt to synchronize the system clock with an NTP server\nfrom the servers defined in the 'ntp/server' list.", Units: "", IsAKey: false, AttrName: "enabled"},
+		{SubPath: "/ntp-source-address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Source address to use on outgoing NTP packets", Units: "", IsAKey: false, AttrName: "ntp-source-address"},
+	}},
+	{Path: "/system/openflow/agent/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/backoff-interval", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Openflow agent connection backoff interval.", Units: "", IsAKey: false, AttrName: "backoff-interval"},
+		{SubPath: "/datapath-id", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Datapath unique ID. The lower 48-bits are for\na MAC address, while the upper 16-bits are\nimplementer-defined.", Units: "", IsAKey: false, AttrName: "datapath-id"},
+		{SubPath: "/failure-mode", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Failure mode for Openflow.", Units: "", IsAKey: false, AttrName: "failure-mode"},
+		{SubPath: "/inactivity-probe", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Openflow agent inactivity probe period.", Units: "", IsAKey: false, AttrName: "inactivity-probe"},
+		{SubPath: "/max-backoff", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "Openflow agent max backoff time.", Units: "", IsAKey: false, AttrName: "max-backoff"},
+	}},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/address", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The IP address of the controller.", Units: "", IsAKey: false, AttrName: "address"},
+		{SubPath: "/aux-id", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "Controller auxiliary ID. Must be 0 for the main controller.\nOne controller may have multiple auxiliary connections as\nspecified by the Openflow protocol. Besides configuring the\nmain controller, it is also possible to configure auxiliary\nconnections. The main controller must have the aux-id\nset to zero. All others must have an aux-id different\nfrom 0.", Units: "", IsAKey: false, AttrName: "aux-id"},
+		{SubPath: "/connected", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "When set to true, indicates the connection between the\nswitch and controller is established.", Units: "", IsAKey: false, AttrName: "connected"},
+		{SubPath: "/port", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Controller port to use.", Units: "", IsAKey: false, AttrName: "port"},
+		{SubPath: "/priority", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "Optional value for servicing auxiliary connections with\ndifferent priorities.", Units: "", IsAKey: false, AttrName: "priority"},
+		{SubPath: "/source-interface", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Optionally specify the source interface for the\ncontroller connection.", Units: "", IsAKey: false, AttrName: "source-interface"},
+		{SubPath: "/transport", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Controller transport protocol used.", Units: "", IsAKey: false, AttrName: "transport"},
+	}},
+	{Path: "/system/openflow/controllers/controller[name=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Name of this Openflow controller. All connections\nfor the same controller need to have the same name.", Units: "", IsAKey: false, AttrName: "name"},
+	}},
+	{Path: "/system/processes/process[pid=*]", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/pid", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Reference to the process pid key", Units: "", IsAKey: true, AttrName: "pid"},
+		{SubPath: "/state/args", ValueType: configapi.ValueType_LEAFLIST_STRING, TypeOpts: nil, Description: "Current process command line arguments.  Arguments with\na parameter (e.g., --option 10  or -option=10) should be\nrepresented as a single element of the list with the\nargument name and parameter together.  Flag arguments, i.e.,\nthose without a parameter should also be in their own list\nelement.", Units: "", IsAKey: false, AttrName: "args"},
+		{SubPath: "/state/cpu-usage-system", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "CPU time consumed by this process in kernel mode.", Units: "", IsAKey: false, AttrName: "cpu-usage-system"},
+		{SubPath: "/state/cpu-usage-user", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "CPU time consumed by this process in user mode.", Units: "", IsAKey: false, AttrName: "cpu-usage-user"},
+		{SubPath: "/state/cpu-utilization", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "The percentage of CPU that is being used by the process.", Units: "", IsAKey: false, AttrName: "cpu-utilization"},
+		{SubPath: "/state/memory-usage", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Bytes allocated and still in use by the process", Units: "", IsAKey: false, AttrName: "memory-usage"},
+		{SubPath: "/state/memory-utilization", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{8}, Description: "The percentage of RAM that is being used by the process.", Units: "", IsAKey: false, AttrName: "memory-utilization"},
+		{SubPath: "/state/name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The process name", Units: "", IsAKey: false, AttrName: "name"},
+		{SubPath: "/state/pid", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The process pid", Units: "", IsAKey: false, AttrName: "pid"},
+		{SubPath: "/state/start-time", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "The time at which this process started,\nreported as nanoseconds since the UNIX epoch.  The\nsystem must be synchronized such that the start-time\ncan be reported accurately, otherwise it should not be\nreported.", Units: "", IsAKey: false, AttrName: "start-time"},
+		{SubPath: "/state/uptime", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "Amount of time elapsed since this process started.", Units: "", IsAKey: false, AttrName: "uptime"},
+	}},
+	{Path: "/system/ssh-server/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/enable", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Enables the ssh server.  The ssh server is enabled by\ndefault.", Units: "", IsAKey: false, AttrName: "enable"},
+		{SubPath: "/protocol-version", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Set the protocol version for SSH connections to the system", Units: "", IsAKey: false, AttrName: "protocol-version"},
+		{SubPath: "/rate-limit", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set a limit on the number of connection attempts per\nminute to the system for the protocol.", Units: "", IsAKey: false, AttrName: "rate-limit"},
+		{SubPath: "/session-limit", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set a limit on the number of simultaneous active terminal\nsessions to the system for the protocol (e.g., ssh,\ntelnet, ...) ", Units: "", IsAKey: false, AttrName: "session-limit"},
+		{SubPath: "/timeout", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set the idle timeout in seconds on terminal connections to\nthe system for the protocol.", Units: "", IsAKey: false, AttrName: "timeout"},
+	}},
+	{Path: "/system/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/boot-time", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "This timestamp indicates the time that the system was last\nrestarted.  The value is the timestamp in seconds relative\nto the Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "boot-time"},
+		{SubPath: "/current-datetime", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The current system date and time.", Units: "", IsAKey: false, AttrName: "current-datetime"},
+		{SubPath: "/domain-name", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Specifies the domain name used to form fully qualified name\nfor unqualified hostnames.", Units: "", IsAKey: false, AttrName: "domain-name"},
+		{SubPath: "/hostname", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The hostname of the device -- should be a single domain\nlabel, without the domain.", Units: "", IsAKey: false, AttrName: "hostname"},
+		{SubPath: "/login-banner", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The console login message displayed before the login prompt,\ni.e., before a user logs into the system.", Units: "", IsAKey: false, AttrName: "login-banner"},
+		{SubPath: "/motd-banner", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The console message displayed after a user logs into the\nsystem.  They system may append additional standard\ninformation such as the current system date and time, uptime,\nlast login timestamp, etc.", Units: "", IsAKey: false, AttrName: "motd-banner"},
+	}},
+	{Path: "/system/telnet-server/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/enable", ValueType: configapi.ValueType_BOOL, TypeOpts: nil, Description: "Enables the telnet server.  Telnet is disabled by\ndefault", Units: "", IsAKey: false, AttrName: "enable"},
+		{SubPath: "/rate-limit", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set a limit on the number of connection attempts per\nminute to the system for the protocol.", Units: "", IsAKey: false, AttrName: "rate-limit"},
+		{SubPath: "/session-limit", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set a limit on the number of simultaneous active terminal\nsessions to the system for the protocol (e.g., ssh,\ntelnet, ...) ", Units: "", IsAKey: false, AttrName: "session-limit"},
+		{SubPath: "/timeout", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Description: "Set the idle timeout in seconds on terminal connections to\nthe system for the protocol.", Units: "", IsAKey: false, AttrName: "timeout"},
+	}},
+}
+
+var readWritePaths = []*admin.ReadWritePath{
+	{Path: "/components/component[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Device name for the component -- this will not be a\nconfigurable parameter on many implementations", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/components/component[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "References the component name", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/components/component[name=*]/properties/property[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "System-supplied name of the property -- this is typically\nnon-configurable", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/components/component[name=*]/properties/property[name=*]/config/value", ValueType: configapi.ValueType_STRING, Units: "", Description: "Property values can take on a variety of types.  Signed and\nunsigned integer types may be provided in smaller sizes,\ne.g., int8, uint16, etc.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "value"},
+	{Path: "/components/component[name=*]/properties/property[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the property name.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/components/component[name=*]/subcomponents/subcomponent[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the name of the subcomponent", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/components/component[name=*]/subcomponents/subcomponent[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the name list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/interfaces/interface[name=*]/config/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "A textual description of the interface.\n\nA server implementation MAY map this leaf to the ifAlias\nMIB object.  Such an implementation needs to use some\nmechanism to handle the differences in size and characters\nallowed between this leaf and ifAlias.  The definition of\nsuch a mechanism is outside the scope of this document.\n\nSince ifAlias is defined to be stored in non-volatile\nstorage, the MIB implementation MUST map ifAlias to the\nvalue of 'description' in the persistently stored\ndatastore.\n\nSpecifically, if the device supports ':startup', when\nifAlias is read the device MUST return the value of\n'description' in the 'startup' datastore, and when it is\nwritten, it MUST be written to the 'running' and 'startup'\ndatastores.  Note that it is up to the implementation to\n\ndecide whether to modify this single leaf in 'startup' or\nperform an implicit copy-config from 'running' to\n'startup'.\n\nIf the device does not support ':startup', ifAlias MUST\nbe mapped to the 'description' leaf in the 'running'\ndatastore.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/interfaces/interface[name=*]/config/enabled", ValueType: configapi.ValueType_BOOL, Units: "", Description: "This leaf contains the configured, desired state of the\ninterface.\n\nSystems that implement the IF-MIB use the value of this\nleaf in the 'running' datastore to set\nIF-MIB.ifAdminStatus to 'up' or 'down' after an ifEntry\nhas been initialized, as described in RFC 2863.\n\nChanges in this leaf in the 'running' datastore are\nreflected in ifAdminStatus, but if ifAdminStatus is\nchanged over SNMP, this leaf is not affected.", Mandatory: false, Default: "true", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enabled"},
+	{Path: "/interfaces/interface[name=*]/config/mtu", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set the max transmission unit size in octets\nfor the physical interface.  If this is not set, the mtu is\nset to the operational default -- e.g., 1514 bytes on an\nEthernet interface.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "mtu"},
+	{Path: "/interfaces/interface[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "The name of the interface.\n\nA device MAY restrict the allowed values for this leaf,\npossibly depending on the type of the interface.\nFor system-controlled interfaces, this leaf is the\ndevice-specific name of the interface.  The 'config false'\nlist interfaces/interface[name]/state contains the currently\nexisting interfaces on the device.\n\nIf a client tries to create configuration for a\nsystem-controlled interface that is not present in the\ncorresponding state list, the server MAY reject\nthe request if the implementation does not support\npre-provisioning of interfaces or if the name refers to\nan interface that can never exist in the system.  A\nNETCONF server MUST reply with an rpc-error with the\nerror-tag 'invalid-value' in this case.\n\nThe IETF model in RFC 7223 provides YANG features for the\nfollowing (i.e., pre-provisioning and arbitrary-names),\nhowever they are omitted here:\n\n If the device supports pre-provisioning of interface\n configuration, the 'pre-provisioning' feature is\n advertised.\n\n If the device allows arbitrarily named user-controlled\n interfaces, the 'arbitrary-names' feature is advertised.\n\nWhen a configured user-controlled interface is created by\nthe system, it is instantiated with the same name in the\n/interfaces/interface[name]/state list.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/interfaces/interface[name=*]/config/type", ValueType: configapi.ValueType_STRING, Units: "", Description: "The type of the interface.\n\nWhen an interface entry is created, a server MAY\ninitialize the type leaf with a valid value, e.g., if it\nis possible to derive the type from the name of the\ninterface.\n\nIf a client tries to set the type of an interface to a\nvalue that can never be used by the system, e.g., if the\ntype is not supported or if the type does not match the\nname of the interface, the server MUST reject the request.\nA NETCONF server MUST reply with an rpc-error with the\nerror-tag 'invalid-value' in this case.", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "type"},
+	{Path: "/interfaces/interface[name=*]/hold-time/config/down", ValueType: configapi.ValueType_UINT, Units: "", Description: "Dampens advertisement when the interface transitions from\nup to down.  A zero value means dampening is turned off,\ni.e., immediate notification.", Mandatory: false, Default: "0", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "down"},
+	{Path: "/interfaces/interface[name=*]/hold-time/config/up", ValueType: configapi.ValueType_UINT, Units: "", Description: "Dampens advertisement when the interface\ntransitions from down to up.  A zero value means dampening\nis turned off, i.e., immediate notification.", Mandatory: false, Default: "0", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "up"},
+	{Path: "/interfaces/interface[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "References the configured name of the interface", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/interfaces/interface[name=*]/subinterfaces/subinterface[index=*]/config/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "A textual description of the interface.\n\nA server implementation MAY map this leaf to the ifAlias\nMIB object.  Such an implementation needs to use some\nmechanism to handle the differences in size and characters\nallowed between this leaf and ifAlias.  The definition of\nsuch a mechanism is outside the scope of this document.\n\nSince ifAlias is defined to be stored in non-volatile\nstorage, the MIB implementation MUST map ifAlias to the\nvalue of 'description' in the persistently stored\ndatastore.\n\nSpecifically, if the device supports ':startup', when\nifAlias is read the device MUST return the value of\n'description' in the 'startup' datastore, and when it is\nwritten, it MUST be written to the 'running' and 'startup'\ndatastores.  Note that it is up to the implementation to\n\ndecide whether to modify this single leaf in 'startup' or\nperform an implicit copy-config from 'running' to\n'startup'.\n\nIf the device does not support ':startup', ifAlias MUST\nbe mapped to the 'description' leaf in the 'running'\ndatastore.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/interfaces/interface[name=*]/subinterfaces/subinterface[index=*]/config/enabled", ValueType: configapi.ValueType_BOOL, Units: "", Description: "This leaf contains the configured, desired state of the\ninterface.\n\nSystems that implement the IF-MIB use the value of this\nleaf in the 'running' datastore to set\nIF-MIB.ifAdminStatus to 'up' or 'down' after an ifEntry\nhas been initialized, as described in RFC 2863.\n\nChanges in this leaf in the 'running' datastore are\nreflected in ifAdminStatus, but if ifAdminStatus is\nchanged over SNMP, this leaf is not affected.", Mandatory: false, Default: "true", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enabled"},
+	{Path: "/interfaces/interface[name=*]/subinterfaces/subinterface[index=*]/config/index", ValueType: configapi.ValueType_UINT, Units: "", Description: "The index of the subinterface, or logical interface number.\nOn systems with no support for subinterfaces, or not using\nsubinterfaces, this value should default to 0, i.e., the\ndefault subinterface.", Mandatory: false, Default: "0", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "index"},
+	{Path: "/interfaces/interface[name=*]/subinterfaces/subinterface[index=*]/index", ValueType: configapi.ValueType_STRING, Units: "", Description: "The index number of the subinterface -- used to address\nthe logical interface", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "index"},
+	{Path: "/system/aaa/accounting/config/accounting-method", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "The method used for AAA accounting for this event\ntype.  The method is defined by the destination for\naccounting data, which may be specified as the group of\nall TACACS+/RADIUS servers, a defined server group, or\nthe local system.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "accounting-method"},
+	{Path: "/system/aaa/accounting/events/event[event-type=*]/config/event-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "The type of activity to record at the AAA accounting\nserver", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "event-type"},
+	{Path: "/system/aaa/accounting/events/event[event-type=*]/config/record", ValueType: configapi.ValueType_STRING, Units: "", Description: "Type of record to send to the accounting server for this\nactivity type", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "record"},
+	{Path: "/system/aaa/accounting/events/event[event-type=*]/event-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the event-type being logged at the\naccounting server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "event-type"},
+	{Path: "/system/aaa/authentication/admin-user/config/admin-password", ValueType: configapi.ValueType_STRING, Units: "", Description: "The admin/root password, supplied as a cleartext string.\nThe system should hash and only store the password as a\nhashed value.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "admin-password"},
+	{Path: "/system/aaa/authentication/admin-user/config/admin-password-hashed", ValueType: configapi.ValueType_STRING, Units: "", Description: "The admin/root password, supplied as a hashed value\nusing the notation described in the definition of the\ncrypt-password-type.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "admin-password-hashed"},
+	{Path: "/system/aaa/authentication/config/authentication-method", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "Ordered list of authentication methods for users.  This\ncan be either a reference to a server group, or a well-\ndefined designation in the AAA_METHOD_TYPE identity.  If\nauthentication fails with one method, the next defined\nmethod is tried -- failure of all methods results in the\nuser being denied access.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "authentication-method"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/config/password", ValueType: configapi.ValueType_STRING, Units: "", Description: "The user password, supplied as cleartext.  The system\nmust hash the value and only store the hashed value.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "password"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/config/password-hashed", ValueType: configapi.ValueType_STRING, Units: "", Description: "The user password, supplied as a hashed value\nusing the notation described in the definition of the\ncrypt-password-type.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "password-hashed"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/config/role", ValueType: configapi.ValueType_STRING, Units: "", Description: "Role assigned to the user.  The role may be supplied\nas a string or a role defined by the SYSTEM_DEFINED_ROLES\nidentity.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "role"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/config/ssh-key", ValueType: configapi.ValueType_STRING, Units: "", Description: "SSH public key for the user (RSA or DSA)", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "ssh-key"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/config/username", ValueType: configapi.ValueType_STRING, Units: "", Description: "Assigned username for this user", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "username"},
+	{Path: "/system/aaa/authentication/users/user[username=*]/username", ValueType: configapi.ValueType_STRING, Units: "", Description: "References the configured username for the user", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "username"},
+	{Path: "/system/aaa/authorization/config/authorization-method", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "Ordered list of methods for authorizing commands.  The first\nmethod that provides a response (positive or negative) should\nbe used.  The list may contain a well-defined method such\nas the set of all TACACS or RADIUS servers, or the name of\na defined AAA server group.  The system must validate\nthat the named server group exists.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "authorization-method"},
+	{Path: "/system/aaa/authorization/events/event[event-type=*]/config/event-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "The type of event to record at the AAA authorization\nserver", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "event-type"},
+	{Path: "/system/aaa/authorization/events/event[event-type=*]/event-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the event-type list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "event-type"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Name for the server group", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/config/type", ValueType: configapi.ValueType_STRING, Units: "", Description: "AAA server type -- all servers in the group must be of this\ntype", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "type"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to configured name of the server group", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the configured address of the AAA server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "address"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/config/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Address of the authentication server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Name assigned to the server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/config/timeout", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set the timeout in seconds on responses from the AAA\nserver", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "timeout"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/config/acct-port", ValueType: configapi.ValueType_UINT, Units: "", Description: "Port number for accounting requests", Mandatory: false, Default: "1813", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "acct-port"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/config/auth-port", ValueType: configapi.ValueType_UINT, Units: "", Description: "Port number for authentication requests", Mandatory: false, Default: "1812", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "auth-port"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/config/retransmit-attempts", ValueType: configapi.ValueType_UINT, Units: "", Description: "Number of times the system may resend a request to the\nRADIUS server when it is unresponsive", Mandatory: false, Default: "", Range: []string{"0..255"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "retransmit-attempts"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/config/secret-key", ValueType: configapi.ValueType_STRING, Units: "", Description: "The unencrypted shared key used between the authentication\nserver and the device.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "secret-key"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/radius/config/source-address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Source IP address to use in messages to the RADIUS server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "source-address"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/tacacs/config/port", ValueType: configapi.ValueType_UINT, Units: "", Description: "The port number on which to contact the TACACS server", Mandatory: false, Default: "49", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "port"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/tacacs/config/secret-key", ValueType: configapi.ValueType_STRING, Units: "", Description: "The unencrypted shared key used between the authentication\nserver and the device.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "secret-key"},
+	{Path: "/system/aaa/server-groups/server-group[name=*]/servers/server[address=*]/tacacs/config/source-address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Source IP address to use in messages to the TACACS server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "source-address"},
+	{Path: "/system/clock/config/timezone-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "The TZ database name to use for the system, such\nas 'Europe/Stockholm'.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "timezone-name"},
+	{Path: "/system/config/domain-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Specifies the domain name used to form fully qualified name\nfor unqualified hostnames.", Mandatory: false, Default: "", Range: nil, Length: []string{"1..253"}, TypeOpts: nil, IsAKey: false, AttrName: "domain-name"},
+	{Path: "/system/config/hostname", ValueType: configapi.ValueType_STRING, Units: "", Description: "The hostname of the device -- should be a single domain\nlabel, without the domain.", Mandatory: false, Default: "", Range: nil, Length: []string{"1..253"}, TypeOpts: nil, IsAKey: false, AttrName: "hostname"},
+	{Path: "/system/config/login-banner", ValueType: configapi.ValueType_STRING, Units: "", Description: "The console login message displayed before the login prompt,\ni.e., before a user logs into the system.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "login-banner"},
+	{Path: "/system/config/motd-banner", ValueType: configapi.ValueType_STRING, Units: "", Description: "The console message displayed after a user logs into the\nsystem.  They system may append additional standard\ninformation such as the current system date and time, uptime,\nlast login timestamp, etc.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "motd-banner"},
+	{Path: "/system/dns/config/search", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "An ordered list of domains to search when resolving\na host name.", Mandatory: false, Default: "", Range: nil, Length: []string{"1..253"}, TypeOpts: nil, IsAKey: false, AttrName: "search"},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/config/alias", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "Additional aliases for the hostname", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "alias"},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/config/hostname", ValueType: configapi.ValueType_STRING, Units: "", Description: "Hostname for the static DNS entry", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "hostname"},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/config/ipv4-address", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "List of IPv4 addressses for the host entry", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "ipv4-address"},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/config/ipv6-address", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "List of IPv6 addresses for the host entry", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "ipv6-address"},
+	{Path: "/system/dns/host-entries/host-entry[hostname=*]/hostname", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the hostname list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "hostname"},
+	{Path: "/system/dns/servers/server[address=*]/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "References the configured address of the DNS server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "address"},
+	{Path: "/system/dns/servers/server[address=*]/config/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "The address of the DNS server, can be either IPv4\nor IPv6.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/system/dns/servers/server[address=*]/config/port", ValueType: configapi.ValueType_UINT, Units: "", Description: "The port number of the DNS server.", Mandatory: false, Default: "53", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "port"},
+	{Path: "/system/logging/console/selectors/selector[facility=*][severity=*]/config/facility", ValueType: configapi.ValueType_STRING, Units: "", Description: "Specifies the facility, or class of messages to log", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "facility"},
+	{Path: "/system/logging/console/selectors/selector[facility=*][severity=*]/config/severity", ValueType: configapi.ValueType_STRING, Units: "", Description: "Specifies that only messages of the given severity (or\ngreater severity) for the corresonding facility are logged", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "severity"},
+	{Path: "/system/logging/console/selectors/selector[facility=*][severity=*]/facility", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to facility list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "facility"},
+	{Path: "/system/logging/console/selectors/selector[facility=*][severity=*]/severity", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to severity list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "severity"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/config/host", ValueType: configapi.ValueType_STRING, Units: "", Description: "IP address or hostname of the remote log server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "host"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/config/remote-port", ValueType: configapi.ValueType_UINT, Units: "", Description: "Sets the destination port number for syslog UDP messages to\nthe server.  The default for syslog is 514.", Mandatory: false, Default: "514", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "remote-port"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/config/source-address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Source IP address for packets to the log server", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "source-address"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/host", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to the host list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "host"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/selectors/selector[facility=*][severity=*]/config/facility", ValueType: configapi.ValueType_STRING, Units: "", Description: "Specifies the facility, or class of messages to log", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "facility"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/selectors/selector[facility=*][severity=*]/config/severity", ValueType: configapi.ValueType_STRING, Units: "", Description: "Specifies that only messages of the given severity (or\ngreater severity) for the corresonding facility are logged", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "severity"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/selectors/selector[facility=*][severity=*]/facility", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to facility list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "facility"},
+	{Path: "/system/logging/remote-servers/remote-server[host=*]/selectors/selector[facility=*][severity=*]/severity", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to severity list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "severity"},
+	{Path: "/system/ntp/config/enable-ntp-auth", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Enable or disable NTP authentication -- when enabled, the\nsystem will only use packets containing a trusted\nauthentication key to synchronize the time.", Mandatory: false, Default: "false", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enable-ntp-auth"},
+	{Path: "/system/ntp/config/enabled", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Enables the NTP protocol and indicates that the system should\nattempt to synchronize the system clock with an NTP server\nfrom the servers defined in the 'ntp/server' list.", Mandatory: false, Default: "false", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enabled"},
+	{Path: "/system/ntp/config/ntp-source-address", ValueType: configapi.ValueType_STRING, Units: "", Description: "Source address to use on outgoing NTP packets", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "ntp-source-address"},
+	{Path: "/system/ntp/ntp-keys/ntp-key[key-id=*]/config/key-id", ValueType: configapi.ValueType_UINT, Units: "", Description: "Integer identifier used by the client and server to\ndesignate a secret key.  The client and server must use\nthe same key id.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "key-id"},
+	{Path: "/system/ntp/ntp-keys/ntp-key[key-id=*]/config/key-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "Encryption type used for the NTP authentication key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "key-type"},
+	{Path: "/system/ntp/ntp-keys/ntp-key[key-id=*]/config/key-value", ValueType: configapi.ValueType_STRING, Units: "", Description: "NTP authentication key value", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "key-value"},
+	{Path: "/system/ntp/ntp-keys/ntp-key[key-id=*]/key-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to auth key-id list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "key-id"},
+	{Path: "/system/ntp/servers/server[address=*]/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "References the configured address or hostname of the\nNTP server.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "address"},
+	{Path: "/system/ntp/servers/server[address=*]/config/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "The address or hostname of the NTP server.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/system/ntp/servers/server[address=*]/config/association-type", ValueType: configapi.ValueType_STRING, Units: "", Description: "The desired association type for this NTP server.", Mandatory: false, Default: "SERVER", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "association-type"},
+	{Path: "/system/ntp/servers/server[address=*]/config/iburst", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Indicates whether this server should enable burst\nsynchronization or not.", Mandatory: false, Default: "false", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "iburst"},
+	{Path: "/system/ntp/servers/server[address=*]/config/port", ValueType: configapi.ValueType_UINT, Units: "", Description: "The port number of the NTP server.", Mandatory: false, Default: "123", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "port"},
+	{Path: "/system/ntp/servers/server[address=*]/config/prefer", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Indicates whether this server should be preferred\nor not.", Mandatory: false, Default: "false", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "prefer"},
+	{Path: "/system/ntp/servers/server[address=*]/config/version", ValueType: configapi.ValueType_UINT, Units: "", Description: "Version number to put in outgoing NTP packets", Mandatory: false, Default: "4", Range: []string{"1..4"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "version"},
+	{Path: "/system/openflow/agent/config/backoff-interval", ValueType: configapi.ValueType_UINT, Units: "", Description: "Openflow agent connection backoff interval.", Mandatory: false, Default: "", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "backoff-interval"},
+	{Path: "/system/openflow/agent/config/datapath-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "Datapath unique ID. The lower 48-bits are for\na MAC address, while the upper 16-bits are\nimplementer-defined.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "datapath-id"},
+	{Path: "/system/openflow/agent/config/failure-mode", ValueType: configapi.ValueType_STRING, Units: "", Description: "Failure mode for Openflow.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "failure-mode"},
+	{Path: "/system/openflow/agent/config/inactivity-probe", ValueType: configapi.ValueType_UINT, Units: "", Description: "Openflow agent inactivity probe period.", Mandatory: false, Default: "", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "inactivity-probe"},
+	{Path: "/system/openflow/agent/config/max-backoff", ValueType: configapi.ValueType_UINT, Units: "", Description: "Openflow agent max backoff time.", Mandatory: false, Default: "", Range: []string{"0..4294967295"}, Length: nil, TypeOpts: []uint64{32}, IsAKey: false, AttrName: "max-backoff"},
+	{Path: "/system/openflow/controllers/controller[name=*]/config/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "Name of this Openflow controller. All connections\nfor the same controller need to have the same name.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "name"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/aux-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "Reference to auxiliary id list key", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "aux-id"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "The IP address of the controller.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/aux-id", ValueType: configapi.ValueType_UINT, Units: "", Description: "Controller auxiliary ID. Must be 0 for the main controller.\nOne controller may have multiple auxiliary connections as\nspecified by the Openflow protocol. Besides configuring the\nmain controller, it is also possible to configure auxiliary\nconnections. The main controller must have the aux-id\nset to zero. All others must have an aux-id different\nfrom 0.", Mandatory: false, Default: "", Range: []string{"0..15"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "aux-id"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/port", ValueType: configapi.ValueType_UINT, Units: "", Description: "Controller port to use.", Mandatory: false, Default: "6653", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "port"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/priority", ValueType: configapi.ValueType_UINT, Units: "", Description: "Optional value for servicing auxiliary connections with\ndifferent priorities.", Mandatory: false, Default: "", Range: []string{"0..255"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "priority"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/source-interface", ValueType: configapi.ValueType_STRING, Units: "", Description: "Optionally specify the source interface for the\ncontroller connection.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "source-interface"},
+	{Path: "/system/openflow/controllers/controller[name=*]/connections/connection[aux-id=*]/config/transport", ValueType: configapi.ValueType_STRING, Units: "", Description: "Controller transport protocol used.", Mandatory: false, Default: "TCP", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "transport"},
+	{Path: "/system/openflow/controllers/controller[name=*]/name", ValueType: configapi.ValueType_STRING, Units: "", Description: "The name identifies the controller.", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "name"},
+	{Path: "/system/ssh-server/config/enable", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Enables the ssh server.  The ssh server is enabled by\ndefault.", Mandatory: false, Default: "true", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enable"},
+	{Path: "/system/ssh-server/config/protocol-version", ValueType: configapi.ValueType_STRING, Units: "", Description: "Set the protocol version for SSH connections to the system", Mandatory: false, Default: "V2", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "protocol-version"},
+	{Path: "/system/ssh-server/config/rate-limit", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set a limit on the number of connection attempts per\nminute to the system for the protocol.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "rate-limit"},
+	{Path: "/system/ssh-server/config/session-limit", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set a limit on the number of simultaneous active terminal\nsessions to the system for the protocol (e.g., ssh,\ntelnet, ...) ", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "session-limit"},
+	{Path: "/system/ssh-server/config/timeout", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set the idle timeout in seconds on terminal connections to\nthe system for the protocol.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "timeout"},
+	{Path: "/system/telnet-server/config/enable", ValueType: configapi.ValueType_BOOL, Units: "", Description: "Enables the telnet server.  Telnet is disabled by\ndefault", Mandatory: false, Default: "false", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "enable"},
+	{Path: "/system/telnet-server/config/rate-limit", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set a limit on the number of connection attempts per\nminute to the system for the protocol.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "rate-limit"},
+	{Path: "/system/telnet-server/config/session-limit", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set a limit on the number of simultaneous active terminal\nsessions to the system for the protocol (e.g., ssh,\ntelnet, ...) ", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "session-limit"},
+	{Path: "/system/telnet-server/config/timeout", ValueType: configapi.ValueType_UINT, Units: "", Description: "Set the idle timeout in seconds on terminal connections to\nthe system for the protocol.", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "timeout"},
+}
+
+// ReadOnlyPaths returns the paths of the state attributes of the model, extracted from its
+// schema when the model was compiled
+func ReadOnlyPaths() []*admin.ReadOnlyPath {
+	return readOnlyPaths
+}
+
+// ReadWritePaths returns the paths of the configuration attributes of the model, extracted
+// from its schema when the model was compiled
+func ReadWritePaths() []*admin.ReadWritePath {
+	return readWritePaths
+}
@@ -5,14 +5,14 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.22.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
 	- e2-o-cu-cp@2020-05-01.yang
 	- e2-o-cu-up@2020-05-01.yang
 	- e2-o-du@2020-05-01.yang
 	- e2node@2020-05-01.yang
 Imported modules were sourced from:
-	- /config-model/yang/...
+	- yang/...
 */
 package api
 
@@ -209,49 +209,50 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6b, 0x1b, 0x3d,
-		0x10, 0xbe, 0xfb, 0x57, 0x08, 0x9d, 0x0d, 0xaf, 0xe3, 0x37, 0x6d, 0xb0, 0x6f, 0x21, 0x4e, 0x68,
-		0x29, 0x31, 0xc1, 0x49, 0x7b, 0x29, 0xa1, 0x88, 0xdd, 0x59, 0x5b, 0x74, 0x2d, 0x2d, 0x5a, 0xad,
-		0x9b, 0x50, 0xfc, 0xdf, 0xcb, 0x7e, 0x78, 0x9d, 0xfd, 0x94, 0xb4, 0x75, 0x0f, 0x6e, 0x27, 0x97,
-		0x8d, 0xa5, 0x99, 0xd1, 0xa3, 0x99, 0x47, 0x7a, 0x10, 0xcc, 0xcf, 0x11, 0x21, 0x84, 0xd0, 0x25,
-		0xdb, 0x02, 0x9d, 0x13, 0xea, 0xc3, 0x8e, 0x7b, 0x40, 0xc7, 0xf9, 0xe8, 0x27, 0x2e, 0x7c, 0x3a,
-		0x27, 0x17, 0xc5, 0xcf, 0x1b, 0x29, 0x02, 0xbe, 0xa6, 0x73, 0x32, 0x29, 0x06, 0x16, 0x5c, 0xd1,
-		0x39, 0xc9, 0x43, 0x64, 0x03, 0x30, 0x15, 0xd2, 0x87, 0xca, 0x58, 0x25, 0x7c, 0x31, 0x3f, 0xae,
-		0xce, 0x2e, 0x20, 0xf6, 0x14, 0x8f, 0x34, 0x97, 0x22, 0x35, 0x7a, 0xda, 0x00, 0xd1, 0x32, 0x22,
-		0x21, 0xec, 0x20, 0x24, 0x9e, 0x14, 0x9a, 0x71, 0x01, 0xaa, 0xee, 0x55, 0x05, 0x57, 0x0e, 0xd7,
-		0x41, 0x96, 0x13, 0x0f, 0x0a, 0x02, 0xfe, 0xd2, 0xc0, 0x56, 0xc3, 0x57, 0x5b, 0x25, 0x9b, 0x7d,
-		0x94, 0x89, 0xf2, 0xa0, 0xd5, 0x33, 0x47, 0x02, 0xaf, 0x3f, 0xa4, 0x4a, 0xc1, 0xd0, 0x28, 0x5f,
-		0x64, 0xdc, 0x6e, 0xf8, 0x81, 0xc5, 0xd7, 0x6a, 0x9d, 0x6c, 0x41, 0x68, 0x3a, 0x27, 0x5a, 0x25,
-		0xd0, 0x61, 0xf8, 0xc6, 0x2a, 0xc5, 0xd4, 0x30, 0xda, 0x57, 0x46, 0xf6, 0xf5, 0x7c, 0xd6, 0xca,
-		0x52, 0x4e, 0x70, 0xa1, 0x41, 0xed, 0x58, 0x18, 0x77, 0xef, 0xe5, 0x90, 0x89, 0xa3, 0x69, 0x07,
-		0xc4, 0x5a, 0xd1, 0x3e, 0x1e, 0xec, 0x49, 0x20, 0x15, 0xb9, 0x9d, 0x92, 0x18, 0x54, 0x4a, 0xa5,
-		0x4e, 0xff, 0xf6, 0xf2, 0x19, 0xcb, 0x68, 0x53, 0x4e, 0xbb, 0xb2, 0xda, 0x96, 0xd7, 0xb9, 0xcc,
-		0xce, 0xe5, 0xb6, 0x2e, 0x7b, 0x7b, 0xf9, 0x3b, 0x68, 0x60, 0xa4, 0xc3, 0x31, 0x95, 0xbe, 0x17,
-		0xdd, 0x03, 0x8b, 0x57, 0x10, 0x49, 0xa5, 0x1f, 0x40, 0x7d, 0xb6, 0xc8, 0xc6, 0x21, 0xb7, 0x6d,
-		0xce, 0x86, 0x6d, 0x76, 0x50, 0x27, 0x63, 0x8e, 0xca, 0xc2, 0x10, 0x19, 0x90, 0x41, 0x81, 0x03,
-		0x96, 0x84, 0x69, 0xfa, 0xbe, 0xf6, 0x1a, 0x66, 0xc6, 0x17, 0x13, 0xda, 0x6b, 0xf4, 0x6c, 0x58,
-		0xac, 0x20, 0xf0, 0xc4, 0x60, 0x66, 0x22, 0xb2, 0x0b, 0xa1, 0xdd, 0x88, 0xed, 0x4a, 0xf0, 0xc1,
-		0x44, 0x1f, 0x4c, 0x78, 0x67, 0xe2, 0xf7, 0x1f, 0x00, 0xc3, 0x41, 0x28, 0x57, 0x7b, 0x7a, 0x8d,
-		0xc0, 0x2d, 0xcf, 0x09, 0x17, 0xfa, 0x7f, 0xab, 0x5c, 0x17, 0xac, 0xb8, 0xb2, 0x30, 0x5d, 0x31,
-		0xb1, 0x06, 0x2b, 0xae, 0xa6, 0x7f, 0x76, 0xb5, 0xcb, 0x02, 0xdf, 0x73, 0x61, 0x5d, 0xec, 0xd2,
-		0xe9, 0x0b, 0x0b, 0x13, 0x30, 0xd3, 0xb4, 0xe1, 0x77, 0xa7, 0x98, 0x97, 0x9e, 0xe4, 0x05, 0x5f,
-		0x73, 0x1d, 0x0f, 0x08, 0xb0, 0x84, 0x35, 0xd3, 0x7c, 0x97, 0xae, 0x1d, 0xb0, 0x30, 0x06, 0x6b,
-		0xef, 0xfd, 0xd8, 0x21, 0x25, 0xec, 0x65, 0x78, 0x4a, 0x2e, 0xa7, 0xb3, 0xcb, 0xd9, 0xfb, 0xab,
-		0xe9, 0xec, 0xdd, 0xf9, 0xe4, 0x66, 0x74, 0x1a, 0xab, 0xe7, 0xd1, 0x30, 0xff, 0x9e, 0xda, 0xd0,
-		0x15, 0xf3, 0xb9, 0xac, 0xdc, 0xec, 0x37, 0x10, 0x86, 0xf6, 0x8a, 0xd3, 0xe1, 0x7f, 0x12, 0xd1,
-		0x69, 0xc6, 0x46, 0xd5, 0x41, 0xd5, 0x41, 0xd5, 0x41, 0xd5, 0x41, 0xd5, 0xf9, 0xdb, 0x54, 0xc7,
-		0xe5, 0x95, 0x33, 0x4c, 0x17, 0x50, 0x71, 0x50, 0x71, 0x50, 0x71, 0x50, 0x71, 0x50, 0x71, 0xfe,
-		0x45, 0xc5, 0x79, 0xf4, 0x36, 0xe0, 0xff, 0xc6, 0x3b, 0xa7, 0xc3, 0xff, 0x24, 0xaa, 0x33, 0x34,
-		0x36, 0xea, 0x0e, 0xea, 0x0e, 0xea, 0x0e, 0xea, 0x0e, 0xea, 0xce, 0x39, 0xe9, 0x8e, 0xcb, 0x4b,
-		0xa7, 0xd5, 0xfb, 0x0f, 0x69, 0x0e, 0xbe, 0x74, 0x50, 0x71, 0x50, 0x71, 0x50, 0x71, 0x50, 0x71,
-		0xce, 0x40, 0x71, 0x9c, 0x7a, 0x0e, 0xae, 0x85, 0x90, 0x9a, 0x15, 0x82, 0xd0, 0xd3, 0x7a, 0x10,
-		0x7b, 0x1b, 0xd8, 0xb2, 0x88, 0xe9, 0x4d, 0x7a, 0x28, 0xff, 0xcb, 0xfb, 0x83, 0x0e, 0x1f, 0x53,
-		0x0f, 0x4a, 0x1e, 0x41, 0xab, 0xc4, 0xd3, 0xa2, 0x38, 0xd6, 0xb7, 0xd3, 0xa5, 0xf4, 0xe1, 0x5b,
-		0xf1, 0x29, 0x9b, 0x52, 0xda, 0xef, 0x9f, 0xbd, 0x63, 0x5f, 0x8d, 0x61, 0x53, 0xbd, 0x9b, 0x69,
-		0xeb, 0x2b, 0xea, 0x81, 0x5e, 0x05, 0x7c, 0x84, 0x95, 0xff, 0x57, 0x00, 0xeb, 0x02, 0x44, 0x79,
-		0x7c, 0xc7, 0xbe, 0xc3, 0x4a, 0xca, 0xe6, 0xa5, 0x5d, 0x07, 0x49, 0xdf, 0x4e, 0x55, 0xf0, 0x2c,
-		0xf2, 0x56, 0xb0, 0x7c, 0xc1, 0xd1, 0xfe, 0x17, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0xd6, 0x6a, 0xc4, 0x3a, 0x29, 0x26, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x9a, 0xdf, 0x6b, 0xdb, 0x3a,
+		0x14, 0xc7, 0xdf, 0xfd, 0x57, 0x7c, 0x39, 0xcf, 0x81, 0xdb, 0xe6, 0xf6, 0xde, 0xd2, 0xbc, 0x95,
+		0xa6, 0x65, 0x63, 0xb4, 0x94, 0xb4, 0xdb, 0xcb, 0x28, 0x43, 0xd8, 0x27, 0x89, 0x98, 0x2b, 0x19,
+		0x59, 0xce, 0x5a, 0x46, 0xfe, 0xf7, 0xe1, 0xda, 0x49, 0x1b, 0xc7, 0x3f, 0x64, 0xaf, 0x65, 0x2b,
+		0x28, 0x2f, 0x21, 0xd2, 0x39, 0x47, 0x47, 0xe7, 0x7c, 0x95, 0x0f, 0x02, 0xfd, 0x0c, 0x00, 0x80,
+		0xae, 0xc4, 0x3d, 0xd3, 0x04, 0x14, 0xf1, 0x4a, 0x86, 0x4c, 0xa3, 0x62, 0xf4, 0x93, 0x54, 0x11,
+		0x4d, 0x70, 0x58, 0xfe, 0x3c, 0xd3, 0x6a, 0x2e, 0x17, 0x34, 0xc1, 0x41, 0x39, 0x30, 0x95, 0x86,
+		0x26, 0x28, 0x42, 0x00, 0x00, 0xf1, 0x58, 0xe9, 0x88, 0x77, 0xc6, 0x76, 0xc2, 0x97, 0xf3, 0xa3,
+		0xdd, 0xd9, 0x29, 0xa7, 0xa1, 0x91, 0x89, 0x95, 0x5a, 0xe5, 0x46, 0xb7, 0x4b, 0x86, 0xd5, 0x09,
+		0x62, 0x5e, 0x71, 0x8c, 0x50, 0x2b, 0x2b, 0xa4, 0x62, 0x53, 0xf5, 0xda, 0x4d, 0x6e, 0x3b, 0x5c,
+		0x4d, 0x72, 0x3b, 0x71, 0x6d, 0x78, 0x2e, 0x1f, 0xf6, 0x72, 0xab, 0xe4, 0x47, 0xa3, 0xfd, 0xd9,
+		0x1b, 0x9d, 0x99, 0x90, 0x6b, 0x3d, 0x8b, 0x4c, 0xf8, 0xf1, 0x87, 0x36, 0x79, 0x32, 0x94, 0x14,
+		0x8b, 0x8c, 0xea, 0x0d, 0x3f, 0x88, 0xf4, 0xd4, 0x2c, 0xb2, 0x7b, 0x56, 0x96, 0x26, 0xb0, 0x26,
+		0xe3, 0x06, 0xc3, 0x17, 0x56, 0x79, 0x4e, 0x7b, 0x46, 0xeb, 0x9d, 0x91, 0x75, 0xb5, 0x9e, 0x95,
+		0xb6, 0x6c, 0x27, 0xa4, 0xb2, 0x6c, 0x56, 0x22, 0x4e, 0x9b, 0xf7, 0xb2, 0xa9, 0xc4, 0xb3, 0x69,
+		0x43, 0x8a, 0x95, 0xa6, 0x7d, 0xdc, 0xd8, 0x63, 0xae, 0x0d, 0xce, 0xc7, 0x48, 0xd9, 0xe4, 0x52,
+		0x6a, 0xf4, 0xaf, 0x6f, 0x5f, 0x67, 0x1b, 0x5d, 0xda, 0xe9, 0xd6, 0x56, 0xd7, 0xf6, 0xf6, 0x6e,
+		0x73, 0xef, 0x76, 0x3b, 0xb7, 0xbd, 0xbe, 0xfd, 0x0d, 0x32, 0xe8, 0x94, 0xc3, 0x73, 0x29, 0xa3,
+		0x30, 0xb9, 0x64, 0x91, 0xce, 0x38, 0xd1, 0xc6, 0x5e, 0xb3, 0xf9, 0xec, 0x50, 0x8d, 0x4d, 0x6d,
+		0xeb, 0x9c, 0x3b, 0xb6, 0xd9, 0x20, 0x9d, 0x27, 0xe5, 0x98, 0xa7, 0x30, 0xd0, 0x73, 0x0c, 0x0a,
+		0x3c, 0x17, 0x59, 0x9c, 0x97, 0xef, 0x6b, 0xab, 0x21, 0x00, 0xd0, 0xe1, 0x01, 0xb5, 0x1a, 0xdd,
+		0x75, 0x2c, 0x56, 0x0a, 0xf8, 0xa0, 0xc3, 0xac, 0x4b, 0xc8, 0x7d, 0x04, 0xdd, 0x4f, 0xd8, 0x7d,
+		0x05, 0x3e, 0x58, 0xe8, 0x83, 0x05, 0xdf, 0x5b, 0xf8, 0xed, 0x07, 0xa0, 0xe3, 0x20, 0x6c, 0x3e,
+		0x74, 0xfb, 0x98, 0x70, 0xbf, 0x3a, 0x67, 0x52, 0xd9, 0x7f, 0x9d, 0x6a, 0x5d, 0xaa, 0xe2, 0xd8,
+		0xc1, 0x74, 0x26, 0xd4, 0x82, 0x9d, 0xb4, 0x0a, 0xc0, 0xb1, 0x77, 0x00, 0x40, 0x97, 0x52, 0xd1,
+		0xa4, 0x87, 0x03, 0x00, 0xd0, 0x17, 0x11, 0x67, 0xdc, 0x2d, 0xd3, 0xea, 0x87, 0x2e, 0x8c, 0x08,
+		0xf3, 0x93, 0x3c, 0x95, 0x0b, 0x69, 0xd3, 0x01, 0x01, 0xae, 0x78, 0x21, 0xac, 0x5c, 0xe5, 0x6b,
+		0xcf, 0x45, 0x9c, 0xb2, 0xb3, 0xf7, 0x7a, 0xd4, 0xa3, 0x24, 0xe2, 0x61, 0x78, 0x49, 0x8e, 0xc6,
+		0x27, 0x47, 0x27, 0xff, 0x1f, 0x8f, 0x4f, 0xfe, 0x7b, 0x3f, 0xb5, 0x09, 0x5e, 0xc7, 0xea, 0x2e,
+		0x18, 0xe6, 0xdf, 0xd2, 0x1b, 0x9a, 0x89, 0x48, 0xea, 0x9d, 0x7f, 0xf6, 0x33, 0x8e, 0x63, 0x77,
+		0xe2, 0x34, 0xf8, 0xbf, 0x0a, 0x74, 0xf6, 0x63, 0x7b, 0xea, 0x00, 0x9e, 0x3a, 0x0e, 0xc7, 0xc5,
+		0x53, 0xc7, 0x53, 0x07, 0xf0, 0xd4, 0x79, 0x37, 0xd4, 0xe9, 0x73, 0xcb, 0x19, 0xc6, 0x05, 0x4f,
+		0x1c, 0xc0, 0x13, 0x07, 0xf0, 0xc4, 0xf1, 0xc4, 0xf1, 0xc4, 0x79, 0x93, 0xda, 0xfc, 0xc5, 0xc4,
+		0xb9, 0x09, 0x97, 0x1c, 0xfd, 0xc6, 0x3d, 0xa7, 0xc1, 0xff, 0x55, 0xa8, 0x33, 0x34, 0xb6, 0xe7,
+		0x0e, 0xe0, 0xb9, 0xe3, 0xb9, 0xe3, 0xb9, 0x03, 0x78, 0xee, 0xbc, 0x17, 0xee, 0xf4, 0xb9, 0xe9,
+		0xd4, 0x7a, 0xbf, 0x11, 0x73, 0xfc, 0x4d, 0x07, 0xf0, 0xc4, 0x71, 0x38, 0x2a, 0x9e, 0x38, 0x9e,
+		0x38, 0x80, 0x27, 0xce, 0x9f, 0x24, 0x4e, 0xaf, 0x37, 0x07, 0xa7, 0x4a, 0x69, 0x2b, 0x4a, 0x20,
+		0x34, 0x37, 0x89, 0xd2, 0x70, 0xc9, 0xf7, 0x22, 0x11, 0x76, 0x49, 0x13, 0xd0, 0x3f, 0xc5, 0xfb,
+		0xa0, 0xcd, 0x57, 0xd7, 0x1b, 0x94, 0x22, 0x82, 0x35, 0x59, 0x68, 0x55, 0x79, 0xac, 0xcf, 0xc7,
+		0x57, 0x3a, 0xe2, 0x6f, 0xe5, 0xd7, 0xf6, 0x51, 0x0a, 0x05, 0x6e, 0x5b, 0x6a, 0x7f, 0x57, 0xd3,
+		0xb1, 0xa9, 0xd6, 0xcd, 0xd4, 0x6c, 0xa1, 0x2d, 0x75, 0x0a, 0xea, 0xd3, 0x5a, 0x07, 0x2f, 0x12,
+		0x6b, 0x4a, 0x88, 0x64, 0x7a, 0x21, 0xbe, 0xf3, 0x4c, 0xeb, 0xfd, 0x3f, 0xed, 0x6a, 0x92, 0x34,
+		0x0a, 0x1a, 0xf2, 0x99, 0x16, 0x4f, 0xc1, 0x8a, 0x05, 0x83, 0xf5, 0x2f, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x03, 0x00, 0xd6, 0x6a, 0xc4, 0x3a, 0x29, 0x26, 0x00, 0x00,
 	}
 )
 
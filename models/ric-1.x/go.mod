@@ -3,6 +3,7 @@ module github.com/onosproject/config-models/models/ric
 go 1.16
 
 require (
+	github.com/SeanCondon/xpath v0.0.0-20220628084621-97cfdefbc266
 	github.com/ghodss/yaml v1.0.0
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0 // indirect
 	github.com/onosproject/config-models v0.10.23
@@ -11,5 +12,9 @@ require (
 	github.com/openconfig/gnmi v0.0.0-20210914185457-51254b657b7d
 	github.com/openconfig/goyang v1.0.0
 	github.com/openconfig/ygot v0.22.1
+	github.com/stretchr/testify v1.7.0
 	google.golang.org/grpc v1.41.0
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
+
+replace github.com/onosproject/config-models => ../..
@@ -5,7 +5,7 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.22.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
 	- ietf-inet-types.yang
 	- ietf-yang-types.yang
@@ -20,7 +20,7 @@ using the following YANG input files:
 	- openconfig-interfaces@2017-07-14.yang
 	- openconfig-types@2017-08-16.yang
 Imported modules were sourced from:
-	- /config-model/yang/...
+	- yang/...
 */
 package api
 
@@ -1338,341 +1338,347 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xff, 0x73, 0xda, 0xb8,
-		0xb6, 0xff, 0xbd, 0x7f, 0x85, 0x86, 0x79, 0x77, 0x06, 0xba, 0xb1, 0x63, 0x48, 0x48, 0x1b, 0x66,
-		0xee, 0xf4, 0x66, 0xdb, 0xbb, 0x77, 0xf3, 0xb6, 0xdd, 0xcd, 0xb4, 0xdd, 0x9d, 0x3b, 0x8b, 0x69,
-		0x46, 0xb1, 0x05, 0xe8, 0xc5, 0xc8, 0x5e, 0x4b, 0xce, 0x97, 0x4d, 0xf2, 0xbf, 0xbf, 0xb1, 0x6c,
-		0x8c, 0x0d, 0xd8, 0x96, 0x6c, 0x48, 0x1d, 0xd0, 0x4c, 0xa7, 0x10, 0x90, 0x84, 0x2c, 0x1d, 0x7d,
-		0xce, 0xd1, 0xe7, 0x1c, 0x1d, 0x3d, 0xbc, 0x02, 0x00, 0x80, 0xd6, 0xaf, 0x70, 0x86, 0x5a, 0x03,
-		0xd0, 0xb2, 0xd1, 0x0d, 0xb6, 0x50, 0xeb, 0x20, 0xfa, 0xf4, 0x17, 0x4c, 0xec, 0xd6, 0x00, 0x74,
-		0xe3, 0x3f, 0xdf, 0xbb, 0x64, 0x8c, 0x27, 0xad, 0x01, 0x30, 0xe2, 0x0f, 0x3e, 0x60, 0xbf, 0x35,
-		0x00, 0x51, 0x13, 0xfc, 0x03, 0x7b, 0x6a, 0x79, 0x1a, 0x45, 0xfe, 0x0d, 0xca, 0x7e, 0x91, 0xfd,
-		0x8d, 0x54, 0xa1, 0x83, 0x6c, 0x91, 0x0f, 0x88, 0x5a, 0x3e, 0xf6, 0x18, 0x76, 0x49, 0x58, 0xf2,
-		0x0c, 0x38, 0x98, 0x32, 0xe0, 0x8e, 0xc1, 0x87, 0x9f, 0xdf, 0x5f, 0x80, 0x2f, 0xbc, 0x0e, 0x5d,
-		0xae, 0x94, 0xed, 0x65, 0xf2, 0xf1, 0x72, 0x6f, 0x93, 0x2f, 0x2e, 0x7c, 0x34, 0xc6, 0x77, 0x2b,
-		0xfd, 0x5b, 0xe9, 0xe3, 0xd2, 0xef, 0xf0, 0xef, 0xbf, 0xb8, 0x81, 0x6f, 0xa1, 0xb5, 0x75, 0xa3,
-		0xbe, 0xa0, 0xfb, 0x5b, 0xd7, 0x0f, 0xbb, 0xd3, 0xf2, 0xa2, 0x9f, 0x39, 0x58, 0x5f, 0xf0, 0x67,
-		0x48, 0xcf, 0xfc, 0x49, 0x30, 0x43, 0x84, 0xb5, 0x06, 0x80, 0xf9, 0x01, 0xca, 0x29, 0x98, 0x2a,
-		0x15, 0xf5, 0x6a, 0xa5, 0xd8, 0x53, 0xe6, 0x93, 0xa7, 0xe5, 0x21, 0xc5, 0xfe, 0xfa, 0x47, 0x85,
-		0xb6, 0xed, 0x23, 0x4a, 0xf3, 0x9f, 0x65, 0x3e, 0x16, 0xf3, 0x82, 0x39, 0x1d, 0x5c, 0x9a, 0x33,
-		0x48, 0x00, 0xf6, 0x40, 0x49, 0x9d, 0x78, 0xca, 0x8c, 0x9c, 0xaf, 0xf3, 0xa6, 0x4e, 0x64, 0x0a,
-		0x45, 0xa7, 0x52, 0x74, 0x4a, 0xa5, 0xa7, 0x56, 0x7a, 0x8a, 0x25, 0xa6, 0x7a, 0xfd, 0x94, 0xe7,
-		0x4c, 0x7d, 0xd2, 0xee, 0x27, 0x48, 0x6c, 0xc8, 0x5c, 0xff, 0x7e, 0x75, 0x95, 0x24, 0x65, 0xbe,
-		0xde, 0x7b, 0x48, 0x6c, 0x3c, 0xb1, 0x77, 0x73, 0xac, 0xc5, 0xf3, 0xab, 0x11, 0x57, 0xfb, 0xdb,
-		0x25, 0xa8, 0x68, 0x7c, 0xe7, 0xcb, 0xf3, 0x6d, 0x41, 0x99, 0x0b, 0xc8, 0x18, 0xf2, 0x43, 0xf9,
-		0x19, 0x16, 0x0f, 0x51, 0xbb, 0x3d, 0x34, 0xb4, 0xd3, 0xd1, 0xe3, 0xb0, 0xab, 0x9d, 0x8e, 0xa2,
-		0xb7, 0x5d, 0xfe, 0x12, 0xbd, 0xef, 0x0d, 0x0d, 0xed, 0x78, 0xfe, 0xbe, 0x3f, 0x34, 0xb4, 0xfe,
-		0xa8, 0x63, 0x9a, 0x7a, 0xe7, 0xe1, 0xe8, 0x49, 0xbe, 0x62, 0xfb, 0x1f, 0x43, 0xd3, 0xf4, 0x1e,
-		0x7e, 0x7d, 0x0a, 0xff, 0xff, 0xf8, 0x34, 0xfa, 0xa1, 0xf3, 0xae, 0x6c, 0xca, 0xc3, 0x06, 0x4c,
-		0x53, 0x1f, 0xbd, 0xce, 0x9f, 0xba, 0xd1, 0x2b, 0xb1, 0x09, 0x5d, 0x33, 0x99, 0x2d, 0x3b, 0xb3,
-		0xd4, 0x4a, 0x96, 0x6d, 0xba, 0xb0, 0xd8, 0xd2, 0x75, 0x5c, 0x32, 0x01, 0xa9, 0x6a, 0x60, 0x8c,
-		0x91, 0x63, 0x37, 0x61, 0x0d, 0x8f, 0xd9, 0xcb, 0x5b, 0xc1, 0x63, 0xb6, 0xa9, 0xf5, 0x2b, 0xbe,
-		0x36, 0x29, 0xf3, 0x31, 0x99, 0xd4, 0x5d, 0x8d, 0x1f, 0x11, 0x99, 0xb0, 0x69, 0xe9, 0x62, 0x2c,
-		0x1e, 0xed, 0x08, 0x79, 0x30, 0x29, 0x9d, 0x96, 0xa4, 0xf0, 0x1f, 0xd0, 0x09, 0x50, 0x3e, 0x44,
-		0xad, 0x94, 0xff, 0xc9, 0x87, 0x56, 0x28, 0xa6, 0x1f, 0xf0, 0x04, 0x33, 0x9a, 0x2f, 0x69, 0xab,
-		0xa3, 0x85, 0x26, 0x90, 0xe1, 0x9b, 0xf0, 0xb7, 0xc6, 0xd0, 0xa1, 0xa8, 0xb4, 0xd6, 0xd3, 0x81,
-		0xc0, 0xa3, 0xc2, 0xbb, 0x0a, 0x8f, 0x6a, 0xf4, 0x8e, 0x9b, 0xf7, 0xb4, 0xaf, 0xaa, 0x7d, 0x5b,
-		0x0b, 0xd9, 0x16, 0x36, 0xa1, 0x86, 0x6d, 0x01, 0x70, 0xcb, 0x96, 0x17, 0xc3, 0xb7, 0xaf, 0x53,
-		0x04, 0xce, 0x3f, 0x84, 0xe6, 0x24, 0x9b, 0xa2, 0xb4, 0x49, 0xa9, 0xcc, 0x94, 0x26, 0x98, 0x29,
-		0xe2, 0x30, 0x77, 0x0f, 0xc9, 0x44, 0xc3, 0x36, 0x22, 0x0c, 0x8f, 0x71, 0xee, 0xf4, 0x29, 0xbc,
-		0x6b, 0x1e, 0xde, 0xbd, 0x3d, 0x3e, 0x3e, 0x79, 0x73, 0x7c, 0x6c, 0xbc, 0x39, 0x7a, 0x63, 0x9c,
-		0xf6, 0xfb, 0xdd, 0x93, 0x6e, 0x7f, 0x77, 0xf0, 0x6f, 0x13, 0x06, 0xee, 0x10, 0x6a, 0x7f, 0x9f,
-		0x69, 0x7f, 0x5e, 0x8e, 0xe2, 0x37, 0xdc, 0xa0, 0xd4, 0x2e, 0x43, 0x93, 0xb2, 0x64, 0x59, 0xea,
-		0x8f, 0xba, 0xfe, 0x38, 0xfc, 0x76, 0xf7, 0xdf, 0x91, 0xfe, 0xfa, 0x51, 0x1f, 0x7e, 0x9b, 0x7d,
-		0xe2, 0x6f, 0xf4, 0xe1, 0x37, 0xe7, 0xe3, 0x48, 0xdf, 0x96, 0x45, 0x8a, 0xa9, 0xe7, 0xc0, 0x7b,
-		0x8d, 0x44, 0x2b, 0xb3, 0x0c, 0xb5, 0xd3, 0xa5, 0xc5, 0x30, 0x3b, 0xae, 0x02, 0xc2, 0x2a, 0x80,
-		0xb9, 0x20, 0xa0, 0x08, 0x60, 0x02, 0xfe, 0xf3, 0xfb, 0x39, 0x70, 0x7d, 0xf0, 0xfe, 0xe3, 0xb9,
-		0x32, 0x4f, 0x95, 0x79, 0xaa, 0xe0, 0x7a, 0x3b, 0x70, 0xfd, 0xd6, 0xd8, 0x3f, 0xe3, 0xb4, 0x90,
-		0x4c, 0xfb, 0x05, 0xdd, 0x97, 0x5a, 0x9f, 0xad, 0x8f, 0x98, 0xb2, 0x33, 0xc6, 0x72, 0x68, 0xb7,
-		0x4f, 0x98, 0xfc, 0xdb, 0x41, 0xe1, 0x2a, 0xcc, 0x19, 0xa7, 0x70, 0xaa, 0x52, 0x25, 0xc4, 0x54,
-		0x66, 0xeb, 0x37, 0xdf, 0x46, 0x3e, 0xb2, 0x7f, 0x0c, 0xfb, 0x47, 0x02, 0xc7, 0x29, 0x7c, 0x8c,
-		0x33, 0x42, 0x5c, 0x06, 0x73, 0x69, 0x84, 0x16, 0xb5, 0xa6, 0x68, 0x06, 0x3d, 0xc8, 0x57, 0x59,
-		0xeb, 0xd0, 0x25, 0x63, 0x2d, 0xf5, 0xc8, 0x87, 0xf9, 0x04, 0x6e, 0x54, 0x9b, 0xf9, 0x81, 0xc5,
-		0x62, 0x8d, 0xd0, 0xfa, 0x8d, 0x8c, 0x3f, 0x4c, 0x2d, 0x2f, 0x32, 0xb4, 0x2f, 0x17, 0x6f, 0xb3,
-		0xe8, 0xb3, 0x18, 0xf6, 0x54, 0x5f, 0x5b, 0xbe, 0x1b, 0x30, 0x94, 0x4f, 0x24, 0x47, 0x5f, 0x8b,
-		0x52, 0xc8, 0xbc, 0xf4, 0xb3, 0x90, 0xc7, 0x3e, 0x6b, 0x1e, 0x75, 0xec, 0xb3, 0xa6, 0x13, 0xc7,
-		0xe7, 0x17, 0x73, 0xd6, 0x38, 0x9c, 0xad, 0xa9, 0xeb, 0x35, 0x41, 0xb3, 0xfb, 0x2f, 0x50, 0xb3,
-		0xfb, 0xac, 0xa9, 0xc4, 0x71, 0x15, 0xda, 0xf8, 0xb4, 0xa0, 0x4c, 0xfc, 0xdb, 0xb5, 0xed, 0x80,
-		0x8a, 0xd4, 0xb6, 0x8c, 0xd5, 0x22, 0xbd, 0x13, 0x78, 0x21, 0x94, 0xb7, 0x0c, 0xf5, 0x5d, 0xac,
-		0x8b, 0x05, 0xad, 0x19, 0xb9, 0xe9, 0x3c, 0x69, 0xe0, 0x74, 0x0e, 0x1e, 0xc3, 0xb1, 0x82, 0xda,
-		0xf8, 0x4c, 0xfb, 0x69, 0xf4, 0x60, 0x1c, 0x1c, 0x3f, 0x75, 0x06, 0x9d, 0xf6, 0xf2, 0x67, 0x83,
-		0xce, 0x83, 0x71, 0xd0, 0x7f, 0x6a, 0xb7, 0xd7, 0x7c, 0xf3, 0x6e, 0x5d, 0x1b, 0x9d, 0xc7, 0x76,
-		0xbb, 0x1d, 0x4f, 0x64, 0x66, 0x72, 0x87, 0x46, 0x77, 0xf4, 0x8e, 0xbf, 0x8d, 0xfe, 0x4f, 0xc4,
-		0x43, 0xa8, 0x70, 0xa7, 0x8e, 0x50, 0xb4, 0xdb, 0xc3, 0x6f, 0x83, 0xd1, 0x0f, 0x83, 0xce, 0xc3,
-		0xc9, 0xd3, 0xfc, 0x3d, 0xff, 0xbf, 0xf3, 0xd8, 0xd6, 0x5f, 0x9b, 0xa6, 0xae, 0xbf, 0xee, 0x44,
-		0x1d, 0x8f, 0xcb, 0xbd, 0x8e, 0xbe, 0x7d, 0x37, 0x18, 0xac, 0x7c, 0xd4, 0x69, 0xff, 0x43, 0x97,
-		0x94, 0xc7, 0x68, 0x7c, 0x06, 0x9b, 0x11, 0x4b, 0xe5, 0xd1, 0x51, 0x5b, 0x66, 0xb5, 0x65, 0x56,
-		0x5b, 0x66, 0xe5, 0xd1, 0x51, 0xcc, 0xa0, 0x82, 0x39, 0x05, 0x73, 0x8a, 0x19, 0x54, 0x20, 0x37,
-		0x43, 0xcc, 0xc7, 0x56, 0x39, 0xbc, 0xc5, 0xe5, 0xc4, 0x80, 0xed, 0x13, 0x2f, 0x0c, 0xa8, 0x87,
-		0x2c, 0x3c, 0xc6, 0x88, 0x72, 0x6f, 0xb5, 0xe7, 0x63, 0xd7, 0xc7, 0xec, 0x5e, 0x91, 0x22, 0xbb,
-		0x45, 0x8a, 0x04, 0x98, 0xb0, 0xb7, 0x02, 0xb8, 0x57, 0xe0, 0x26, 0x6d, 0x7d, 0x86, 0x64, 0x82,
-		0xbe, 0x1b, 0xea, 0x19, 0xfb, 0x83, 0x7a, 0xbd, 0x7e, 0x5f, 0xc1, 0x1e, 0x48, 0xd6, 0x6b, 0x29,
-		0xec, 0x15, 0xf3, 0xd8, 0x4b, 0xb0, 0x47, 0x83, 0x2b, 0x82, 0x58, 0x68, 0xc9, 0xcd, 0x20, 0xb3,
-		0xa6, 0xc0, 0x83, 0xd6, 0x35, 0x62, 0x0a, 0xef, 0x76, 0x8e, 0x04, 0x2e, 0x7d, 0xfa, 0xef, 0xc7,
-		0xfd, 0x0a, 0x4d, 0xcc, 0x6e, 0x51, 0xbe, 0x87, 0xf1, 0x8f, 0x75, 0x1e, 0xdb, 0xc3, 0xae, 0xd6,
-		0x1b, 0xcd, 0xff, 0x38, 0x1a, 0x1a, 0x5a, 0x6f, 0xd4, 0xe9, 0x34, 0x8c, 0xce, 0x6d, 0xcc, 0x0c,
-		0xbd, 0x38, 0x16, 0x37, 0x3d, 0xd1, 0xe1, 0xeb, 0x43, 0xef, 0xa9, 0xf3, 0xd8, 0x0e, 0xc5, 0xa3,
-		0x9b, 0x4c, 0x7a, 0x37, 0x6c, 0xe4, 0x6d, 0x58, 0xfc, 0xfb, 0x30, 0xbc, 0x87, 0xfa, 0x0f, 0x9d,
-		0x66, 0x12, 0xb5, 0xdc, 0x7b, 0x2c, 0x14, 0x9a, 0x9a, 0x94, 0xac, 0x12, 0x94, 0xba, 0xce, 0xa5,
-		0xad, 0x74, 0xde, 0xb3, 0xeb, 0x3c, 0x15, 0x8a, 0xba, 0xfb, 0x44, 0xad, 0x0a, 0x45, 0x7d, 0xc1,
-		0xa1, 0xa8, 0x22, 0x51, 0x5a, 0x39, 0x40, 0xbc, 0x9b, 0xf1, 0x59, 0xfc, 0x61, 0x0f, 0xf3, 0xd4,
-		0xc7, 0x6a, 0x4c, 0xd6, 0xe7, 0xb0, 0xe4, 0x25, 0xff, 0x5f, 0x24, 0x12, 0x8b, 0xde, 0x62, 0x66,
-		0x4d, 0xf3, 0x43, 0xb1, 0xe2, 0xef, 0xcb, 0x62, 0xb1, 0x66, 0x90, 0xc0, 0x09, 0xb2, 0x41, 0x74,
-		0xcc, 0x18, 0x60, 0xc2, 0x95, 0xde, 0x18, 0x5e, 0xad, 0xb2, 0x63, 0x5b, 0x89, 0xcc, 0xa2, 0xb7,
-		0xcd, 0x8b, 0xcc, 0xa2, 0xb7, 0x9b, 0x8b, 0xcc, 0x62, 0xcc, 0xc7, 0x57, 0xeb, 0x62, 0xe6, 0x56,
-		0x46, 0x62, 0x51, 0x54, 0xf0, 0x58, 0x2f, 0x98, 0x41, 0x2f, 0xb4, 0x52, 0xd0, 0x1d, 0xf3, 0x21,
-		0x48, 0xaa, 0xd3, 0x01, 0x88, 0xb8, 0x7b, 0xad, 0x90, 0xc2, 0xcf, 0x99, 0x4d, 0xe5, 0x74, 0xd9,
-		0xbc, 0xd3, 0x25, 0x4f, 0x3a, 0x56, 0xa5, 0x44, 0xbb, 0xe6, 0x40, 0x59, 0x32, 0x0e, 0x2b, 0x12,
-		0xc3, 0xab, 0x95, 0x3c, 0xda, 0x92, 0xf4, 0x84, 0xab, 0xfc, 0x1a, 0xdd, 0x87, 0x0b, 0x3e, 0x11,
-		0xa4, 0x85, 0x08, 0x95, 0x35, 0x56, 0x6c, 0xf7, 0x0a, 0xcb, 0x90, 0x8c, 0x2c, 0xc9, 0xc9, 0x94,
-		0xac, 0x6c, 0x55, 0x96, 0xb1, 0xca, 0xb2, 0x26, 0x2d, 0x73, 0x82, 0x16, 0x48, 0xc9, 0x48, 0x97,
-		0xda, 0xd1, 0x35, 0xec, 0xe9, 0x4a, 0x1b, 0x7d, 0x41, 0xfb, 0x5a, 0xdc, 0xce, 0xae, 0x64, 0x6f,
-		0x57, 0xb5, 0xbb, 0x6b, 0x5b, 0xa0, 0xd5, 0x2d, 0x51, 0x09, 0x7b, 0xbc, 0x92, 0x5d, 0xbe, 0x32,
-		0x24, 0xc7, 0xc6, 0xcb, 0x19, 0x93, 0x57, 0x9b, 0x29, 0x35, 0xda, 0x06, 0x53, 0x55, 0xd9, 0x8a,
-		0xaf, 0x6f, 0xcd, 0xd7, 0xe5, 0x6b, 0x0a, 0x64, 0xad, 0x75, 0x13, 0x8b, 0x89, 0xa0, 0xf2, 0x8a,
-		0x8a, 0xcb, 0x2b, 0x2d, 0x5e, 0x2f, 0xa3, 0xad, 0x44, 0xb4, 0x9f, 0x52, 0x58, 0x2f, 0x55, 0x61,
-		0x09, 0x38, 0x3b, 0x6a, 0x28, 0xb7, 0xd2, 0x28, 0x17, 0xa5, 0xd3, 0x76, 0x5a, 0xa7, 0xf5, 0x8c,
-		0x3d, 0x54, 0x6a, 0x55, 0xc1, 0x5f, 0x6a, 0xd3, 0x13, 0x53, 0x3e, 0x22, 0x28, 0x5d, 0xcc, 0xff,
-		0x88, 0xf3, 0x40, 0xb5, 0xf8, 0x20, 0x31, 0x5e, 0xa8, 0xec, 0xa9, 0x4b, 0x78, 0xa2, 0x22, 0xbe,
-		0x28, 0x22, 0x6c, 0x0e, 0xe3, 0x97, 0x32, 0x36, 0x60, 0x3d, 0x87, 0xf4, 0x85, 0x57, 0xbe, 0x8c,
-		0x5f, 0xce, 0x92, 0x36, 0x54, 0x3c, 0xbc, 0xe2, 0x2c, 0x54, 0xa0, 0xa8, 0x72, 0xb3, 0xa8, 0x78,
-		0xf8, 0x34, 0xb2, 0xa9, 0x78, 0x78, 0x05, 0x73, 0x0a, 0xe6, 0x76, 0x14, 0xe6, 0x54, 0x3c, 0x3c,
-		0xef, 0x64, 0xe4, 0xdf, 0x8b, 0x17, 0x4e, 0x59, 0x4c, 0xfc, 0xa2, 0xac, 0x18, 0xc0, 0x59, 0x1c,
-		0x88, 0x02, 0x9f, 0x1b, 0xbc, 0xf3, 0x80, 0x99, 0x45, 0x2b, 0xc0, 0x73, 0x7d, 0xd6, 0x04, 0xf7,
-		0xd3, 0x5a, 0x27, 0x63, 0xc3, 0x31, 0x6e, 0x9d, 0x13, 0xb2, 0x1a, 0xc6, 0x95, 0xbb, 0x9f, 0x4a,
-		0xd2, 0x47, 0xac, 0x8c, 0x67, 0x71, 0x1a, 0x89, 0x3c, 0x69, 0xf9, 0x9a, 0x15, 0x8e, 0xf3, 0x8b,
-		0x9b, 0x63, 0x20, 0xd8, 0x52, 0x13, 0xe8, 0xbb, 0x42, 0x21, 0x7a, 0xa1, 0xf4, 0x5d, 0x91, 0x90,
-		0x6d, 0x86, 0xbe, 0x93, 0xa7, 0xe4, 0x54, 0xca, 0x87, 0x26, 0xa4, 0x7c, 0xa8, 0xe4, 0x02, 0x08,
-		0xf1, 0x5e, 0x23, 0xc1, 0xec, 0x0a, 0xf9, 0xe2, 0x60, 0x92, 0xae, 0x54, 0x09, 0x50, 0x52, 0xca,
-		0x06, 0x88, 0xb5, 0xa3, 0xe0, 0x64, 0x7f, 0xe0, 0x44, 0x5c, 0xbc, 0xd2, 0xa2, 0x71, 0x22, 0x50,
-		0x54, 0xec, 0x90, 0xd7, 0x73, 0x93, 0xfc, 0x86, 0x22, 0xf9, 0x97, 0x87, 0xe4, 0xa4, 0xdf, 0x3f,
-		0xea, 0x2b, 0x9a, 0x7f, 0x2b, 0x34, 0xff, 0xc6, 0x08, 0xef, 0xd2, 0xcd, 0x87, 0x08, 0xe3, 0xfd,
-		0x69, 0xd1, 0x48, 0x9d, 0x3d, 0x93, 0x6b, 0x23, 0x47, 0xe8, 0x64, 0x41, 0x52, 0x52, 0x90, 0xec,
-		0xc6, 0xe4, 0x1a, 0x30, 0x17, 0x44, 0x8f, 0x0c, 0x78, 0xed, 0x26, 0x70, 0x40, 0xfb, 0xbd, 0x3f,
-		0xda, 0xec, 0x71, 0x3a, 0x07, 0xc1, 0xb1, 0x8f, 0xc6, 0x22, 0x44, 0xd1, 0x9b, 0xe2, 0x98, 0xf0,
-		0x68, 0xa5, 0xd0, 0xd9, 0x20, 0x12, 0x17, 0x8d, 0x8b, 0xcb, 0xf2, 0xdf, 0xa1, 0xf0, 0xd5, 0x39,
-		0x37, 0x1a, 0xee, 0xd0, 0xcb, 0x4f, 0x8d, 0x16, 0xec, 0xe3, 0x57, 0x22, 0x8a, 0xb9, 0x1d, 0xc6,
-		0xc3, 0x0a, 0xd7, 0x86, 0x20, 0x2b, 0x02, 0xa0, 0x79, 0x04, 0x80, 0x05, 0x27, 0x48, 0xda, 0x6e,
-		0x4f, 0x57, 0x92, 0xb3, 0xdb, 0x7d, 0x34, 0x46, 0x3e, 0x22, 0x16, 0x27, 0xc5, 0xd9, 0x14, 0x81,
-		0x54, 0x53, 0x73, 0x16, 0x69, 0x2e, 0x45, 0xe1, 0x7b, 0x01, 0xb8, 0x54, 0xa6, 0xfd, 0x1e, 0x9a,
-		0xf6, 0xe5, 0x50, 0x2b, 0x03, 0xb9, 0x42, 0xd0, 0x1b, 0x8a, 0x65, 0xf8, 0x9a, 0x16, 0xfe, 0x5a,
-		0x4f, 0xcd, 0x83, 0xf7, 0xb5, 0x80, 0x50, 0x06, 0xaf, 0x1c, 0xc1, 0xe7, 0x9f, 0x05, 0x94, 0x6d,
-		0x63, 0xdf, 0x91, 0xc8, 0x2f, 0x62, 0x9a, 0xe5, 0x12, 0x06, 0x31, 0xa1, 0xed, 0xc3, 0xf4, 0x10,
-		0x0c, 0xff, 0xb5, 0xa4, 0x7b, 0xfe, 0xf9, 0x3f, 0x6c, 0x8a, 0xe9, 0xa1, 0xae, 0x87, 0xff, 0xe6,
-		0x1f, 0x8e, 0x0e, 0xf9, 0x30, 0xfd, 0x2b, 0x35, 0x48, 0x07, 0x40, 0x17, 0x3d, 0xc0, 0xba, 0x06,
-		0x31, 0x24, 0xed, 0xfe, 0xb4, 0xda, 0xca, 0x60, 0x4b, 0x38, 0x70, 0xe0, 0x0a, 0x81, 0xb1, 0xef,
-		0xce, 0x22, 0xe0, 0x71, 0x7d, 0x1f, 0x51, 0xcf, 0x25, 0x36, 0x26, 0x13, 0x90, 0x7e, 0xb6, 0x96,
-		0xa4, 0xb9, 0x2f, 0xb9, 0x9a, 0xf3, 0x56, 0x76, 0x79, 0x00, 0xc5, 0x56, 0x96, 0x7a, 0xee, 0xb2,
-		0xaf, 0x35, 0x84, 0x52, 0x3f, 0xfd, 0xb4, 0x95, 0x7d, 0xe3, 0xbf, 0x7d, 0xdf, 0xf5, 0x3f, 0x21,
-		0x4a, 0xe1, 0x04, 0x6d, 0x41, 0x90, 0x3c, 0x1f, 0x51, 0x44, 0xb8, 0xa2, 0xca, 0x1f, 0x87, 0xc3,
-		0x02, 0xf3, 0x69, 0xdb, 0xf2, 0x84, 0xc2, 0xe7, 0xd7, 0x66, 0xf1, 0x00, 0x34, 0x5a, 0xa2, 0x24,
-		0xc6, 0x72, 0x5b, 0x82, 0xf5, 0xbd, 0x77, 0xde, 0x05, 0x36, 0xaa, 0x35, 0x85, 0x84, 0x20, 0x47,
-		0xde, 0x4a, 0xcb, 0xd6, 0xab, 0x6b, 0xa8, 0x65, 0x5a, 0x2b, 0xb3, 0xd5, 0x74, 0x93, 0x7c, 0x4d,
-		0x22, 0xb6, 0x2d, 0x48, 0x88, 0xcb, 0x00, 0xba, 0xb3, 0x10, 0xb2, 0x63, 0x47, 0xe1, 0x9d, 0x16,
-		0x37, 0x48, 0xe7, 0x4d, 0x65, 0x67, 0x7e, 0xb5, 0x61, 0x4d, 0x19, 0x81, 0xca, 0x08, 0x04, 0x32,
-		0xe9, 0xba, 0x96, 0x85, 0xa2, 0xaf, 0x98, 0xdd, 0xdd, 0x61, 0x76, 0xbb, 0x27, 0x8a, 0xd6, 0x7d,
-		0xf1, 0xdb, 0x8d, 0x48, 0x99, 0xb4, 0xf5, 0x0e, 0x30, 0x03, 0xc3, 0x38, 0xb2, 0xfe, 0x19, 0x7b,
-		0xf1, 0xaa, 0x6f, 0x3d, 0x86, 0xe9, 0xad, 0xc7, 0xa2, 0x5c, 0xfa, 0xd3, 0xd1, 0x61, 0x4a, 0xfd,
-		0x7c, 0xaf, 0xad, 0x49, 0x56, 0x9b, 0xce, 0x0d, 0x21, 0xf4, 0x57, 0x00, 0x1d, 0xe0, 0xfa, 0xc0,
-		0x41, 0x94, 0x02, 0x36, 0x85, 0x24, 0xad, 0x2a, 0x9b, 0x6b, 0x6b, 0x36, 0x73, 0xef, 0xb2, 0xad,
-		0x31, 0xde, 0xb1, 0xcd, 0x4d, 0x76, 0x98, 0x22, 0x2b, 0x8d, 0x66, 0xc6, 0xc4, 0x1d, 0xab, 0x3d,
-		0x4e, 0x0d, 0xc9, 0xab, 0x38, 0xa4, 0x7b, 0xb8, 0xd5, 0xb1, 0x25, 0x00, 0x56, 0xe2, 0xe4, 0x49,
-		0xae, 0x53, 0x4e, 0xe6, 0x04, 0x4a, 0xa3, 0xb6, 0x18, 0xea, 0x40, 0xe9, 0xf3, 0x6c, 0x31, 0xd4,
-		0x21, 0xd1, 0x3d, 0xdf, 0x65, 0x08, 0x9f, 0x98, 0xd9, 0x9f, 0x7d, 0x46, 0x35, 0x64, 0x9f, 0x5a,
-		0x9e, 0x66, 0xb9, 0x84, 0x20, 0x8b, 0x69, 0x9e, 0x8b, 0x0b, 0x82, 0xd2, 0x57, 0x01, 0x7e, 0xb5,
-		0xae, 0x1c, 0xce, 0x7f, 0x4e, 0x93, 0x59, 0xfc, 0xaa, 0xf1, 0xb8, 0x35, 0x20, 0xd4, 0x9a, 0xe2,
-		0x94, 0xf6, 0x87, 0x53, 0xda, 0xb6, 0x63, 0x31, 0x94, 0xe5, 0xc1, 0xf2, 0xfd, 0x9d, 0x83, 0xa5,
-		0x3b, 0x4c, 0x6b, 0x3d, 0xb3, 0xd0, 0x89, 0xea, 0xb4, 0xc2, 0x10, 0x3b, 0x59, 0x9d, 0xc6, 0xd3,
-		0xca, 0x27, 0xac, 0x93, 0x46, 0x84, 0x4e, 0x5a, 0xd7, 0x44, 0x1c, 0x91, 0x33, 0x7e, 0xab, 0x58,
-		0x53, 0x7e, 0xd6, 0x2f, 0x0f, 0x65, 0xaa, 0x9e, 0xf9, 0x53, 0x86, 0xa5, 0x32, 0x2c, 0x95, 0x61,
-		0xb9, 0x67, 0x86, 0xe5, 0x5b, 0x95, 0x7c, 0x64, 0x13, 0x20, 0x4f, 0x3d, 0x84, 0x6c, 0x71, 0x74,
-		0x8f, 0x8a, 0xcb, 0xc1, 0xfa, 0xfc, 0xa4, 0x23, 0xb2, 0x23, 0xa7, 0xa4, 0x50, 0x1b, 0xca, 0x64,
-		0x7c, 0xa9, 0x26, 0xe3, 0x76, 0x93, 0x4e, 0x45, 0xc9, 0x14, 0xd9, 0xbd, 0xa4, 0x89, 0x29, 0x62,
-		0x52, 0x9d, 0xc7, 0x4d, 0xff, 0x08, 0xa9, 0xc4, 0x74, 0xca, 0xac, 0x8c, 0x2c, 0x88, 0x51, 0x61,
-		0x35, 0x23, 0xa7, 0x6a, 0x56, 0xbb, 0xa5, 0x75, 0x8d, 0x49, 0x6b, 0x1b, 0x10, 0x5e, 0xab, 0x53,
-		0x3d, 0xad, 0xdf, 0xc4, 0x5e, 0x35, 0xb1, 0x53, 0xc7, 0x4d, 0x9c, 0xbf, 0xae, 0x61, 0x34, 0x72,
-		0xa8, 0x1a, 0x39, 0x56, 0x0d, 0xec, 0x13, 0x0c, 0x98, 0x4b, 0x50, 0x03, 0x3b, 0x26, 0xb5, 0x02,
-		0x85, 0x4a, 0x8e, 0xb6, 0x96, 0x5f, 0xa2, 0xd0, 0xb6, 0x62, 0x90, 0x49, 0xec, 0x9c, 0xa3, 0xe2,
-		0x72, 0xb6, 0xd5, 0x6f, 0x1e, 0xe0, 0xd5, 0xe4, 0xf3, 0x4f, 0x77, 0x45, 0x2d, 0xab, 0x9e, 0xb2,
-		0xac, 0x1a, 0x62, 0x59, 0x95, 0x9d, 0x45, 0x49, 0x0a, 0x42, 0x7b, 0x86, 0x89, 0x16, 0x0a, 0x46,
-		0x40, 0xe5, 0x2d, 0x99, 0x4c, 0x6d, 0xc1, 0x81, 0x58, 0x73, 0xba, 0xdc, 0x46, 0x14, 0x87, 0x36,
-		0x7f, 0x24, 0x9f, 0x71, 0x8c, 0x22, 0x26, 0x0c, 0xf9, 0x63, 0x68, 0x21, 0x1d, 0x80, 0x73, 0x02,
-		0x3e, 0xff, 0xf4, 0x1e, 0xbc, 0xe9, 0xf5, 0x8e, 0x00, 0x9b, 0x62, 0x0a, 0x1c, 0x04, 0xc7, 0x26,
-		0x99, 0xc2, 0xe8, 0x8e, 0x50, 0x0a, 0x67, 0x08, 0xf8, 0x08, 0xda, 0x80, 0xa2, 0x19, 0x24, 0x0c,
-		0x5b, 0x14, 0x40, 0x0a, 0xf0, 0xf8, 0x2c, 0xec, 0xdf, 0x17, 0xde, 0x3d, 0x1d, 0x80, 0x9f, 0x91,
-		0x8f, 0x0e, 0x00, 0x66, 0x26, 0xf1, 0xd1, 0xd8, 0x41, 0x16, 0x8b, 0x6a, 0xf3, 0x87, 0xc0, 0x94,
-		0xf9, 0x7c, 0xcb, 0x36, 0x5f, 0x24, 0x14, 0x50, 0xc4, 0xc0, 0xd5, 0x3d, 0x40, 0x04, 0x5e, 0x39,
-		0x98, 0x4c, 0x80, 0xeb, 0x9b, 0xc4, 0xc6, 0x34, 0xfe, 0x2b, 0xdb, 0x45, 0xd1, 0x87, 0x17, 0xdb,
-		0xab, 0x48, 0xef, 0x59, 0xaa, 0xac, 0xb0, 0x95, 0xc9, 0x74, 0x2d, 0x0d, 0x8f, 0x65, 0x42, 0x74,
-		0x2a, 0x06, 0x16, 0x54, 0x5e, 0x7c, 0xb5, 0x17, 0xe1, 0xda, 0xc5, 0x18, 0x3d, 0xf6, 0xa6, 0x77,
-		0xe8, 0x82, 0xf3, 0x25, 0xb1, 0x03, 0x92, 0xdf, 0x09, 0xad, 0xcc, 0x30, 0x22, 0xc1, 0x0c, 0x45,
-		0x69, 0x84, 0x64, 0xe6, 0x79, 0xae, 0x06, 0x8e, 0x65, 0x82, 0x66, 0x48, 0x30, 0x0b, 0x3b, 0xb9,
-		0xd9, 0xe1, 0xaa, 0x10, 0x57, 0x97, 0xd4, 0x4d, 0xa2, 0xac, 0xa5, 0xf6, 0x52, 0xf2, 0x16, 0x4a,
-		0x66, 0xc4, 0x43, 0xd4, 0xea, 0xbd, 0x3d, 0x39, 0x1a, 0x00, 0x7e, 0xdd, 0xd9, 0x1c, 0x2f, 0x28,
-		0xf8, 0x8f, 0xef, 0x06, 0x1e, 0xf8, 0x74, 0xfe, 0x23, 0xd0, 0xb2, 0x38, 0x55, 0x25, 0xb2, 0xa6,
-		0x46, 0x84, 0xcf, 0xf2, 0x82, 0x5c, 0x8c, 0xd2, 0x41, 0xb5, 0xb6, 0x36, 0x11, 0xe5, 0xb3, 0xb2,
-		0x46, 0x2b, 0x0c, 0xa3, 0xf4, 0x8f, 0x3e, 0x6d, 0x29, 0x06, 0x68, 0xb4, 0x21, 0x4a, 0x4f, 0x24,
-		0x85, 0x1b, 0x1e, 0x63, 0x62, 0xa3, 0x3b, 0x79, 0x45, 0x3e, 0xaf, 0x58, 0x4d, 0x87, 0x7f, 0xb9,
-		0xa7, 0x0c, 0xcd, 0x00, 0xa4, 0x14, 0x4f, 0x08, 0xb2, 0xe3, 0xc0, 0x52, 0x30, 0x76, 0x7d, 0x80,
-		0xa0, 0x35, 0xcd, 0xa8, 0xf2, 0xf7, 0x49, 0x24, 0x16, 0x05, 0xcc, 0x35, 0x09, 0x1e, 0x9f, 0x87,
-		0xbf, 0x0c, 0xdc, 0xab, 0xff, 0x43, 0x16, 0x3f, 0x86, 0xf0, 0xe5, 0xd7, 0x4f, 0x17, 0x8b, 0x39,
-		0x0e, 0x27, 0x57, 0x69, 0x57, 0xa5, 0x5d, 0xab, 0xa8, 0x8b, 0xea, 0x9a, 0x32, 0xc0, 0x84, 0x1d,
-		0xf5, 0x2a, 0x28, 0xc9, 0x37, 0x12, 0x55, 0xe4, 0xce, 0x3f, 0xd4, 0xd0, 0x46, 0x55, 0x1c, 0x4a,
-		0x59, 0x02, 0x52, 0xde, 0x07, 0x92, 0xd4, 0xaf, 0xeb, 0x4c, 0x59, 0xcc, 0x4d, 0x55, 0xa7, 0x4a,
-		0x05, 0x52, 0x22, 0x65, 0xa0, 0xdd, 0xd5, 0x1f, 0xba, 0xe3, 0xde, 0xe9, 0xf1, 0xe9, 0xc9, 0x9b,
-		0xde, 0x69, 0xff, 0xe5, 0x8f, 0xe1, 0xf7, 0xd5, 0x92, 0xbb, 0x6e, 0x27, 0x02, 0x2d, 0xd7, 0xc2,
-		0x51, 0x86, 0x61, 0x81, 0x61, 0x58, 0x34, 0x6e, 0x7b, 0x69, 0x09, 0x3a, 0x90, 0x32, 0x1e, 0x00,
-		0x3f, 0xa9, 0xe0, 0xa0, 0x4a, 0x57, 0xae, 0xca, 0xea, 0x60, 0x0a, 0x18, 0x9e, 0x21, 0xca, 0xe0,
-		0xcc, 0x03, 0x98, 0xd8, 0xd8, 0x82, 0x0c, 0x45, 0x84, 0x4b, 0xf8, 0xf9, 0x9c, 0xe5, 0x09, 0x7f,
-		0x2a, 0xa6, 0x5c, 0xa2, 0x1f, 0x34, 0xc9, 0x32, 0xff, 0x03, 0xda, 0x48, 0x9f, 0xe8, 0x07, 0x20,
-		0xf0, 0x34, 0xe6, 0x6a, 0xb6, 0x7b, 0x4b, 0x00, 0xf3, 0x21, 0xa1, 0x38, 0xfc, 0xb1, 0x8e, 0x0e,
-		0xc2, 0xdf, 0x32, 0x89, 0x95, 0xb1, 0x2b, 0xa3, 0x16, 0xc6, 0x1f, 0x21, 0x65, 0xef, 0x79, 0xb3,
-		0x29, 0xfb, 0x92, 0x33, 0x46, 0x2c, 0xdc, 0x78, 0xfb, 0xb6, 0x49, 0x70, 0xda, 0xd0, 0xd4, 0x4d,
-		0x92, 0x3e, 0x4c, 0x8b, 0x17, 0xfd, 0x9d, 0x3f, 0x07, 0x20, 0x90, 0xb8, 0x14, 0x59, 0xfc, 0x77,
-		0x7c, 0xe4, 0x44, 0x9c, 0x51, 0x68, 0xc8, 0x86, 0x25, 0x7f, 0x27, 0xf8, 0x0e, 0xfc, 0xdb, 0x73,
-		0xad, 0x29, 0x68, 0xff, 0x2f, 0x24, 0xa0, 0x7b, 0x00, 0xba, 0xa7, 0x6f, 0x0c, 0x60, 0x18, 0x03,
-		0xfe, 0x0f, 0xfc, 0xfe, 0xf5, 0x7d, 0x47, 0x91, 0x45, 0xca, 0x9c, 0x7d, 0x66, 0x73, 0x36, 0x94,
-		0x60, 0x86, 0xad, 0x6b, 0x7a, 0x72, 0x5c, 0xc1, 0xa6, 0x7d, 0xab, 0x6c, 0x5a, 0x65, 0xd3, 0x2e,
-		0x0f, 0x5d, 0xf5, 0x6b, 0xc4, 0x95, 0x75, 0xab, 0x58, 0xd0, 0xb5, 0xf4, 0xdd, 0x42, 0x5b, 0x2b,
-		0x5b, 0xb7, 0x3a, 0x09, 0x9a, 0x1a, 0xc5, 0xbd, 0xb4, 0x7c, 0x5d, 0x0f, 0xf9, 0x95, 0x1d, 0x9a,
-		0xe9, 0xca, 0xd5, 0xfd, 0x99, 0x56, 0xe0, 0xfb, 0x88, 0x30, 0x10, 0xb6, 0xc6, 0xbd, 0x2d, 0xd0,
-		0xc9, 0xf3, 0x6d, 0x46, 0x06, 0x67, 0xec, 0xd1, 0x04, 0x19, 0x87, 0xe6, 0x92, 0x2f, 0xf3, 0x37,
-		0x0f, 0xf9, 0xb1, 0x2b, 0x53, 0x19, 0x90, 0xca, 0x80, 0xac, 0xa2, 0x38, 0x94, 0xb7, 0x51, 0xe9,
-		0xd9, 0x0c, 0x92, 0x28, 0x3d, 0x5b, 0x5d, 0xcf, 0xa6, 0x46, 0x71, 0xf7, 0xf4, 0x6c, 0xad, 0xe0,
-		0x23, 0xc1, 0x64, 0xe5, 0x49, 0xf9, 0xd2, 0xa4, 0xe5, 0x51, 0x3a, 0x50, 0x81, 0x98, 0x37, 0x20,
-		0x90, 0xbc, 0xfc, 0xc2, 0xf5, 0xd9, 0xe5, 0x17, 0xde, 0xd8, 0x36, 0xae, 0xe7, 0x76, 0x20, 0x91,
-		0xb8, 0xe2, 0x27, 0x2a, 0x2e, 0x17, 0xc8, 0x17, 0x10, 0x06, 0x27, 0x13, 0x64, 0x03, 0x48, 0x6c,
-		0x10, 0xbf, 0xe5, 0xed, 0x00, 0x0f, 0xf9, 0x40, 0x20, 0x43, 0x88, 0x74, 0x5c, 0x9f, 0x3a, 0x31,
-		0x21, 0x86, 0x1c, 0x0d, 0x8a, 0xeb, 0x8b, 0xe4, 0x42, 0xde, 0x00, 0x8e, 0xeb, 0x55, 0xb3, 0x7d,
-		0x67, 0x81, 0xc3, 0xb0, 0xe7, 0xa0, 0x8c, 0x54, 0xee, 0x82, 0xb1, 0x2a, 0x24, 0xac, 0x3b, 0x66,
-		0xa9, 0x8a, 0x08, 0x73, 0xd3, 0x78, 0x4e, 0xf1, 0x13, 0xe5, 0x2b, 0x58, 0x28, 0xe3, 0xb8, 0x9f,
-		0x9f, 0x30, 0x8f, 0x92, 0xa2, 0xe9, 0xfa, 0x21, 0xbd, 0x1d, 0x84, 0xb2, 0x3e, 0x7f, 0x2d, 0x3d,
-		0x57, 0x2e, 0x3b, 0x22, 0x52, 0xe7, 0xcc, 0xd3, 0x14, 0xa9, 0xdc, 0x79, 0xf3, 0x34, 0x43, 0x58,
-		0xfb, 0xdc, 0x79, 0xd2, 0x98, 0xd4, 0xf9, 0xf3, 0xcd, 0x72, 0x01, 0x73, 0x55, 0x29, 0x8f, 0x83,
-		0x49, 0xcd, 0x6a, 0x48, 0x18, 0x4a, 0x01, 0x8f, 0x7f, 0x4a, 0x94, 0xb5, 0x07, 0xad, 0x6b, 0xc4,
-		0x24, 0x58, 0x85, 0x31, 0x0c, 0x1c, 0x26, 0xb5, 0x7b, 0x68, 0x75, 0xc5, 0xa4, 0x6e, 0xa4, 0x10,
-		0x59, 0x21, 0xb2, 0x42, 0xe4, 0x1d, 0xda, 0x1b, 0x89, 0x1a, 0x5a, 0x42, 0x7b, 0xa3, 0x3f, 0x78,
-		0x63, 0xcf, 0x72, 0xad, 0xd5, 0x2f, 0xe8, 0x7e, 0xe9, 0x8e, 0x13, 0x20, 0x94, 0x49, 0x5b, 0x4c,
-		0x21, 0x8a, 0x2b, 0xc0, 0x5a, 0x0a, 0x4f, 0x4c, 0xc1, 0x6d, 0xfd, 0x66, 0xaf, 0x92, 0x8d, 0x9f,
-		0xc8, 0xd4, 0xd7, 0xb9, 0xe2, 0xc8, 0x77, 0x1d, 0x81, 0xeb, 0xdd, 0x79, 0x29, 0xb1, 0x2b, 0x8e,
-		0xbe, 0x4e, 0x11, 0x08, 0x8b, 0xcf, 0x39, 0xf3, 0x38, 0xe5, 0x79, 0x1c, 0xc0, 0x31, 0x86, 0x57,
-		0x3e, 0xb6, 0xd4, 0xad, 0x5e, 0xbb, 0x75, 0xab, 0x97, 0x18, 0x65, 0x2c, 0x42, 0x11, 0x97, 0x50,
-		0xc2, 0x62, 0x32, 0x5d, 0x7c, 0x2a, 0x53, 0xe8, 0x34, 0x66, 0xf5, 0x53, 0x98, 0xc2, 0x37, 0x77,
-		0xf5, 0x94, 0x10, 0xd7, 0x14, 0xe2, 0xf2, 0x9b, 0xbb, 0xa2, 0x14, 0x76, 0x32, 0xe9, 0x4f, 0x16,
-		0x55, 0xe4, 0xd8, 0xbd, 0xc8, 0xf9, 0x98, 0xbe, 0xf3, 0x61, 0x00, 0x02, 0xef, 0xd1, 0x76, 0x6f,
-		0x89, 0x4a, 0x84, 0xa2, 0x72, 0xe7, 0xd5, 0xc8, 0x69, 0xb5, 0x05, 0xb6, 0x3b, 0x0a, 0x14, 0x95,
-		0x5f, 0x1c, 0x4b, 0xf5, 0xe4, 0x56, 0xc8, 0x47, 0x48, 0x19, 0xb8, 0x26, 0x3c, 0x0e, 0x14, 0xcf,
-		0x50, 0xda, 0x38, 0xb8, 0x85, 0x14, 0x08, 0x37, 0xab, 0x96, 0xcb, 0xfe, 0x2c, 0x17, 0x1b, 0x32,
-		0xa4, 0x41, 0x62, 0x6b, 0xa1, 0xc4, 0x7c, 0xf7, 0x6b, 0xee, 0x4d, 0xd3, 0x7e, 0x38, 0x7e, 0xd2,
-		0xc2, 0x97, 0xde, 0xfc, 0xe5, 0x6b, 0xf4, 0x32, 0xc8, 0xbc, 0xb4, 0x4d, 0x53, 0x37, 0x4d, 0xfb,
-		0x87, 0xce, 0xbb, 0xf6, 0x9f, 0x8f, 0x43, 0xd3, 0xfc, 0xc1, 0x34, 0xb5, 0x51, 0xa6, 0x44, 0xa7,
-		0xb5, 0x6b, 0x37, 0x14, 0x97, 0xf9, 0xf9, 0xca, 0x36, 0x32, 0x05, 0xae, 0x3d, 0x41, 0xab, 0x2f,
-		0xca, 0x0e, 0x2f, 0x72, 0x31, 0xf1, 0xa2, 0xa8, 0x98, 0xf5, 0x17, 0x10, 0xfc, 0x57, 0x80, 0x40,
-		0x94, 0x99, 0x6a, 0x8c, 0xe3, 0x33, 0x92, 0x0b, 0x00, 0x53, 0xfb, 0x99, 0xef, 0x6d, 0x0a, 0x8a,
-		0xef, 0x55, 0x6c, 0x77, 0x06, 0x31, 0x29, 0x4b, 0x5e, 0x2a, 0x02, 0x24, 0xa2, 0xa9, 0x24, 0xc5,
-		0x52, 0xcc, 0x8a, 0xab, 0x09, 0xc9, 0x94, 0x91, 0x95, 0xe3, 0x74, 0xe5, 0xe3, 0x72, 0x9f, 0xc4,
-		0x72, 0xe3, 0xca, 0x3f, 0x6a, 0xaf, 0x7f, 0xd4, 0xbc, 0x87, 0xad, 0x88, 0xcf, 0xa3, 0xe2, 0x7b,
-		0xad, 0x85, 0x54, 0x52, 0xab, 0xdd, 0x6e, 0x0f, 0xa1, 0xf6, 0xf7, 0x99, 0xf6, 0xa7, 0xa1, 0x9d,
-		0x5e, 0x8e, 0x52, 0x7f, 0x98, 0xa6, 0x76, 0x39, 0xea, 0x3c, 0x18, 0x07, 0x27, 0xdd, 0xa7, 0xce,
-		0xbb, 0xc5, 0xe7, 0x23, 0xd3, 0xd4, 0x3b, 0xaf, 0xab, 0xd4, 0x7a, 0xd7, 0x79, 0x34, 0x4d, 0x3d,
-		0x7f, 0xa5, 0x8e, 0xea, 0x83, 0xb6, 0x07, 0x0b, 0xb6, 0x72, 0xcb, 0xb0, 0xcd, 0x0b, 0x8b, 0xde,
-		0xb7, 0x1d, 0x69, 0x17, 0x10, 0xd6, 0xc1, 0x64, 0xa2, 0x83, 0xb3, 0xb9, 0xcd, 0x69, 0x41, 0x02,
-		0x3c, 0xe8, 0x33, 0x6c, 0x61, 0x8f, 0x87, 0x78, 0x12, 0x60, 0x68, 0xdd, 0x79, 0x41, 0xaa, 0xee,
-		0xe5, 0x6e, 0xfc, 0xee, 0x3e, 0x9c, 0x2a, 0x64, 0xc7, 0x66, 0x88, 0xf8, 0x26, 0x26, 0x5b, 0x4d,
-		0x6e, 0x0f, 0x73, 0x01, 0xa3, 0x84, 0x47, 0x31, 0xa7, 0x99, 0xd8, 0x03, 0xa1, 0x5c, 0x4d, 0x02,
-		0xe8, 0xdb, 0xc0, 0x87, 0xd8, 0x01, 0xb7, 0xd8, 0x71, 0x80, 0x8f, 0xfe, 0x0a, 0xb0, 0x1f, 0xee,
-		0x73, 0x20, 0x33, 0x09, 0x4f, 0x7f, 0xc4, 0xef, 0x4e, 0x01, 0x98, 0x26, 0xb7, 0x79, 0xde, 0x4e,
-		0x51, 0xc4, 0x8b, 0xc6, 0x62, 0xa7, 0xf1, 0xf4, 0xa9, 0x0e, 0xa6, 0x8c, 0xc7, 0x14, 0x43, 0x06,
-		0x1c, 0x14, 0x6e, 0x9b, 0xba, 0x00, 0xcd, 0x69, 0x6e, 0xb5, 0x3b, 0x52, 0xbb, 0xa3, 0xe7, 0xba,
-		0xe1, 0xfb, 0x76, 0x90, 0xd8, 0xf8, 0x83, 0x85, 0xcd, 0xbc, 0x05, 0x42, 0x22, 0x2d, 0xfe, 0x72,
-		0x2b, 0x39, 0xa9, 0x25, 0xb7, 0x90, 0xbf, 0xce, 0x2f, 0x66, 0x75, 0xa3, 0xf5, 0xe7, 0xb8, 0x16,
-		0x74, 0xe6, 0xeb, 0x9a, 0xb9, 0x00, 0xf1, 0xe0, 0x61, 0x4c, 0xa7, 0xc9, 0xea, 0x44, 0x36, 0x70,
-		0x30, 0xb9, 0x36, 0xc9, 0x39, 0x01, 0xe3, 0x80, 0x05, 0x7c, 0x65, 0x23, 0x1f, 0x81, 0x19, 0xbc,
-		0x07, 0x57, 0x08, 0xcc, 0xdc, 0x68, 0xad, 0x13, 0xd0, 0x8d, 0x5a, 0x66, 0x2e, 0xf0, 0x7c, 0xf7,
-		0x06, 0xdb, 0x08, 0xf8, 0xc8, 0x0e, 0x88, 0x0d, 0x89, 0x75, 0xaf, 0x9b, 0xe4, 0xa7, 0x78, 0xeb,
-		0x80, 0x09, 0x66, 0x18, 0x3a, 0xe0, 0x06, 0xf9, 0x14, 0xf3, 0x6e, 0x60, 0x1a, 0x62, 0x83, 0x83,
-		0x67, 0x98, 0x21, 0x3b, 0xac, 0xdf, 0x55, 0x11, 0x81, 0x7b, 0x1f, 0x11, 0x98, 0xbe, 0x4b, 0x5f,
-		0x3a, 0x1c, 0x26, 0x5d, 0xb9, 0x5a, 0x44, 0xcc, 0xc5, 0xfc, 0x42, 0xea, 0x79, 0x76, 0xa0, 0x80,
-		0x22, 0x9b, 0x6f, 0x7f, 0x63, 0xc2, 0x8e, 0x27, 0xd5, 0x73, 0x81, 0x0a, 0x17, 0x14, 0xad, 0xa7,
-		0x82, 0x53, 0x24, 0xf5, 0x5d, 0x0d, 0xbd, 0x57, 0x45, 0xff, 0xad, 0xe8, 0xc1, 0x74, 0x70, 0x4a,
-		0x14, 0xd2, 0x7e, 0x3b, 0x48, 0x2f, 0xac, 0x67, 0x0c, 0x8c, 0x93, 0xbc, 0xe7, 0x7c, 0x15, 0x0f,
-		0x64, 0xee, 0x3b, 0x2f, 0x86, 0x84, 0xf8, 0x92, 0xc6, 0x65, 0x54, 0x88, 0xf7, 0x5b, 0x67, 0x0a,
-		0x0f, 0x14, 0x1e, 0xec, 0x13, 0x1e, 0x64, 0x17, 0x56, 0x23, 0x62, 0xd6, 0xaa, 0x46, 0x69, 0x2d,
-		0xf8, 0xcd, 0xe7, 0xbd, 0x26, 0x6b, 0xe3, 0x77, 0x62, 0x3d, 0x77, 0x4c, 0x5f, 0x8a, 0x9f, 0x3a,
-		0x94, 0xd8, 0x9b, 0x08, 0x79, 0x47, 0xf8, 0xcb, 0x05, 0xc4, 0xfe, 0xe5, 0x45, 0xd4, 0xf2, 0x45,
-		0xe9, 0xfd, 0xb7, 0xcd, 0x73, 0x11, 0x95, 0xf2, 0x77, 0x72, 0x43, 0x51, 0xc7, 0x5b, 0x74, 0xe3,
-		0x40, 0x52, 0xce, 0x38, 0xf2, 0x52, 0x62, 0x54, 0x23, 0x8c, 0x68, 0x1b, 0x77, 0x0c, 0xfe, 0xf8,
-		0x78, 0xf6, 0xab, 0xe2, 0x0f, 0x9b, 0xcf, 0x1f, 0xaa, 0x9b, 0x94, 0x05, 0xf5, 0xab, 0xba, 0xf0,
-		0xee, 0x79, 0xf8, 0x3c, 0x75, 0xe1, 0xdd, 0x16, 0x1c, 0x7b, 0xd5, 0x1d, 0x7c, 0x92, 0x06, 0x2c,
-		0x50, 0x37, 0x29, 0x57, 0x29, 0x35, 0x52, 0xf7, 0x9a, 0x2a, 0x98, 0x57, 0x30, 0xaf, 0x60, 0x7e,
-		0x6f, 0x60, 0x5e, 0xdd, 0x6b, 0xba, 0x11, 0x90, 0xa7, 0xc1, 0x15, 0x41, 0x12, 0xde, 0xc2, 0xb8,
-		0xbc, 0x1c, 0xb0, 0xff, 0x8a, 0xd8, 0xad, 0xeb, 0x5f, 0x83, 0xa8, 0x32, 0xe5, 0x14, 0x67, 0xb8,
-		0xbf, 0x53, 0x6e, 0x78, 0xe5, 0x86, 0x4f, 0x2e, 0xe1, 0xf0, 0x6e, 0x8e, 0x35, 0xe1, 0xb1, 0xd8,
-		0x6e, 0x88, 0x72, 0xbb, 0x3d, 0x34, 0xb4, 0xd3, 0xd1, 0xe3, 0xb0, 0xab, 0x9d, 0x8e, 0xa2, 0xb7,
-		0x5d, 0xfe, 0x12, 0xbd, 0xef, 0x0d, 0x0d, 0xed, 0x78, 0xfe, 0xbe, 0x3f, 0x34, 0xb4, 0xfe, 0xa8,
-		0x63, 0x9a, 0x7a, 0xe7, 0xe1, 0xe8, 0x49, 0xbe, 0xe2, 0x61, 0xfc, 0x63, 0x9d, 0xc7, 0xf6, 0xb0,
-		0xab, 0xf5, 0x46, 0xf3, 0x3f, 0x8e, 0x86, 0x86, 0xd6, 0x1b, 0x75, 0xea, 0x87, 0x38, 0x6f, 0x91,
-		0x9e, 0xec, 0xee, 0xd0, 0x2d, 0xfe, 0xf3, 0x83, 0xd2, 0x52, 0xd9, 0x8b, 0xf2, 0x83, 0x9f, 0xf3,
-		0xa0, 0x90, 0x4d, 0x11, 0x87, 0x3e, 0x70, 0xfe, 0x41, 0xa1, 0x9f, 0x42, 0x3f, 0x01, 0x8a, 0x74,
-		0x9d, 0x4c, 0x9c, 0x08, 0x14, 0x95, 0x4b, 0x46, 0xfd, 0x5c, 0x96, 0xac, 0xa1, 0x2c, 0xd9, 0xe5,
-		0x21, 0x39, 0x36, 0x4e, 0x4f, 0x94, 0x2d, 0x2b, 0x0a, 0xe1, 0x55, 0x52, 0x2c, 0x14, 0x43, 0xb5,
-		0xca, 0xa7, 0xb0, 0xea, 0x64, 0x2a, 0xc1, 0xa3, 0x32, 0xef, 0xd2, 0x1f, 0x61, 0x75, 0x51, 0xbf,
-		0xd2, 0xab, 0x82, 0x27, 0x9b, 0xcf, 0x60, 0xde, 0x59, 0xa3, 0xe2, 0xb9, 0x2b, 0x9f, 0xb3, 0x4a,
-		0x73, 0x55, 0x3c, 0x47, 0xcb, 0x4f, 0x50, 0x32, 0x27, 0xa5, 0x73, 0xd1, 0x5a, 0x7b, 0x36, 0xbf,
-		0x68, 0xf8, 0xb3, 0x23, 0xbf, 0x18, 0xdf, 0x54, 0xcf, 0xe6, 0x03, 0x3a, 0x73, 0x6d, 0xe4, 0xac,
-		0xf4, 0x6b, 0xf9, 0xac, 0x40, 0x54, 0x6a, 0xe9, 0xb9, 0x56, 0x0e, 0x09, 0xf0, 0x52, 0xc0, 0x1d,
-		0xaf, 0x3f, 0xce, 0x95, 0xe3, 0xb3, 0xcb, 0x35, 0x5f, 0x8a, 0xcc, 0x95, 0x45, 0xff, 0x66, 0xeb,
-		0x46, 0xa7, 0xc4, 0x1c, 0x11, 0x36, 0x3f, 0x84, 0xcd, 0x8d, 0xac, 0x79, 0x31, 0x6b, 0x49, 0xca,
-		0x78, 0x9e, 0x8f, 0xad, 0x95, 0xa4, 0x49, 0x28, 0xf7, 0xb1, 0x2e, 0x8a, 0x8a, 0x3a, 0x5a, 0x67,
-		0xd0, 0x0b, 0x27, 0x8b, 0xe7, 0xf9, 0x4d, 0x25, 0x64, 0x18, 0x80, 0x88, 0x59, 0xd3, 0x0a, 0x09,
-		0xb6, 0x67, 0xf5, 0xc0, 0x16, 0x52, 0xaa, 0x0d, 0xf5, 0xc0, 0x16, 0x51, 0xa6, 0x1b, 0xf6, 0xc0,
-		0x26, 0x73, 0xa7, 0x5d, 0x73, 0xb0, 0x14, 0xdc, 0xbf, 0x64, 0xab, 0xc9, 0xef, 0x62, 0xae, 0xd1,
-		0x3d, 0xc0, 0x04, 0x24, 0x82, 0x24, 0x7d, 0xb3, 0xba, 0xa2, 0xe9, 0xf7, 0x80, 0xa6, 0xbf, 0x87,
-		0x64, 0xa2, 0x2d, 0x8e, 0xf8, 0x28, 0xbe, 0x7e, 0x7f, 0x77, 0x39, 0xfb, 0xb7, 0xc7, 0xd9, 0x06,
-		0x37, 0x19, 0x9f, 0x2a, 0xbd, 0x1c, 0x65, 0x8f, 0x9d, 0xea, 0xa3, 0xd7, 0xa2, 0x58, 0xa3, 0x3f,
-		0xea, 0xfa, 0xe3, 0xf0, 0xdb, 0xdd, 0x7f, 0x47, 0xfa, 0xeb, 0x47, 0x7d, 0xf8, 0x6d, 0xf6, 0x89,
-		0xbf, 0xd1, 0x87, 0xdf, 0x9c, 0x8f, 0x23, 0xfd, 0x75, 0xeb, 0xbb, 0xf8, 0x21, 0x6e, 0x62, 0x31,
-		0x11, 0x25, 0xdf, 0x78, 0x71, 0x79, 0xa5, 0x15, 0x5d, 0x6d, 0x97, 0xd6, 0x56, 0x22, 0xda, 0x4f,
-		0x29, 0xac, 0x97, 0xaa, 0xb0, 0x24, 0x2e, 0xfd, 0x50, 0x3e, 0x68, 0xa5, 0xd3, 0x24, 0x87, 0xa4,
-		0x67, 0x28, 0x27, 0xf4, 0x56, 0x89, 0x3b, 0x11, 0x94, 0x56, 0xf4, 0xdd, 0x82, 0x32, 0x8a, 0x98,
-		0x9a, 0xc3, 0xcc, 0x1f, 0x65, 0xcc, 0x40, 0x11, 0xa1, 0xf4, 0x29, 0x6c, 0xe1, 0x32, 0xfd, 0xfe,
-		0x2c, 0x69, 0xad, 0x46, 0xd8, 0xb8, 0x48, 0xcc, 0xb0, 0x44, 0xac, 0x70, 0xad, 0x18, 0xe1, 0x67,
-		0x4d, 0x2f, 0xb4, 0xdf, 0x4c, 0x86, 0x78, 0x7a, 0xa1, 0x52, 0xc5, 0xaa, 0x32, 0x0b, 0x35, 0x2a,
-		0xb3, 0x90, 0x78, 0xcc, 0xed, 0x4b, 0x48, 0x2d, 0x54, 0x07, 0xd9, 0x44, 0x62, 0x66, 0x65, 0x62,
-		0x65, 0x37, 0x15, 0x23, 0xab, 0x60, 0x4e, 0xc1, 0x9c, 0x82, 0xb9, 0x9a, 0x30, 0x27, 0x1a, 0x73,
-		0xba, 0xe3, 0x20, 0xe7, 0x61, 0x0f, 0x39, 0x98, 0x08, 0x00, 0x5c, 0x52, 0x52, 0x0c, 0xdc, 0x2e,
-		0xe2, 0xe2, 0xc0, 0xe2, 0x68, 0x14, 0x44, 0xc9, 0xd0, 0x81, 0x06, 0xec, 0x00, 0x3a, 0x21, 0xbe,
-		0xfd, 0x15, 0xc0, 0x46, 0xd8, 0x71, 0x6b, 0xfd, 0x8e, 0x0d, 0x07, 0xb8, 0x75, 0x7e, 0xc9, 0x6a,
-		0x00, 0xb7, 0xd3, 0x69, 0xef, 0x0b, 0x93, 0x27, 0x2d, 0x04, 0x3b, 0xff, 0x40, 0xf2, 0xaa, 0x63,
-		0x9c, 0x27, 0x32, 0xe2, 0xae, 0x32, 0xa1, 0x44, 0xa7, 0x5d, 0x25, 0xc1, 0xdb, 0x96, 0xe0, 0xf2,
-		0x9c, 0xf7, 0x12, 0xf9, 0x82, 0x2a, 0xe4, 0x09, 0x5a, 0x96, 0x91, 0x54, 0x4e, 0xdc, 0x38, 0xf7,
-		0x3d, 0x4f, 0x16, 0xa4, 0x01, 0x6f, 0x7a, 0x4f, 0xb1, 0x05, 0x9d, 0x24, 0xcd, 0x96, 0x58, 0xc2,
-		0xbd, 0x46, 0x84, 0x8e, 0xce, 0x76, 0x30, 0x74, 0x74, 0xd6, 0x3c, 0x0f, 0x6b, 0x80, 0x09, 0x7b,
-		0x2b, 0xc1, 0x41, 0xf7, 0x55, 0xec, 0xe8, 0x0e, 0x31, 0xd0, 0xfd, 0xbe, 0x62, 0xa0, 0x45, 0x19,
-		0xe8, 0x03, 0x95, 0xc5, 0xa0, 0x36, 0xaa, 0x2b, 0x37, 0xe4, 0xf3, 0xa0, 0xba, 0x72, 0x2d, 0xee,
-		0x37, 0xb0, 0xab, 0x2c, 0x06, 0x9b, 0x42, 0x76, 0x95, 0xc5, 0x40, 0xc1, 0xbc, 0x82, 0x79, 0x05,
-		0xf3, 0x2a, 0x8b, 0xc1, 0xee, 0x82, 0xfc, 0x0c, 0xde, 0x69, 0x71, 0x56, 0x46, 0x71, 0x8c, 0x4f,
-		0x57, 0x92, 0x83, 0xf8, 0x33, 0x40, 0x3d, 0x07, 0x33, 0x86, 0x7c, 0x7e, 0x01, 0x86, 0x8d, 0x79,
-		0x7e, 0xf2, 0xf9, 0x95, 0x85, 0xfc, 0x96, 0x56, 0x77, 0x9e, 0x25, 0x92, 0xea, 0x26, 0xf9, 0x3a,
-		0x45, 0xc0, 0x8e, 0xee, 0x34, 0x8f, 0x23, 0x10, 0x0d, 0x80, 0x89, 0x8d, 0x2d, 0xc8, 0x10, 0x4d,
-		0xd5, 0xa3, 0x80, 0xb8, 0x49, 0x52, 0x58, 0xfc, 0x37, 0xbc, 0x72, 0x04, 0x94, 0x8f, 0xf8, 0x55,
-		0xe9, 0x2d, 0xa3, 0x18, 0x00, 0x47, 0x8a, 0x55, 0x52, 0xac, 0x92, 0x62, 0x95, 0x94, 0x56, 0xea,
-		0xaa, 0xf3, 0xc8, 0x9b, 0xd0, 0x4a, 0xd4, 0x43, 0xc8, 0xa6, 0x12, 0xb9, 0x75, 0xa2, 0xf2, 0x72,
-		0xba, 0x88, 0x6b, 0x0e, 0x5e, 0x53, 0x39, 0x05, 0x14, 0x7c, 0x27, 0xd9, 0x74, 0xb8, 0x43, 0x89,
-		0xdd, 0x4b, 0x5e, 0x6c, 0x23, 0x82, 0xe2, 0xe7, 0x71, 0xd3, 0x3f, 0x42, 0x8a, 0xe4, 0x73, 0xe7,
-		0x8b, 0x48, 0x6a, 0x16, 0x8f, 0xa8, 0xb0, 0xc6, 0x90, 0xd3, 0x1a, 0xab, 0xdd, 0xd2, 0xba, 0xc6,
-		0xa4, 0xb5, 0x0d, 0x34, 0xae, 0xd5, 0xa9, 0x9e, 0xd6, 0x6f, 0x62, 0xaf, 0x9a, 0xd8, 0xa9, 0xe3,
-		0x26, 0xce, 0x5f, 0xd7, 0x30, 0x1a, 0x39, 0x54, 0x8d, 0x1c, 0xab, 0x06, 0xf6, 0x09, 0x06, 0xcc,
-		0x25, 0xa8, 0x81, 0x1d, 0x93, 0x5a, 0x81, 0x42, 0x25, 0x47, 0x5b, 0xd6, 0x63, 0x7b, 0x95, 0x6b,
-		0xac, 0xca, 0x79, 0x97, 0xf2, 0x08, 0x12, 0x75, 0xda, 0xa5, 0xf8, 0xb4, 0x4b, 0xc9, 0x4d, 0x11,
-		0xe2, 0x07, 0x5d, 0xf2, 0x6f, 0x86, 0x90, 0xba, 0x93, 0x95, 0xf7, 0x4a, 0xe6, 0x3a, 0xed, 0xa4,
-		0xc2, 0x0e, 0x5d, 0xaa, 0xbd, 0xd7, 0xb1, 0x66, 0xe2, 0x91, 0x90, 0xe2, 0xb9, 0x12, 0x54, 0x5c,
-		0x78, 0xc3, 0x8e, 0xbf, 0x18, 0xea, 0x62, 0xed, 0x74, 0xc1, 0xca, 0x49, 0x0a, 0xaa, 0x27, 0x27,
-		0x18, 0x6d, 0x21, 0xdd, 0x58, 0x0e, 0x16, 0xef, 0x72, 0xd2, 0xb1, 0x35, 0x3a, 0x55, 0x22, 0x01,
-		0xd9, 0x8a, 0x1a, 0xcd, 0x4d, 0x45, 0xf6, 0x2a, 0xd5, 0xeb, 0xbc, 0xde, 0xb6, 0x30, 0xfd, 0x09,
-		0x5e, 0xa3, 0xcf, 0xae, 0xbb, 0x0a, 0xe0, 0xcb, 0x4f, 0xd0, 0x4a, 0x7f, 0x95, 0xe9, 0xdc, 0x07,
-		0x74, 0x83, 0xad, 0xf8, 0xb8, 0xea, 0xd3, 0xab, 0xa7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x01,
-		0x00, 0x00, 0xff, 0xff, 0x55, 0x44, 0x8a, 0xe2, 0xfb, 0x60, 0x01, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x6d, 0x6f, 0xdb, 0xb8,
+		0xb2, 0xfe, 0x9e, 0x5f, 0x31, 0x10, 0xee, 0x01, 0xec, 0x6e, 0xa4, 0xd8, 0x8e, 0x9d, 0x36, 0x06,
+		0x0e, 0x7a, 0xb2, 0xed, 0xd9, 0xb3, 0xb9, 0xdb, 0xee, 0x06, 0x6d, 0x77, 0x71, 0xb0, 0xb6, 0x1b,
+		0x30, 0x12, 0xed, 0xf0, 0xc6, 0xa6, 0xb4, 0x22, 0x95, 0xd4, 0xdb, 0xe4, 0xbf, 0x5f, 0x50, 0x2f,
+		0xb6, 0xfc, 0x26, 0x91, 0x92, 0x9d, 0x28, 0x0e, 0x8d, 0xa2, 0x76, 0x12, 0x92, 0xa6, 0xc8, 0xe1,
+		0x33, 0xc3, 0x67, 0x86, 0xc3, 0xef, 0x07, 0x00, 0x00, 0xc6, 0xaf, 0x68, 0x82, 0x8d, 0x2e, 0x18,
+		0x0e, 0xbe, 0x25, 0x36, 0x36, 0x0e, 0xa3, 0xdf, 0xfe, 0x42, 0xa8, 0x63, 0x74, 0xa1, 0x19, 0xff,
+		0xf8, 0xce, 0xa5, 0x43, 0x32, 0x32, 0xba, 0xd0, 0x88, 0x7f, 0xf1, 0x9e, 0xf8, 0x46, 0x17, 0xa2,
+		0x26, 0x00, 0x00, 0x0c, 0xe7, 0xda, 0xf6, 0x4c, 0x86, 0xfd, 0x5b, 0xbc, 0xf8, 0x87, 0xc5, 0xef,
+		0x48, 0x15, 0x3a, 0x5c, 0x2c, 0xf2, 0x1e, 0x33, 0xdb, 0x27, 0x1e, 0x27, 0x2e, 0x15, 0x25, 0xcf,
+		0x60, 0x4c, 0x18, 0x07, 0x77, 0x08, 0xef, 0x7f, 0x7e, 0x77, 0x01, 0x9f, 0xc3, 0x3a, 0x6c, 0xb9,
+		0xd2, 0x62, 0x2f, 0x67, 0xbf, 0x5e, 0xee, 0xed, 0xec, 0x0f, 0x17, 0x3e, 0x1e, 0x92, 0x6f, 0x2b,
+		0xfd, 0x5b, 0xe9, 0xa3, 0x71, 0xb8, 0xfa, 0xf7, 0xcf, 0x6e, 0xe0, 0xdb, 0x78, 0x6d, 0xdd, 0xa8,
+		0x2f, 0x78, 0x7a, 0xe7, 0xfa, 0xa2, 0x3b, 0x86, 0x17, 0x7d, 0xcd, 0xe1, 0xfa, 0x82, 0x3f, 0x23,
+		0x76, 0xe6, 0x8f, 0x82, 0x09, 0xa6, 0xdc, 0xe8, 0x02, 0xf7, 0x03, 0xbc, 0xa1, 0x60, 0xaa, 0x54,
+		0xd4, 0xab, 0x95, 0x62, 0x0f, 0x0b, 0xbf, 0x79, 0x58, 0x1e, 0x52, 0xe2, 0xaf, 0x7f, 0x54, 0xe4,
+		0x38, 0x3e, 0x66, 0x6c, 0xf3, 0xb3, 0x24, 0x63, 0x91, 0x14, 0xdc, 0xd0, 0xc1, 0xa5, 0x39, 0x43,
+		0x14, 0x88, 0x07, 0x39, 0x75, 0xe2, 0x29, 0x6b, 0x6c, 0xf8, 0xf3, 0xa6, 0xa9, 0x93, 0x99, 0x42,
+		0xd9, 0xa9, 0x94, 0x9d, 0x52, 0xe5, 0xa9, 0x55, 0x9e, 0x62, 0x85, 0xa9, 0x5e, 0x3f, 0xe5, 0x1b,
+		0xa6, 0x3e, 0x79, 0x19, 0x1f, 0x11, 0x75, 0x10, 0x77, 0xfd, 0xe9, 0xea, 0x2a, 0x49, 0x5e, 0xc6,
+		0x97, 0xa9, 0x87, 0xe5, 0xc6, 0x93, 0x78, 0xb7, 0x6d, 0x33, 0x9e, 0x5f, 0x93, 0xba, 0xe6, 0xdf,
+		0x2e, 0xc5, 0x59, 0xe3, 0x9b, 0x2c, 0xcf, 0x37, 0x19, 0x65, 0x2e, 0x10, 0xe7, 0xd8, 0x17, 0xf2,
+		0xd3, 0xcb, 0x1e, 0xa2, 0x5a, 0xad, 0xd7, 0x30, 0x4f, 0x07, 0xf7, 0xbd, 0xa6, 0x79, 0x3a, 0x88,
+		0x3e, 0x36, 0xc3, 0xb7, 0xe8, 0x73, 0xab, 0xd7, 0x30, 0xdb, 0xc9, 0xe7, 0x4e, 0xaf, 0x61, 0x76,
+		0x06, 0xf5, 0x7e, 0xdf, 0xaa, 0x7f, 0x3f, 0x7e, 0x50, 0xaf, 0x58, 0xfb, 0x47, 0xaf, 0xdf, 0xf7,
+		0xbe, 0xff, 0xfa, 0x20, 0xfe, 0xff, 0xf0, 0x30, 0xf8, 0xa1, 0xfe, 0x36, 0x6f, 0xca, 0x45, 0x03,
+		0xfd, 0xbe, 0x35, 0x78, 0xb5, 0x79, 0xea, 0x06, 0x07, 0x72, 0x13, 0xba, 0x66, 0x32, 0x0d, 0x67,
+		0x61, 0xa9, 0xe5, 0x2c, 0xdb, 0x74, 0x61, 0xb9, 0xa5, 0x3b, 0x76, 0xe9, 0x08, 0x52, 0xd5, 0x60,
+		0x48, 0xf0, 0xd8, 0xa9, 0xc2, 0x1a, 0x1e, 0xf2, 0xe7, 0xb7, 0x82, 0x87, 0x7c, 0x5b, 0xeb, 0x57,
+		0x7e, 0x6d, 0x32, 0xee, 0x13, 0x3a, 0x2a, 0xbb, 0x1a, 0x3f, 0x60, 0x3a, 0xe2, 0xd7, 0xb9, 0x8b,
+		0x31, 0x7b, 0xb4, 0x23, 0xe4, 0x21, 0xd4, 0xe8, 0x4a, 0x14, 0x04, 0x00, 0x30, 0xfe, 0x40, 0xe3,
+		0x00, 0x6f, 0x86, 0xa8, 0xe5, 0x97, 0xf1, 0x93, 0x8f, 0x6c, 0x21, 0xa6, 0xef, 0xc9, 0x88, 0x70,
+		0xb6, 0x59, 0xd2, 0x56, 0x47, 0x0b, 0x8f, 0x10, 0x27, 0xb7, 0xe2, 0xbb, 0x86, 0x68, 0xcc, 0x70,
+		0x6e, 0xad, 0x87, 0x43, 0x89, 0x47, 0x45, 0xdf, 0x0a, 0x3c, 0x6a, 0xa3, 0xd5, 0xae, 0xde, 0xd3,
+		0x1e, 0x14, 0xfb, 0x6b, 0x29, 0x64, 0x9b, 0xdb, 0x84, 0x26, 0x71, 0x24, 0xc0, 0x6d, 0xb1, 0xbc,
+		0x1c, 0xbe, 0x7d, 0xb9, 0xc6, 0x70, 0xfe, 0x1e, 0xdc, 0x21, 0xf0, 0x6b, 0x9c, 0x36, 0x29, 0xb5,
+		0x99, 0x52, 0x05, 0x33, 0x45, 0x1e, 0xe6, 0xa6, 0x88, 0x8e, 0x4c, 0xe2, 0x60, 0xca, 0xc9, 0x90,
+		0x6c, 0x9c, 0x3e, 0x8d, 0x77, 0xd5, 0xc3, 0xbb, 0x37, 0xed, 0xf6, 0xc9, 0xeb, 0x76, 0xbb, 0xf1,
+		0xfa, 0xf8, 0x75, 0xe3, 0xb4, 0xd3, 0x69, 0x9e, 0x34, 0x3b, 0xfb, 0x83, 0x7f, 0xdb, 0x30, 0x70,
+		0x7b, 0xc8, 0xfc, 0xfb, 0xcc, 0xfc, 0xf3, 0x72, 0x10, 0x7f, 0x08, 0x0d, 0x4a, 0xf3, 0x52, 0x98,
+		0x94, 0x39, 0xcb, 0xd2, 0xba, 0xb7, 0xac, 0xfb, 0xde, 0xd7, 0x6f, 0xff, 0x1d, 0x58, 0xaf, 0xee,
+		0xad, 0xde, 0xd7, 0xc9, 0xc7, 0xf0, 0x83, 0xd5, 0xfb, 0x3a, 0xfe, 0x30, 0xb0, 0x76, 0x65, 0x91,
+		0x12, 0xe6, 0x8d, 0xd1, 0xd4, 0xa4, 0xd1, 0xca, 0xcc, 0x43, 0xed, 0x74, 0x69, 0x39, 0xcc, 0x8e,
+		0xab, 0x80, 0xa8, 0x02, 0xdc, 0x85, 0x80, 0x61, 0x20, 0x14, 0xfe, 0xf3, 0xfb, 0x39, 0xb8, 0x3e,
+		0xbc, 0xfb, 0x70, 0xae, 0xcd, 0x53, 0x6d, 0x9e, 0x6a, 0xb8, 0xde, 0x0d, 0x5c, 0xbf, 0x69, 0xbc,
+		0x3c, 0xe3, 0x34, 0x93, 0x4c, 0xfb, 0x05, 0x4f, 0x73, 0xad, 0x4f, 0xe3, 0x03, 0x61, 0xfc, 0x8c,
+		0xf3, 0x0d, 0xb4, 0xdb, 0x47, 0x42, 0xff, 0x3d, 0xc6, 0x62, 0x15, 0x6e, 0x18, 0x27, 0x31, 0x55,
+		0xa9, 0x12, 0x72, 0x2a, 0xd3, 0xf8, 0xcd, 0x77, 0xb0, 0x8f, 0x9d, 0x1f, 0x45, 0xff, 0x68, 0x30,
+		0x1e, 0x67, 0x3e, 0xc6, 0x19, 0xa5, 0x2e, 0x47, 0x1b, 0x69, 0x04, 0x83, 0xd9, 0xd7, 0x78, 0x82,
+		0x3c, 0x14, 0xae, 0x32, 0xe3, 0xc8, 0xa5, 0x43, 0x33, 0xf5, 0xc8, 0x47, 0x9b, 0x09, 0xdc, 0xa8,
+		0x36, 0xf7, 0x03, 0x9b, 0xc7, 0x1a, 0xc1, 0xf8, 0x8d, 0x0e, 0xdf, 0x5f, 0xdb, 0x5e, 0x64, 0x68,
+		0x5f, 0xce, 0x3f, 0x1a, 0x07, 0xeb, 0x87, 0x3d, 0xd5, 0x57, 0xc3, 0x77, 0x03, 0x8e, 0x37, 0x13,
+		0xc9, 0xd1, 0x9f, 0x65, 0x29, 0xe4, 0xb0, 0xf4, 0xa3, 0x90, 0xc7, 0x3e, 0xaf, 0x1e, 0x75, 0xec,
+		0xf3, 0xaa, 0x13, 0xc7, 0xe7, 0x17, 0x09, 0x6b, 0x2c, 0x66, 0xeb, 0xda, 0xf5, 0xaa, 0xa0, 0xd9,
+		0xfd, 0x67, 0xa8, 0xd9, 0x7d, 0x5e, 0x55, 0xe2, 0xb8, 0x08, 0x6d, 0x7c, 0x9a, 0x51, 0x26, 0xfe,
+		0xee, 0xd2, 0x76, 0x40, 0x41, 0x6a, 0x5b, 0xc5, 0x6a, 0x51, 0xde, 0x09, 0x3c, 0x13, 0xca, 0x5b,
+		0x85, 0xfa, 0xce, 0xd6, 0xc5, 0x39, 0xc2, 0x58, 0x6c, 0x3a, 0x4f, 0x2a, 0x38, 0x9d, 0xdd, 0x7b,
+		0x31, 0x56, 0xc8, 0x1c, 0x9e, 0x99, 0x3f, 0x0d, 0xbe, 0x37, 0x0e, 0xdb, 0x0f, 0xf5, 0x6e, 0xbd,
+		0xb6, 0xfc, 0xbb, 0x6e, 0xfd, 0x7b, 0xe3, 0xb0, 0xf3, 0x50, 0xab, 0xad, 0xf9, 0xcb, 0xdb, 0x75,
+		0x6d, 0xd4, 0xef, 0x6b, 0xb5, 0x5a, 0x3c, 0x91, 0x0b, 0x93, 0xdb, 0x6b, 0x34, 0x07, 0x6f, 0xc3,
+		0x8f, 0xd1, 0xff, 0x33, 0xf1, 0x90, 0x2a, 0x5c, 0x2f, 0x23, 0x14, 0xb5, 0x5a, 0xef, 0x6b, 0x77,
+		0xf0, 0x43, 0xb7, 0xfe, 0xfd, 0xe4, 0x21, 0xf9, 0x1c, 0xfe, 0x5f, 0xbf, 0xaf, 0x59, 0xaf, 0xfa,
+		0x7d, 0xcb, 0x7a, 0x55, 0x8f, 0x3a, 0x1e, 0x97, 0x7b, 0x15, 0xfd, 0xf5, 0x6d, 0xb7, 0xbb, 0xf2,
+		0xab, 0x7a, 0xed, 0x1f, 0x96, 0xa2, 0x3c, 0x46, 0xe3, 0xd3, 0xdd, 0x8e, 0x58, 0x6a, 0x8f, 0x8e,
+		0xde, 0x32, 0xeb, 0x2d, 0xb3, 0xde, 0x32, 0x03, 0x68, 0x8f, 0x8e, 0x0c, 0xb2, 0x69, 0x66, 0x50,
+		0xc3, 0x9c, 0x86, 0x39, 0x00, 0xcd, 0x0c, 0xee, 0x2f, 0xc8, 0x4d, 0x30, 0xf7, 0x89, 0x9d, 0x0f,
+		0x6f, 0x71, 0x39, 0x39, 0x60, 0xfb, 0x18, 0x16, 0x06, 0xe6, 0x61, 0x5b, 0xb8, 0x37, 0x59, 0xe8,
+		0xad, 0xf6, 0x7c, 0xe2, 0xfa, 0x84, 0x4f, 0x35, 0x29, 0xb2, 0x5f, 0xa4, 0x48, 0x40, 0x28, 0x7f,
+		0x23, 0x81, 0x7b, 0x19, 0x6e, 0x52, 0xe3, 0x13, 0xa2, 0x23, 0xfc, 0x64, 0xa8, 0xd7, 0x78, 0x39,
+		0xa8, 0xd7, 0xea, 0x74, 0x34, 0xec, 0xc1, 0x6c, 0xbd, 0xe6, 0xc2, 0x5e, 0x36, 0x8f, 0xbd, 0x04,
+		0x7b, 0x2c, 0xb8, 0xa2, 0x98, 0x03, 0x77, 0x61, 0x82, 0xb8, 0x7d, 0x0d, 0x1e, 0xb2, 0x6f, 0x30,
+		0xd7, 0x78, 0xb7, 0x77, 0x24, 0x70, 0xee, 0xd3, 0x3f, 0x1d, 0xf7, 0x2b, 0x35, 0x31, 0xb0, 0x57,
+		0x94, 0xef, 0x51, 0xfc, 0x65, 0xf5, 0xfb, 0x5a, 0xaf, 0x69, 0xb6, 0x06, 0xc9, 0x0f, 0xc7, 0xbd,
+		0x86, 0xd9, 0x1a, 0xd4, 0xeb, 0x15, 0xa3, 0x73, 0x2b, 0x33, 0x43, 0xcf, 0x8e, 0xc5, 0x4d, 0x4f,
+		0xb4, 0x78, 0xff, 0xde, 0x7a, 0xa8, 0xdf, 0xd7, 0x84, 0x78, 0x34, 0x67, 0x93, 0xde, 0x14, 0x8d,
+		0xbc, 0x11, 0xc5, 0x9f, 0x86, 0xe1, 0x3d, 0xb2, 0x7e, 0xa8, 0x57, 0x93, 0xa8, 0x0d, 0xbd, 0xc7,
+		0x52, 0xa1, 0xa9, 0xb3, 0x92, 0x45, 0x82, 0x52, 0xd7, 0xb9, 0xb4, 0xb5, 0xce, 0x7b, 0x74, 0x9d,
+		0xa7, 0x43, 0x51, 0x2b, 0x63, 0xde, 0xea, 0x50, 0xd4, 0x22, 0x48, 0xb7, 0xef, 0xa1, 0xa8, 0x32,
+		0x51, 0x5a, 0x1b, 0x80, 0x78, 0x3f, 0xe3, 0xb3, 0xc2, 0x87, 0x3d, 0xda, 0xa4, 0x3e, 0x56, 0x63,
+		0xb2, 0x3e, 0x89, 0x92, 0x97, 0xe1, 0xff, 0x32, 0x91, 0x58, 0xec, 0x8e, 0x70, 0xfb, 0x7a, 0x73,
+		0x28, 0x56, 0xfc, 0xf7, 0xbc, 0x58, 0xac, 0x09, 0xa2, 0x68, 0x84, 0x1d, 0x88, 0x8e, 0x19, 0x03,
+		0xa1, 0xa1, 0xd2, 0x1b, 0xa2, 0xab, 0x55, 0x76, 0x6c, 0x27, 0x91, 0x59, 0xec, 0xae, 0x7a, 0x91,
+		0x59, 0xec, 0x6e, 0x7b, 0x91, 0x59, 0x9c, 0xfb, 0xe4, 0x6a, 0x5d, 0xcc, 0xdc, 0xca, 0x48, 0xcc,
+		0x8b, 0x4a, 0x1e, 0xeb, 0x85, 0x09, 0xf2, 0xc0, 0x1d, 0x02, 0xfe, 0xc6, 0x7d, 0x04, 0xb3, 0xea,
+		0xac, 0x0b, 0x11, 0x77, 0x6f, 0x66, 0x52, 0xf8, 0x1b, 0x66, 0x33, 0x77, 0x56, 0x65, 0x66, 0x17,
+		0x40, 0x3b, 0x5d, 0x24, 0xa4, 0x63, 0x55, 0x4a, 0xcc, 0x1b, 0x3c, 0xcd, 0x2c, 0xba, 0x5e, 0x62,
+		0xc2, 0x6a, 0x39, 0x8f, 0xb6, 0x24, 0x3d, 0x62, 0x95, 0xdf, 0xe0, 0x29, 0x10, 0x0a, 0x33, 0x41,
+		0x9a, 0x8b, 0x50, 0x5e, 0x63, 0xd9, 0x76, 0xaf, 0xb4, 0x0c, 0xa9, 0xc8, 0x92, 0x9a, 0x4c, 0xa9,
+		0xca, 0x56, 0x61, 0x19, 0x2b, 0x2c, 0x6b, 0xca, 0x32, 0x97, 0x2d, 0x7b, 0x39, 0x32, 0x28, 0x6f,
+		0x47, 0x97, 0xb0, 0xa7, 0x55, 0xec, 0x6a, 0x55, 0xfb, 0x3a, 0x79, 0xc9, 0x4d, 0x23, 0xa8, 0xda,
+		0xdb, 0xab, 0xc6, 0xe8, 0xa1, 0x5a, 0xbd, 0xa2, 0x16, 0x68, 0x71, 0x4b, 0x54, 0x72, 0xc6, 0x0b,
+		0xdb, 0xe5, 0x2b, 0x43, 0xd2, 0x6e, 0x3c, 0x9f, 0x31, 0x39, 0xd8, 0x4e, 0xa9, 0xc1, 0x2e, 0x98,
+		0xaa, 0xc2, 0x56, 0x7c, 0x79, 0x6b, 0x7e, 0xf6, 0x5c, 0x05, 0x77, 0x31, 0x19, 0xb2, 0x66, 0xdc,
+		0xc6, 0x62, 0x22, 0xa9, 0xbc, 0xa2, 0xe2, 0xea, 0x4a, 0x2b, 0xac, 0xb7, 0xa0, 0xad, 0x64, 0xb4,
+		0x9f, 0x56, 0x58, 0xcf, 0x55, 0x61, 0x49, 0x38, 0x3b, 0x4a, 0x28, 0xb7, 0xdc, 0x28, 0x17, 0x00,
+		0xad, 0xd3, 0xf6, 0x58, 0xa7, 0xb5, 0x1a, 0x2f, 0x50, 0xa9, 0x15, 0x05, 0x7f, 0xa5, 0x4d, 0x4f,
+		0x4c, 0xf9, 0xc8, 0xa0, 0x74, 0x36, 0xff, 0x93, 0x96, 0xfd, 0x6c, 0x1e, 0x28, 0x2d, 0x12, 0xca,
+		0x7c, 0x90, 0x1c, 0x2f, 0x94, 0xf7, 0xd4, 0x39, 0x3c, 0x51, 0x16, 0x5f, 0x14, 0x11, 0x36, 0x47,
+		0xf1, 0x5b, 0x1e, 0x1b, 0x00, 0x6b, 0x39, 0xa4, 0xcf, 0x61, 0xe5, 0xcb, 0xf8, 0xed, 0x6c, 0xd6,
+		0x86, 0x8e, 0x87, 0x07, 0xd0, 0x9c, 0x85, 0x8a, 0x20, 0xeb, 0x40, 0xd1, 0xad, 0xa2, 0xbf, 0x8e,
+		0x87, 0x2f, 0xa3, 0xb5, 0x74, 0x3c, 0xbc, 0x86, 0x39, 0x0d, 0x73, 0x1a, 0xe6, 0x00, 0x74, 0x3c,
+		0xbc, 0x22, 0xc8, 0x45, 0xfe, 0xbd, 0x78, 0xe1, 0xe4, 0xc5, 0xc4, 0xcf, 0xcb, 0xca, 0x01, 0x9c,
+		0x1d, 0x02, 0x51, 0xe0, 0x87, 0x06, 0x6f, 0x12, 0x30, 0x33, 0x6f, 0x05, 0x3c, 0xd7, 0xe7, 0x55,
+		0x70, 0x3f, 0xad, 0x75, 0x32, 0x56, 0x1c, 0xe3, 0xd6, 0x39, 0x21, 0x77, 0xe5, 0x7e, 0xca, 0x49,
+		0x1f, 0xb1, 0x32, 0x9e, 0xd9, 0x69, 0x24, 0xb2, 0xe2, 0xaa, 0x52, 0xc2, 0x71, 0x7e, 0x71, 0xdb,
+		0x06, 0xc9, 0x96, 0xaa, 0x40, 0xdf, 0x65, 0x0a, 0x91, 0xaa, 0x30, 0x15, 0x16, 0xaa, 0xc2, 0xc2,
+		0xa5, 0x2c, 0x64, 0x92, 0xa8, 0xb5, 0x75, 0x4a, 0x4e, 0xa7, 0x7c, 0xa8, 0x42, 0xca, 0x87, 0x42,
+		0x2e, 0x00, 0x81, 0xf7, 0x26, 0x0d, 0x26, 0x57, 0xd8, 0x97, 0x07, 0x93, 0x74, 0xa5, 0x42, 0x80,
+		0x92, 0x52, 0x36, 0x20, 0xd7, 0x8e, 0x86, 0x93, 0x97, 0x03, 0x27, 0xf2, 0xe2, 0x95, 0x16, 0x8d,
+		0x13, 0x89, 0xa2, 0x72, 0x87, 0xbc, 0x92, 0xd7, 0x63, 0x91, 0xfc, 0x0d, 0x4d, 0xf2, 0x2f, 0x0f,
+		0xc9, 0x49, 0xa7, 0x73, 0xdc, 0xd1, 0x34, 0xbf, 0x2c, 0xc0, 0x3f, 0x0d, 0xe1, 0x9d, 0xbb, 0xf9,
+		0x00, 0x09, 0xc6, 0xfb, 0xe3, 0xbc, 0x91, 0x32, 0x7b, 0x26, 0xd7, 0xc1, 0x63, 0xa9, 0x93, 0x05,
+		0xb3, 0x92, 0x92, 0x64, 0x37, 0xa1, 0x37, 0xc0, 0x5d, 0x88, 0x1e, 0x19, 0xc2, 0xda, 0x55, 0xe0,
+		0x80, 0x5e, 0xf6, 0xfe, 0x68, 0xbb, 0xc7, 0xe9, 0xc6, 0x18, 0x0d, 0x7d, 0x3c, 0x94, 0x21, 0x8a,
+		0x5e, 0x67, 0xc7, 0x84, 0x47, 0x2b, 0x85, 0x4d, 0xba, 0x91, 0xb8, 0x98, 0xa1, 0xb8, 0x2c, 0xff,
+		0x2c, 0x84, 0xaf, 0xcc, 0xb9, 0x51, 0xb1, 0x43, 0xcf, 0x3f, 0x35, 0x9a, 0xb1, 0x8f, 0x5f, 0x89,
+		0x28, 0x16, 0x85, 0xa3, 0xb0, 0xc2, 0xb5, 0x21, 0xc8, 0x2b, 0xa3, 0xa0, 0x05, 0xfc, 0xc9, 0x09,
+		0x00, 0x1b, 0x8d, 0xb0, 0xb2, 0xdd, 0x9e, 0xae, 0xa4, 0x66, 0xb7, 0xfb, 0x78, 0x88, 0x7d, 0x4c,
+		0xed, 0x90, 0x14, 0x17, 0x94, 0x51, 0xaa, 0xa9, 0x84, 0x45, 0x4a, 0xa4, 0x48, 0x7c, 0x96, 0x80,
+		0x4b, 0x49, 0xd8, 0x94, 0x96, 0x2e, 0x15, 0x29, 0x53, 0x93, 0x36, 0x55, 0xa9, 0x2b, 0x2c, 0x7d,
+		0x85, 0xa5, 0x50, 0x59, 0x1a, 0xb3, 0xa5, 0x32, 0x47, 0x3a, 0xe5, 0x21, 0xb6, 0x00, 0xd4, 0xaa,
+		0x40, 0xae, 0x14, 0xf4, 0x0a, 0xb1, 0x14, 0xef, 0x69, 0xe1, 0x2f, 0xf5, 0xd4, 0x61, 0xf0, 0xbe,
+		0x19, 0x50, 0xc6, 0xd1, 0xd5, 0x58, 0xf2, 0xf9, 0x27, 0x01, 0xe3, 0xbb, 0xd8, 0x77, 0xcc, 0xe4,
+		0x17, 0x73, 0xd3, 0x76, 0x29, 0x47, 0x84, 0xb2, 0xda, 0x51, 0x7a, 0x08, 0x7a, 0xff, 0x5a, 0xd2,
+		0x3d, 0xff, 0xfc, 0x1f, 0x7e, 0x4d, 0xd8, 0x91, 0x65, 0x89, 0x7f, 0xc9, 0x2f, 0x07, 0x47, 0xe1,
+		0x30, 0xfd, 0x2b, 0x35, 0x48, 0x87, 0x60, 0xc9, 0x1e, 0x60, 0x5d, 0x83, 0x18, 0x8a, 0x76, 0x7f,
+		0x5a, 0x6d, 0x2d, 0x60, 0x8b, 0x18, 0x38, 0xb8, 0xc2, 0x30, 0xf4, 0xdd, 0x49, 0x04, 0x3c, 0xae,
+		0xef, 0x63, 0xe6, 0xb9, 0xd4, 0x21, 0x74, 0x04, 0xe9, 0x67, 0x33, 0x14, 0xcd, 0x7d, 0xc5, 0xd5,
+		0xbc, 0x69, 0x65, 0xe7, 0x07, 0x50, 0xec, 0x64, 0xa9, 0x6f, 0x5c, 0xf6, 0xa5, 0x86, 0x50, 0xe9,
+		0xab, 0x1f, 0x76, 0xb2, 0x6f, 0xfc, 0xb7, 0xef, 0xbb, 0xfe, 0x47, 0xcc, 0x18, 0x1a, 0xe1, 0x1d,
+		0x08, 0x92, 0xe7, 0x63, 0x86, 0x69, 0xa8, 0xa8, 0x36, 0x8f, 0xc3, 0x51, 0x86, 0xf9, 0xb4, 0x6b,
+		0x79, 0xc2, 0xe2, 0xf9, 0xcd, 0x49, 0x3c, 0x00, 0x95, 0x96, 0x28, 0x85, 0xb1, 0xdc, 0x95, 0x60,
+		0x3d, 0xf5, 0xce, 0x3b, 0xc3, 0x46, 0xb5, 0xaf, 0x11, 0xa5, 0x78, 0xac, 0x6e, 0xa5, 0x2d, 0xd6,
+		0x2b, 0x6b, 0xa8, 0x2d, 0xb4, 0x96, 0x67, 0xab, 0x59, 0x7d, 0xfa, 0x65, 0x16, 0xb1, 0x6d, 0x23,
+		0xc1, 0x14, 0x00, 0xfe, 0x66, 0x63, 0xec, 0xc4, 0x8e, 0xc2, 0x6f, 0x66, 0xdc, 0x20, 0x4b, 0x9a,
+		0x5a, 0x9c, 0xf9, 0xd5, 0x86, 0x4d, 0x6d, 0x04, 0x6a, 0x23, 0x10, 0x54, 0xd2, 0x75, 0x2d, 0x0b,
+		0x45, 0x47, 0x33, 0xbb, 0xbb, 0xa7, 0x30, 0x1f, 0x8b, 0xd9, 0x6d, 0x9e, 0x68, 0x5a, 0xf7, 0xd9,
+		0x6f, 0x37, 0x22, 0x65, 0x52, 0xb3, 0xea, 0xd0, 0x0f, 0x1a, 0x8d, 0x63, 0xfb, 0x9f, 0xb1, 0x17,
+		0xaf, 0xf8, 0xd6, 0xa3, 0x97, 0xde, 0x7a, 0xcc, 0xcb, 0xa5, 0x7f, 0x3b, 0x38, 0x4a, 0xa9, 0x9f,
+		0xa7, 0xda, 0x9a, 0x2c, 0x6a, 0xd3, 0xc4, 0x10, 0xc2, 0x7f, 0x05, 0x68, 0x0c, 0xae, 0x0f, 0x63,
+		0xcc, 0x44, 0xaa, 0x49, 0x44, 0xd3, 0xaa, 0xb2, 0xba, 0xb6, 0x66, 0x35, 0xf7, 0x2e, 0xbb, 0x1a,
+		0xe3, 0x3d, 0xdb, 0xdc, 0x2c, 0x0e, 0x53, 0x64, 0xa5, 0xb1, 0x85, 0x31, 0x71, 0x87, 0x7a, 0x8f,
+		0x53, 0x42, 0xf2, 0x0a, 0x0e, 0xe9, 0x0b, 0xdc, 0xea, 0x38, 0x0a, 0x00, 0xab, 0x70, 0xf2, 0x64,
+		0x2b, 0x27, 0x50, 0x96, 0xad, 0x49, 0x7d, 0xa0, 0xb4, 0xfc, 0x32, 0xaa, 0x7c, 0x06, 0x04, 0x7d,
+		0x48, 0xb4, 0x9a, 0x16, 0xf5, 0xa3, 0xed, 0x32, 0xa4, 0x4f, 0xcc, 0x54, 0x61, 0x54, 0xaa, 0x8c,
+		0xec, 0xe2, 0x9a, 0x42, 0xdb, 0xa5, 0x14, 0xdb, 0xdc, 0xf4, 0x5c, 0x92, 0x11, 0x94, 0xbe, 0x0a,
+		0xf0, 0xab, 0x75, 0xd5, 0x70, 0xfe, 0x53, 0x9a, 0xcc, 0x0a, 0xaf, 0x1a, 0x8f, 0x5b, 0x03, 0xa9,
+		0xd6, 0x34, 0xa7, 0xf4, 0x72, 0x38, 0xa5, 0x5d, 0x3b, 0x16, 0x85, 0x2c, 0x77, 0x97, 0xef, 0xef,
+		0xec, 0x2e, 0xdd, 0x61, 0x5a, 0xea, 0x99, 0xa5, 0x4e, 0x54, 0xa7, 0x15, 0x86, 0xdc, 0xc9, 0xea,
+		0x34, 0x9e, 0x16, 0x3e, 0x61, 0xad, 0x76, 0xd2, 0xba, 0x24, 0xe2, 0xc8, 0x9c, 0xf1, 0x5b, 0xc5,
+		0x9a, 0xfc, 0xb3, 0x7e, 0x9b, 0x50, 0xa6, 0xe8, 0x99, 0x3f, 0x00, 0x6d, 0x58, 0x02, 0x68, 0xc3,
+		0x12, 0x40, 0x1b, 0x96, 0x00, 0x2f, 0xc4, 0xb0, 0x7c, 0xa3, 0x93, 0x8f, 0x6c, 0x03, 0xe4, 0x99,
+		0x87, 0xb1, 0x23, 0x8f, 0xee, 0x51, 0x71, 0x35, 0x58, 0x4f, 0x4e, 0x3a, 0x62, 0x27, 0x72, 0x4a,
+		0x4a, 0xb5, 0xa1, 0x4d, 0xc6, 0xe7, 0x6a, 0x32, 0xee, 0x36, 0xe9, 0x54, 0x94, 0x4c, 0x91, 0x4f,
+		0x15, 0x4d, 0x4c, 0x19, 0x93, 0xea, 0x3c, 0x6e, 0xfa, 0x47, 0xc4, 0x14, 0xa6, 0x53, 0x65, 0x65,
+		0x2c, 0x82, 0x18, 0x93, 0x56, 0x33, 0xa0, 0xa4, 0x6a, 0x56, 0xbb, 0x65, 0x36, 0x1b, 0x8d, 0x91,
+		0xb1, 0x0b, 0x0c, 0x2f, 0xd9, 0xab, 0x2a, 0x76, 0xaa, 0x82, 0x7d, 0x6a, 0x99, 0x9d, 0x2a, 0xf6,
+		0xaa, 0x8a, 0x9d, 0x6a, 0x57, 0x52, 0xd2, 0xdb, 0x55, 0xec, 0x54, 0x15, 0xa7, 0x0f, 0x05, 0xdc,
+		0xa5, 0x58, 0xa5, 0x63, 0x52, 0x25, 0x07, 0x3b, 0xcb, 0x2f, 0x91, 0x69, 0x5b, 0x71, 0xc4, 0x15,
+		0x76, 0xce, 0x51, 0x71, 0x35, 0xdb, 0xea, 0x37, 0x0f, 0xc2, 0x6a, 0xea, 0xf9, 0xa7, 0x9b, 0xb2,
+		0x96, 0x55, 0x4b, 0x5b, 0x56, 0x15, 0xb1, 0xac, 0xf2, 0xce, 0xa2, 0x24, 0x2f, 0x03, 0x39, 0x13,
+		0x42, 0x4d, 0x21, 0x18, 0x01, 0x53, 0xb7, 0x64, 0x16, 0x6a, 0x4b, 0x0e, 0xc4, 0x9a, 0xd3, 0xe5,
+		0x0e, 0x66, 0x44, 0xd8, 0xfc, 0x91, 0x7c, 0xc6, 0x31, 0x8a, 0x84, 0x72, 0xec, 0x0f, 0x91, 0x8d,
+		0x2d, 0x80, 0x73, 0x0a, 0x9f, 0x7e, 0x7a, 0x07, 0xaf, 0x5b, 0xad, 0x63, 0x10, 0x61, 0x26, 0x20,
+		0xa8, 0xc3, 0x3e, 0xbd, 0x46, 0xd1, 0x1d, 0xa1, 0x0c, 0x4d, 0x30, 0xf8, 0x18, 0x39, 0xc0, 0xf0,
+		0x04, 0x51, 0x4e, 0x6c, 0x06, 0x88, 0x01, 0x19, 0x9e, 0x89, 0xfe, 0x7d, 0x0e, 0xbb, 0x67, 0x01,
+		0xfc, 0x8c, 0x7d, 0x7c, 0x08, 0x84, 0xf7, 0xa9, 0x8f, 0x87, 0x63, 0x6c, 0xf3, 0xa8, 0x76, 0xf8,
+		0x10, 0x84, 0x71, 0x3f, 0xdc, 0xb2, 0x25, 0x8b, 0x84, 0x01, 0xc3, 0x1c, 0xae, 0xa6, 0x80, 0x29,
+		0xba, 0x1a, 0x0b, 0xaf, 0xb1, 0xeb, 0xf7, 0xa9, 0x43, 0x58, 0xfc, 0xd3, 0x62, 0x17, 0x65, 0x1f,
+		0x5e, 0x6e, 0xaf, 0xb2, 0xba, 0xb2, 0x64, 0x2b, 0x28, 0xac, 0xb0, 0x95, 0xc9, 0x74, 0x6d, 0x93,
+		0x0c, 0x55, 0x42, 0x74, 0x0a, 0x06, 0x16, 0x14, 0x5e, 0x7c, 0xa5, 0x17, 0xe1, 0xda, 0xc5, 0x18,
+		0x3d, 0xf6, 0xb6, 0x77, 0xe8, 0x92, 0xf3, 0xa5, 0xb0, 0x03, 0x52, 0xdf, 0x09, 0xad, 0xcc, 0x30,
+		0xa6, 0xc1, 0x04, 0x47, 0x69, 0x84, 0x54, 0xe6, 0x39, 0x51, 0x03, 0x6d, 0x95, 0xa0, 0x19, 0x1a,
+		0x4c, 0x44, 0x27, 0xb7, 0x3b, 0x5c, 0x05, 0xe2, 0xea, 0x92, 0xd7, 0x3c, 0xca, 0x5a, 0x69, 0x2f,
+		0x05, 0xca, 0x66, 0xca, 0xc2, 0x88, 0x0b, 0xd4, 0x6a, 0xbd, 0x39, 0x39, 0xee, 0x42, 0x78, 0xdd,
+		0x59, 0x82, 0x17, 0x0c, 0xfe, 0xe3, 0xbb, 0x81, 0x07, 0x1f, 0xcf, 0x7f, 0x04, 0x73, 0x11, 0xa7,
+		0x8a, 0x44, 0xd6, 0x14, 0x5c, 0x88, 0xeb, 0x16, 0xe4, 0x7c, 0x94, 0x0e, 0x8b, 0xb5, 0x55, 0x76,
+		0x6d, 0xae, 0x5d, 0xa3, 0x05, 0x86, 0x51, 0xf9, 0x4b, 0x1f, 0x76, 0x14, 0x03, 0x34, 0xd8, 0x12,
+		0xa5, 0x27, 0xb1, 0x46, 0x0c, 0x32, 0x24, 0xd4, 0xc1, 0xdf, 0xd4, 0x15, 0x79, 0x52, 0xb1, 0x98,
+		0x0e, 0xff, 0x3c, 0x65, 0x1c, 0x4f, 0x00, 0x31, 0x46, 0x46, 0x14, 0x3b, 0x71, 0x60, 0x29, 0x0c,
+		0x5d, 0x1f, 0x30, 0xb2, 0xaf, 0x17, 0x54, 0xf9, 0xbb, 0x59, 0x24, 0x16, 0x03, 0xee, 0xf6, 0x29,
+		0x19, 0x9e, 0x8b, 0x6f, 0x06, 0xf7, 0xea, 0xff, 0x84, 0x93, 0x98, 0x50, 0xf8, 0xfc, 0xeb, 0xc7,
+		0x8b, 0xf9, 0x1c, 0x8b, 0xc9, 0xd5, 0xda, 0x15, 0x40, 0x6b, 0x57, 0x75, 0x75, 0x51, 0x5c, 0x53,
+		0x8a, 0xe3, 0x0e, 0xc7, 0xad, 0x02, 0x4a, 0xf2, 0xb5, 0x42, 0x15, 0xb5, 0xf3, 0x0f, 0xc9, 0xab,
+		0x80, 0x36, 0x2a, 0xe2, 0x50, 0x5a, 0x24, 0x20, 0xd5, 0x7d, 0x20, 0x5b, 0x73, 0xa6, 0x94, 0x77,
+		0xaa, 0x28, 0x8a, 0x4d, 0x69, 0xc7, 0xd3, 0xca, 0xd0, 0xb5, 0x5b, 0xa7, 0xed, 0xd3, 0x93, 0xd7,
+		0xad, 0xd3, 0xce, 0xf3, 0x1f, 0xc3, 0xa7, 0xd5, 0x92, 0xfb, 0x6e, 0x27, 0x82, 0xb9, 0xd1, 0xc2,
+		0xd1, 0x86, 0x21, 0x00, 0x6c, 0x32, 0x0c, 0xb3, 0xc6, 0xed, 0x45, 0x5a, 0x82, 0x63, 0xc4, 0x78,
+		0x18, 0x00, 0x3f, 0x2a, 0xe0, 0xa0, 0x4a, 0x57, 0x2e, 0xca, 0xea, 0x10, 0x06, 0x9c, 0x4c, 0x30,
+		0xe3, 0x68, 0xe2, 0x01, 0xa1, 0x0e, 0xb1, 0x11, 0xc7, 0x11, 0xe1, 0x22, 0x7e, 0x9f, 0xb0, 0x3c,
+		0xe2, 0xab, 0x62, 0xca, 0x25, 0xfa, 0xc2, 0x3e, 0x5d, 0xe6, 0x7f, 0xa0, 0x86, 0xad, 0x91, 0x75,
+		0x08, 0x81, 0x67, 0x72, 0xd7, 0x74, 0xdc, 0x3b, 0x0a, 0xdc, 0x47, 0x94, 0x11, 0xf1, 0x65, 0x75,
+		0x0b, 0xc4, 0x77, 0xf5, 0xa9, 0xbd, 0x60, 0x57, 0x46, 0x2d, 0x0c, 0x3f, 0x20, 0xc6, 0xdf, 0x85,
+		0xcd, 0xa6, 0xec, 0x4b, 0xf1, 0x27, 0xc6, 0xc5, 0xc6, 0xdb, 0x77, 0xfa, 0x94, 0xa4, 0x0d, 0x4d,
+		0xab, 0x4f, 0xd3, 0x87, 0x69, 0xc9, 0xbc, 0xbf, 0xc9, 0x73, 0x00, 0x45, 0xd4, 0x65, 0xd8, 0x0e,
+		0xbf, 0xc7, 0xc7, 0xe3, 0x10, 0x4d, 0x43, 0x43, 0x56, 0x94, 0xfc, 0x9d, 0x92, 0x6f, 0xf0, 0x6f,
+		0xcf, 0xb5, 0xaf, 0xa1, 0xf6, 0xbf, 0x88, 0x42, 0xf3, 0x10, 0x9a, 0xa7, 0xaf, 0x1b, 0xd0, 0x68,
+		0x74, 0xc3, 0x7f, 0xf0, 0xfb, 0x97, 0x77, 0x75, 0x4d, 0x16, 0x01, 0x68, 0x73, 0xf6, 0x51, 0xcd,
+		0x59, 0x21, 0xc1, 0x9c, 0xd8, 0x37, 0xec, 0xa4, 0x5d, 0xc0, 0xa6, 0x7d, 0xa3, 0x6d, 0xda, 0xea,
+		0xda, 0x63, 0x4f, 0x65, 0xd3, 0x16, 0xbf, 0x46, 0xbc, 0xca, 0xa3, 0xa9, 0xad, 0xdb, 0x6d, 0xae,
+		0x51, 0x45, 0x16, 0x74, 0xae, 0xad, 0xb5, 0xad, 0x0b, 0x00, 0x05, 0x49, 0xd0, 0xd4, 0x28, 0xbe,
+		0x48, 0xcb, 0xd7, 0xf5, 0xb0, 0x5f, 0xd8, 0xa1, 0x99, 0xae, 0x5c, 0xdc, 0x9f, 0x69, 0x07, 0xbe,
+		0x8f, 0x29, 0x07, 0xd7, 0x8b, 0xbd, 0x2d, 0x68, 0xbc, 0xc9, 0xb7, 0x19, 0x19, 0x9c, 0xb1, 0x47,
+		0x13, 0x16, 0x1c, 0x9a, 0x4b, 0xbe, 0xcc, 0xdf, 0x3c, 0xec, 0xc7, 0xae, 0x4c, 0x6d, 0x40, 0x02,
+		0x68, 0x03, 0x12, 0x40, 0x7b, 0x1b, 0x01, 0x40, 0x7b, 0x1b, 0x55, 0xf5, 0xec, 0x1c, 0x49, 0xb4,
+		0x9e, 0x5d, 0xb3, 0x44, 0xd5, 0x47, 0x71, 0xff, 0xf4, 0x6c, 0xa9, 0xe0, 0x23, 0xc9, 0x64, 0xe5,
+		0xf2, 0x49, 0xcb, 0xa3, 0x74, 0xa0, 0x12, 0x31, 0x6f, 0x20, 0x91, 0xbc, 0xfc, 0xc2, 0xf5, 0xf9,
+		0xe5, 0xe7, 0xb0, 0xb1, 0x5d, 0x5c, 0xcf, 0x3d, 0x46, 0x54, 0xe1, 0x8a, 0x9f, 0xa8, 0xb8, 0x5a,
+		0x20, 0x5f, 0x40, 0x39, 0x1a, 0x8d, 0xb0, 0x03, 0x88, 0x3a, 0x10, 0x7f, 0x0c, 0xdb, 0x01, 0x0f,
+		0xfb, 0x20, 0x91, 0x21, 0x44, 0x39, 0xae, 0x4f, 0x9f, 0x98, 0x78, 0x76, 0x71, 0x7d, 0x91, 0x5c,
+		0xa8, 0x1b, 0xc0, 0x71, 0xbd, 0x62, 0xb6, 0xef, 0x24, 0x18, 0x73, 0xe2, 0x8d, 0xf1, 0x82, 0x54,
+		0xee, 0x83, 0xb1, 0x2a, 0x25, 0xac, 0x45, 0x85, 0xb6, 0xb4, 0xf0, 0x96, 0x16, 0xe2, 0xc2, 0xc2,
+		0x2c, 0xaf, 0x51, 0xe0, 0x51, 0x78, 0x4e, 0xf9, 0x13, 0xe5, 0x2b, 0x58, 0xa8, 0xe2, 0xb8, 0x4f,
+		0x4e, 0x98, 0x47, 0x49, 0xd1, 0x2c, 0xeb, 0x88, 0xdd, 0x75, 0x85, 0xac, 0x27, 0xef, 0xb9, 0xe7,
+		0xca, 0x55, 0x47, 0x44, 0xe9, 0x9c, 0x79, 0x9a, 0x22, 0x55, 0x3b, 0x6f, 0xbe, 0xd5, 0x73, 0xe7,
+		0xc5, 0xce, 0x9f, 0xcb, 0x4b, 0x94, 0xc4, 0xd8, 0xcd, 0x54, 0xa5, 0x3a, 0x0e, 0xce, 0x6a, 0x16,
+		0x43, 0x42, 0x21, 0x05, 0x61, 0xfc, 0x53, 0xd2, 0x0e, 0x78, 0xc8, 0xbe, 0xc1, 0x5c, 0x81, 0x55,
+		0x18, 0xa2, 0x60, 0xcc, 0x95, 0x76, 0x0f, 0x46, 0x53, 0x4e, 0xea, 0x06, 0x1a, 0x91, 0x35, 0x22,
+		0x6b, 0x44, 0x2e, 0x8a, 0x3b, 0x15, 0xdc, 0x1b, 0xc9, 0x1a, 0x5a, 0x52, 0x7b, 0xa3, 0x3f, 0xc2,
+		0xc6, 0x1e, 0xe5, 0x5a, 0xab, 0x5f, 0xf0, 0x74, 0xe9, 0x8e, 0x13, 0x90, 0xca, 0xa4, 0x2d, 0xa7,
+		0x10, 0xe5, 0x15, 0x60, 0x29, 0x85, 0x27, 0xa7, 0xe0, 0x76, 0x7e, 0xb3, 0x57, 0xce, 0xc6, 0x4f,
+		0x66, 0xea, 0xcb, 0x5c, 0x71, 0xe4, 0xbb, 0x63, 0x89, 0xeb, 0xdd, 0xc3, 0x52, 0x72, 0x57, 0x1c,
+		0x09, 0xf2, 0x45, 0x14, 0x4f, 0x38, 0xf3, 0xe8, 0x31, 0x93, 0x00, 0x8e, 0x21, 0xba, 0xf2, 0x89,
+		0xad, 0x6f, 0xf5, 0xda, 0xaf, 0x5b, 0xbd, 0xe4, 0x28, 0x63, 0x19, 0x8a, 0x38, 0x87, 0x12, 0x96,
+		0x93, 0xe9, 0xec, 0x53, 0x99, 0x52, 0xa7, 0x31, 0x8b, 0x9f, 0xc2, 0x94, 0xbe, 0xb9, 0xab, 0xa5,
+		0x85, 0xb8, 0xa4, 0x10, 0xe7, 0xdf, 0xdc, 0x15, 0xa5, 0xb0, 0x53, 0x49, 0x7f, 0x32, 0xaf, 0xa2,
+		0xc6, 0xee, 0x45, 0xce, 0xc7, 0xf4, 0x9d, 0x0f, 0x5d, 0x08, 0xbc, 0x7b, 0x11, 0xf6, 0xa6, 0x13,
+		0xa1, 0xe8, 0xdc, 0x79, 0x25, 0x72, 0x5a, 0xed, 0x80, 0xed, 0x8e, 0x02, 0x45, 0xd5, 0x17, 0xc7,
+		0x52, 0x3d, 0xb5, 0x15, 0x22, 0x02, 0x1c, 0xe0, 0x86, 0x86, 0x71, 0xa0, 0x64, 0x82, 0xd3, 0xc6,
+		0xc1, 0x1d, 0x62, 0x20, 0xdd, 0xac, 0x5e, 0x2e, 0x2f, 0x67, 0xb9, 0x38, 0x88, 0x63, 0x13, 0x51,
+		0xc7, 0x14, 0x12, 0xf3, 0xe4, 0xd7, 0xdc, 0xf7, 0xfb, 0xce, 0xf7, 0xf6, 0x83, 0x29, 0xde, 0x5a,
+		0xc9, 0xdb, 0x97, 0xe8, 0xad, 0xbb, 0xf0, 0x56, 0xeb, 0xf7, 0xad, 0x7e, 0xdf, 0xf9, 0xa1, 0xfe,
+		0xb6, 0xf6, 0xe7, 0x7d, 0xaf, 0xdf, 0xff, 0xa1, 0xdf, 0x37, 0x07, 0x0b, 0x25, 0xea, 0xc6, 0xbe,
+		0xdd, 0x50, 0x9c, 0xe7, 0xe7, 0xcb, 0xdb, 0xc8, 0x64, 0xb8, 0xf6, 0x24, 0xad, 0xbe, 0xb0, 0x1d,
+		0xa9, 0x8b, 0x89, 0xe7, 0x45, 0xe5, 0xac, 0xbf, 0x80, 0x92, 0xbf, 0x02, 0x0c, 0x51, 0x66, 0xaa,
+		0x21, 0x89, 0xcf, 0x48, 0xce, 0x01, 0x4c, 0xef, 0x67, 0x9e, 0xda, 0x14, 0x94, 0xdf, 0xab, 0x38,
+		0xee, 0x04, 0x11, 0x9a, 0x97, 0xbc, 0x54, 0x06, 0x48, 0x64, 0x53, 0x49, 0xca, 0xa5, 0x98, 0x95,
+		0x57, 0x13, 0x8a, 0x29, 0x23, 0x0b, 0xc7, 0xe9, 0xaa, 0xc7, 0xe5, 0x3e, 0xc8, 0xe5, 0xc6, 0x55,
+		0x7f, 0xd4, 0x56, 0xe7, 0xb8, 0x7a, 0x0f, 0x5b, 0x10, 0x9f, 0x07, 0x87, 0x07, 0xa5, 0x55, 0x92,
+		0x51, 0xab, 0xd5, 0x7a, 0xc8, 0xfc, 0xfb, 0xcc, 0xfc, 0xb3, 0x61, 0x9e, 0x5e, 0x0e, 0x52, 0x3f,
+		0xf4, 0xfb, 0xe6, 0xe5, 0xa0, 0xfe, 0xbd, 0x71, 0x78, 0xd2, 0x7c, 0xa8, 0xbf, 0x9d, 0xff, 0x7e,
+		0xd0, 0xef, 0x5b, 0xf5, 0x57, 0x45, 0x6a, 0xbd, 0xad, 0xdf, 0xf7, 0xfb, 0x96, 0x71, 0xa0, 0xa6,
+		0xa8, 0x94, 0x40, 0xdb, 0x43, 0x19, 0x5b, 0xb9, 0x65, 0xd8, 0x0e, 0x0b, 0xcb, 0xde, 0xb7, 0x1d,
+		0x69, 0x17, 0x10, 0x75, 0x08, 0x1d, 0x59, 0x70, 0x16, 0x43, 0x36, 0xd8, 0x88, 0x82, 0x87, 0x7c,
+		0x4e, 0x6c, 0xe2, 0x85, 0x21, 0x9e, 0x14, 0x1a, 0x66, 0x33, 0x29, 0xc8, 0xf4, 0xbd, 0xdc, 0x95,
+		0xdf, 0xdd, 0x8b, 0xa9, 0xc2, 0x4e, 0x6c, 0x86, 0xc8, 0x6f, 0x62, 0x16, 0xab, 0xa9, 0xed, 0x61,
+		0x2e, 0xc2, 0xba, 0x33, 0x4e, 0x73, 0x66, 0x0f, 0x08, 0xb9, 0x1a, 0x05, 0xc8, 0x77, 0xc0, 0x47,
+		0x64, 0x0c, 0x77, 0x64, 0x3c, 0x06, 0x1f, 0xff, 0x15, 0x10, 0x5f, 0xec, 0x73, 0x10, 0x17, 0xc7,
+		0xcc, 0x08, 0x8b, 0xee, 0x4e, 0x01, 0xc2, 0x66, 0xb7, 0x79, 0xde, 0x5d, 0xe3, 0x88, 0x17, 0x8d,
+		0xc5, 0xce, 0x14, 0xc4, 0x01, 0x8c, 0x09, 0xe3, 0x61, 0x4c, 0x31, 0xe2, 0x30, 0xc6, 0x62, 0xdb,
+		0xd4, 0x04, 0x9c, 0xd0, 0xdc, 0x7a, 0x77, 0xa4, 0x77, 0x47, 0x8f, 0x75, 0xc3, 0xf7, 0x5d, 0x77,
+		0x66, 0xe3, 0x77, 0xe7, 0x36, 0xf3, 0x0e, 0x08, 0x89, 0xb4, 0xf8, 0xab, 0xad, 0xe4, 0x59, 0x2d,
+		0xb5, 0x85, 0xfc, 0x25, 0x26, 0xe9, 0xc0, 0x8d, 0xd6, 0xdf, 0xd8, 0xb5, 0x45, 0xb0, 0x7f, 0xf8,
+		0x8c, 0xc0, 0x5d, 0xc0, 0x61, 0xf0, 0x30, 0x61, 0xd7, 0xb3, 0xd5, 0x89, 0x1d, 0x18, 0x13, 0x7a,
+		0xd3, 0xa7, 0xe7, 0x14, 0x86, 0x01, 0x0f, 0xc2, 0x95, 0x8d, 0x7d, 0x0c, 0x13, 0x34, 0x85, 0x2b,
+		0x0c, 0x13, 0x37, 0x5a, 0xeb, 0x14, 0x9a, 0x51, 0xcb, 0xdc, 0x05, 0xcf, 0x77, 0x6f, 0x89, 0x83,
+		0xc1, 0xc7, 0x4e, 0x40, 0x1d, 0x44, 0xed, 0xa9, 0xd5, 0xa7, 0x3f, 0xc5, 0x5b, 0x07, 0x42, 0x09,
+		0x27, 0x68, 0x0c, 0xb7, 0xd8, 0x67, 0x24, 0xec, 0x06, 0x61, 0x20, 0x4e, 0x14, 0x90, 0x09, 0xe1,
+		0xd8, 0x11, 0xf5, 0x9b, 0x3a, 0x22, 0xf0, 0xc5, 0x47, 0x04, 0xa6, 0xef, 0xd2, 0x57, 0x0e, 0x87,
+		0x49, 0x57, 0x2e, 0x16, 0x11, 0x73, 0x91, 0x5c, 0x48, 0x9d, 0x64, 0x07, 0x0a, 0x18, 0x76, 0xc2,
+		0xed, 0x6f, 0x4c, 0xd8, 0x85, 0x49, 0xf5, 0x5c, 0xd0, 0xe1, 0x82, 0x92, 0x2f, 0x1d, 0x9c, 0xa2,
+		0xaa, 0xef, 0x4a, 0xe8, 0xbd, 0x22, 0xfa, 0x6f, 0x45, 0x0f, 0xa6, 0x83, 0x53, 0xa2, 0x90, 0xf6,
+		0xbb, 0x6e, 0x7a, 0x61, 0x3d, 0x62, 0x60, 0x9c, 0xe2, 0x3d, 0xe7, 0xab, 0x78, 0xa0, 0x72, 0xdf,
+		0x79, 0x36, 0x24, 0x44, 0x2d, 0xad, 0xa0, 0x42, 0xbc, 0xdf, 0x3a, 0xd3, 0x78, 0xa0, 0xf1, 0xe0,
+		0x25, 0xe1, 0xc1, 0xe2, 0xc2, 0xaa, 0x44, 0xcc, 0x5a, 0xd1, 0x28, 0x2d, 0xb5, 0x68, 0xad, 0x34,
+		0x87, 0x59, 0xee, 0x9a, 0xac, 0xad, 0xdf, 0x89, 0xf5, 0xd8, 0x31, 0x7d, 0x29, 0x7e, 0xea, 0x48,
+		0x61, 0x6f, 0x02, 0x32, 0xde, 0x91, 0xf0, 0x4d, 0xd0, 0x0f, 0x97, 0x17, 0x51, 0xcb, 0x17, 0xb9,
+		0xf7, 0xdf, 0x56, 0xcf, 0x45, 0x94, 0xcb, 0xdf, 0xa9, 0x0d, 0x45, 0x19, 0x6f, 0x91, 0x08, 0xbd,
+		0xcc, 0x67, 0x1c, 0xc3, 0x52, 0x72, 0x54, 0x23, 0x8a, 0x68, 0x1b, 0x77, 0x08, 0x7f, 0x7c, 0x38,
+		0xfb, 0x55, 0xf3, 0x87, 0xd5, 0xe7, 0x0f, 0xf5, 0x4d, 0xca, 0x00, 0xfa, 0xc2, 0xbb, 0x9d, 0xa9,
+		0x5f, 0x7d, 0xe1, 0x5d, 0xa6, 0x4b, 0x4f, 0x5f, 0x78, 0x07, 0xa0, 0x6f, 0x52, 0xde, 0xd1, 0x4d,
+		0xca, 0xfa, 0x5e, 0x53, 0x0d, 0xf3, 0x1a, 0xe6, 0x35, 0xcc, 0xeb, 0x7b, 0x4d, 0xf7, 0x17, 0xe4,
+		0x59, 0x70, 0x45, 0xb1, 0x82, 0xb7, 0x30, 0x2e, 0xaf, 0x06, 0xec, 0xbf, 0x62, 0x7e, 0xe7, 0xfa,
+		0x37, 0x10, 0x55, 0x66, 0x21, 0xc5, 0x29, 0xf6, 0x77, 0xda, 0x0d, 0xaf, 0xdd, 0xf0, 0xc9, 0x38,
+		0x13, 0xef, 0xb6, 0x6d, 0x4a, 0x8f, 0xc5, 0x6e, 0x43, 0x94, 0x6b, 0xb5, 0x9e, 0x88, 0xda, 0xba,
+		0xef, 0x35, 0xcd, 0xd3, 0x41, 0xf4, 0xb1, 0x19, 0xbe, 0x45, 0x9f, 0x5b, 0xbd, 0x86, 0xd9, 0x4e,
+		0x3e, 0x77, 0x7a, 0x0d, 0xb3, 0x33, 0xa8, 0x8b, 0xb8, 0xb0, 0xef, 0xc7, 0x0f, 0xea, 0x15, 0x8f,
+		0xe2, 0x2f, 0xab, 0xdf, 0xd7, 0x7a, 0x4d, 0xb3, 0x35, 0x48, 0x7e, 0x38, 0xee, 0x35, 0xcc, 0xd6,
+		0xa0, 0x5e, 0x3e, 0xc4, 0x79, 0x87, 0xf4, 0x64, 0x73, 0x8f, 0x6e, 0xf1, 0x4f, 0x0e, 0x4a, 0x2b,
+		0x65, 0x2f, 0xda, 0x1c, 0xfc, 0xbc, 0x09, 0x0a, 0xf9, 0x35, 0x0e, 0xa1, 0x0f, 0xce, 0xdf, 0x6b,
+		0xf4, 0xd3, 0xe8, 0x27, 0x41, 0x91, 0xae, 0x93, 0x89, 0x93, 0xc3, 0x83, 0x2d, 0x27, 0xa3, 0x7e,
+		0x2c, 0x4b, 0xb6, 0xa1, 0x2d, 0xd9, 0xe5, 0x21, 0x69, 0x37, 0x4e, 0x4f, 0xb4, 0x2d, 0x2b, 0x0b,
+		0xe1, 0x45, 0x52, 0x2c, 0x64, 0x43, 0xb5, 0xce, 0xa7, 0xb0, 0xea, 0x64, 0xca, 0xc1, 0xa3, 0x3c,
+		0xef, 0x92, 0xc8, 0xa2, 0x21, 0xed, 0x57, 0x3a, 0xc8, 0x78, 0xb2, 0x64, 0x06, 0x37, 0x9d, 0x35,
+		0xca, 0x9e, 0xbb, 0xfc, 0x39, 0x2b, 0x34, 0x57, 0xd9, 0x73, 0xb4, 0xfc, 0x04, 0x39, 0x73, 0x92,
+		0x3b, 0x17, 0xc6, 0xe1, 0x81, 0xe2, 0xf0, 0x1b, 0x07, 0xeb, 0xc7, 0x37, 0xd5, 0xb3, 0x64, 0x40,
+		0x27, 0xae, 0x83, 0xc7, 0x2b, 0xfd, 0x5a, 0x3e, 0x2b, 0x10, 0x95, 0x3a, 0x3c, 0xc8, 0x32, 0x6c,
+		0xce, 0x20, 0x2c, 0x05, 0xee, 0x70, 0xfd, 0x71, 0xae, 0x0d, 0x3e, 0xbb, 0x8d, 0xe6, 0x4b, 0x96,
+		0xb9, 0x32, 0xef, 0xdf, 0x64, 0xdd, 0xe8, 0xe4, 0x98, 0x23, 0xd2, 0xe6, 0x87, 0xb4, 0xb9, 0xb1,
+		0x68, 0x5e, 0x4c, 0x0c, 0x45, 0x19, 0xdf, 0xe4, 0x63, 0x33, 0x66, 0x69, 0x12, 0xf2, 0x7d, 0xac,
+		0xf3, 0xa2, 0xb2, 0x8e, 0xd6, 0x09, 0xf2, 0xc4, 0x64, 0x85, 0x79, 0x7e, 0x53, 0x09, 0x19, 0xba,
+		0x10, 0x31, 0x6b, 0x66, 0x26, 0xc1, 0xf6, 0xa8, 0x1e, 0xd8, 0x4c, 0x4a, 0xb5, 0xa2, 0x1e, 0xd8,
+		0x2c, 0xca, 0x74, 0xcb, 0x1e, 0xd8, 0xd9, 0xdc, 0x99, 0x37, 0x78, 0x9a, 0x59, 0x74, 0xbd, 0xc4,
+		0x84, 0xd5, 0xd4, 0x77, 0x31, 0x37, 0x78, 0x0a, 0x84, 0xc2, 0x4c, 0x90, 0x94, 0x6f, 0x56, 0xd7,
+		0x34, 0x7d, 0x71, 0x59, 0x53, 0x96, 0xb9, 0x6c, 0xd9, 0xcb, 0x91, 0xc1, 0x12, 0x1b, 0x9b, 0x29,
+		0xa2, 0x23, 0x73, 0x7e, 0xc4, 0x47, 0xf3, 0xf5, 0x55, 0xb3, 0xe7, 0x1f, 0x6f, 0x97, 0xf3, 0xf2,
+		0xf6, 0x38, 0xbb, 0xe0, 0x26, 0xe3, 0x53, 0xa5, 0x97, 0x83, 0xc5, 0x63, 0xa7, 0xd6, 0xe0, 0x95,
+		0x2c, 0xd6, 0x58, 0xf7, 0x96, 0x75, 0xdf, 0xfb, 0xfa, 0xed, 0xbf, 0x03, 0xeb, 0xd5, 0xbd, 0xd5,
+		0xfb, 0x3a, 0xf9, 0x18, 0x7e, 0xb0, 0x7a, 0x5f, 0xc7, 0x1f, 0x06, 0xd6, 0x2b, 0xe3, 0x49, 0xfc,
+		0x10, 0xb7, 0xb1, 0x98, 0xc8, 0x92, 0x6f, 0x61, 0x71, 0x75, 0xa5, 0x15, 0xd6, 0x5b, 0xd0, 0x56,
+		0x32, 0xda, 0x4f, 0x2b, 0xac, 0xe7, 0xaa, 0xb0, 0x14, 0x2e, 0xfd, 0xd0, 0x3e, 0xe8, 0x6c, 0x00,
+		0xd7, 0x3a, 0x6d, 0x65, 0x48, 0x5a, 0x0d, 0xed, 0x84, 0x96, 0xad, 0x5f, 0x88, 0xb8, 0x93, 0x41,
+		0x69, 0x4d, 0xdf, 0xcd, 0x29, 0xa3, 0x88, 0xa9, 0x39, 0x5a, 0xf8, 0x21, 0x8f, 0x19, 0x80, 0x0c,
+		0x42, 0xe9, 0xa3, 0x68, 0xe1, 0x32, 0xfd, 0xf9, 0x6c, 0xd6, 0x5a, 0x89, 0xb0, 0x71, 0x99, 0x98,
+		0x61, 0x85, 0x58, 0xe1, 0x52, 0x31, 0xc2, 0x8f, 0x9a, 0x5e, 0xe8, 0x65, 0x33, 0x19, 0xf2, 0xe9,
+		0x85, 0x72, 0x15, 0xab, 0xce, 0x2c, 0x54, 0xa9, 0xcc, 0x42, 0xf2, 0x31, 0xb7, 0xcf, 0x21, 0xb5,
+		0x50, 0x19, 0x64, 0x93, 0x89, 0x99, 0x55, 0x89, 0x95, 0xdd, 0x56, 0x8c, 0xac, 0x86, 0x39, 0x0d,
+		0x73, 0x1a, 0xe6, 0x4a, 0xc2, 0xdc, 0x9b, 0x46, 0xf5, 0x9e, 0xf5, 0x09, 0x40, 0xce, 0x23, 0x1e,
+		0x1e, 0x13, 0x2a, 0x01, 0x70, 0xb3, 0x92, 0x72, 0xe0, 0x76, 0x11, 0x17, 0x07, 0x3b, 0x44, 0xa3,
+		0x20, 0x4a, 0x86, 0x0e, 0x26, 0x38, 0x01, 0x1a, 0x83, 0xeb, 0xc3, 0x5f, 0x01, 0xaa, 0x84, 0x1d,
+		0xb7, 0xd6, 0xef, 0x58, 0x71, 0x80, 0x5b, 0xe7, 0x97, 0x2c, 0x06, 0x70, 0x7b, 0x9d, 0xf6, 0x3e,
+		0x33, 0x79, 0xd2, 0x5c, 0xb0, 0x37, 0x1f, 0x48, 0x5e, 0x75, 0x8c, 0x8b, 0xc2, 0x91, 0xab, 0x4c,
+		0x2a, 0xd1, 0x69, 0x53, 0x4b, 0xf0, 0xae, 0x25, 0x38, 0x3f, 0xe7, 0xbd, 0x42, 0xbe, 0xa0, 0x02,
+		0x79, 0x82, 0x96, 0x65, 0x64, 0xee, 0x20, 0x4b, 0x72, 0xdf, 0x8b, 0xb6, 0xc0, 0x04, 0xef, 0x7a,
+		0xca, 0x88, 0x48, 0xaa, 0x95, 0xa4, 0xd9, 0x92, 0x4b, 0xb8, 0x57, 0x89, 0xd0, 0xd1, 0xc9, 0x1e,
+		0x86, 0x8e, 0x4e, 0xaa, 0xe7, 0x61, 0x0d, 0x08, 0xe5, 0x6f, 0x14, 0x38, 0xe8, 0x8e, 0x8e, 0x1d,
+		0x95, 0x7c, 0x3d, 0x07, 0x06, 0xba, 0xd3, 0xd1, 0x0c, 0xb4, 0x64, 0xfd, 0xcc, 0xb3, 0xae, 0x3a,
+		0x8b, 0x81, 0x1c, 0xda, 0x68, 0x37, 0xe4, 0xe3, 0xa0, 0xba, 0x76, 0x2d, 0xbe, 0x6c, 0x60, 0xd7,
+		0x59, 0x0c, 0xb6, 0x85, 0xec, 0x3a, 0x8b, 0x81, 0x86, 0x79, 0x0d, 0xf3, 0x1a, 0xe6, 0x75, 0x16,
+		0x83, 0xfd, 0x05, 0xf9, 0x09, 0xfa, 0x66, 0xc6, 0x59, 0x19, 0xe5, 0x31, 0x3e, 0x5d, 0x49, 0x0d,
+		0xe2, 0xcf, 0x80, 0x79, 0x63, 0x22, 0x02, 0x37, 0xc3, 0x0b, 0x30, 0x1c, 0x12, 0xe6, 0x27, 0x4f,
+		0xae, 0x2c, 0x04, 0x42, 0x81, 0xbb, 0x49, 0x96, 0x48, 0x66, 0xf5, 0xa9, 0xc8, 0x93, 0xee, 0x44,
+		0x77, 0x9a, 0xc7, 0x11, 0x88, 0x0d, 0x20, 0xd4, 0x21, 0x36, 0xe2, 0x98, 0xa5, 0xea, 0x31, 0xa0,
+		0xee, 0x2c, 0x29, 0x2c, 0xf9, 0x1b, 0x5d, 0x8d, 0x25, 0x94, 0x8f, 0xfc, 0x55, 0xe9, 0x46, 0xc3,
+		0x38, 0x28, 0x11, 0xaf, 0xaa, 0x59, 0xa5, 0x1d, 0x29, 0x26, 0xcd, 0x2a, 0xad, 0x7b, 0x69, 0x56,
+		0x49, 0x71, 0x96, 0xb7, 0xa7, 0x95, 0x9a, 0xfa, 0x3c, 0xb2, 0x6c, 0xfd, 0xcc, 0xdc, 0x3a, 0x1e,
+		0xc6, 0x0e, 0x53, 0xc8, 0xad, 0x13, 0x95, 0x57, 0xd3, 0x45, 0xa1, 0xe6, 0x08, 0x6b, 0x6a, 0xa7,
+		0x80, 0x86, 0xef, 0x64, 0x9c, 0x23, 0x87, 0x12, 0x9f, 0x2a, 0x5e, 0x6c, 0x23, 0x83, 0xe2, 0xe7,
+		0x71, 0xd3, 0x3f, 0x22, 0x86, 0xd5, 0x73, 0xe7, 0xcb, 0x48, 0xea, 0x22, 0x1e, 0x31, 0x69, 0x8d,
+		0x01, 0x4a, 0x5a, 0x63, 0xb5, 0x5b, 0x66, 0xb3, 0xd1, 0x18, 0x19, 0xbb, 0x80, 0xe3, 0x92, 0xbd,
+		0xaa, 0x62, 0xa7, 0x2a, 0xd8, 0xa7, 0x96, 0xd9, 0xa9, 0x62, 0xaf, 0xaa, 0xd8, 0xa9, 0x76, 0x25,
+		0x25, 0xbd, 0x5d, 0xc5, 0x4e, 0x55, 0x71, 0xfa, 0x50, 0xc0, 0x5d, 0x8a, 0x47, 0xdb, 0xbe, 0x64,
+		0x62, 0xb0, 0x63, 0x3d, 0xf6, 0xa2, 0x72, 0x8d, 0x15, 0x39, 0xef, 0x92, 0x1f, 0x41, 0xa2, 0x4f,
+		0xbb, 0x64, 0x9f, 0x76, 0xc9, 0xb9, 0x29, 0x42, 0xfe, 0xa0, 0xcb, 0xe6, 0x9b, 0x21, 0x94, 0xee,
+		0x64, 0x0d, 0x7b, 0xa5, 0x72, 0x9d, 0xf6, 0xac, 0xc2, 0x1e, 0x5d, 0xaa, 0xfd, 0xa2, 0x63, 0xcd,
+		0xe4, 0x23, 0x21, 0xe5, 0x73, 0x25, 0xe8, 0xb8, 0xf0, 0x8a, 0x1d, 0x7f, 0x69, 0xe8, 0x8b, 0xb5,
+		0xb7, 0x92, 0xa4, 0xa0, 0x78, 0x72, 0x82, 0xc1, 0x0e, 0xd2, 0x8d, 0x6d, 0xc0, 0xe2, 0x7d, 0x4e,
+		0x3a, 0xb6, 0x46, 0xa7, 0x2a, 0x24, 0x20, 0x5b, 0x51, 0xa3, 0x1b, 0x53, 0x91, 0x1d, 0xa4, 0x7a,
+		0xbd, 0xa9, 0xb7, 0x06, 0x61, 0x3f, 0xa1, 0x1b, 0xfc, 0xc9, 0x75, 0x57, 0x01, 0x7c, 0xf9, 0x09,
+		0x8c, 0xc3, 0x83, 0x0d, 0x9d, 0x7b, 0x8f, 0x6f, 0x89, 0x8d, 0x8d, 0xe8, 0x0b, 0x0f, 0x1e, 0xfe,
+		0x1f, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x03, 0xe5, 0xb4, 0xed, 0xfb, 0x60, 0x01, 0x00,
 	}
 )
 
@@ -0,0 +1,86 @@
+// Code generated by model-compiler. DO NOT EDIT.
+
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+)
+
+var readOnlyPaths = []*admin.ReadOnlyPath{
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/admin-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The desired state of the interface.  In RFC 7223 this leaf\nhas the same read semantics as ifAdminStatus.  Here, it\nreflects the administrative state as set by enabling or\ndisabling the interface.", Units: "", IsAKey: false, AttrName: "admin-status"},
+		{SubPath: "/ifindex", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}, Description: "System assigned number for each interface.  Corresponds to\nifIndex object in SNMP Interface MIB", Units: "", IsAKey: false, AttrName: "ifindex"},
+		{SubPath: "/last-change", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}, Description: "This timestamp indicates the time of the last state change\nof the interface (e.g., up-to-down transition). This\ncorresponds to the ifLastChange object in the standard\ninterface MIB.\n\nThe value is the timestamp in nanoseconds relative to\nthe Unix Epoch (Jan 1, 1970 00:00:00 UTC).", Units: "", IsAKey: false, AttrName: "last-change"},
+		{SubPath: "/oper-status", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "The current operational state of the interface.\n\nThis leaf has the same semantics as ifOperStatus.", Units: "", IsAKey: false, AttrName: "oper-status"},
+	}},
+	{Path: "/switch[switch-id=*]/state", SubPath: []*admin.ReadOnlySubPath{
+		{SubPath: "/connected", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "status of the port: up|down", Units: "", IsAKey: false, AttrName: "connected"},
+		{SubPath: "/last-connected", ValueType: configapi.ValueType_STRING, TypeOpts: nil, Description: "Last known time the switch was connected", Units: "", IsAKey: false, AttrName: "last-connected"},
+	}},
+}
+
+var readWritePaths = []*admin.ReadWritePath{
+	{Path: "/dhcp-server[dhcp-server-id=*]/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "an ip address", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/dhcp-server[dhcp-server-id=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/dhcp-server[dhcp-server-id=*]/dhcp-server-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "The ID of the DHCP Server", Mandatory: false, Default: "", Range: nil, Length: []string{"1..18446744073709551615"}, TypeOpts: nil, IsAKey: true, AttrName: "dhcp-server-id"},
+	{Path: "/dhcp-server[dhcp-server-id=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/route[route-id=*]/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "IP address of hop", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/route[route-id=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/route[route-id=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/route[route-id=*]/metric", ValueType: configapi.ValueType_UINT, Units: "", Description: "Metric specifies the priority", Mandatory: true, Default: "", Range: []string{"0..255"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "metric"},
+	{Path: "/route[route-id=*]/prefix", ValueType: configapi.ValueType_STRING, Units: "", Description: "subnet to match packet", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "prefix"},
+	{Path: "/route[route-id=*]/route-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "The ID of the route", Mandatory: false, Default: "", Range: nil, Length: []string{"1..18446744073709551615"}, TypeOpts: nil, IsAKey: true, AttrName: "route-id"},
+	{Path: "/switch-model[switch-model-id=*]/attribute[attribute-key=*]/attribute-key", ValueType: configapi.ValueType_STRING, Units: "", Description: "the key in a map of attributes", Mandatory: false, Default: "", Range: nil, Length: []string{"1..40"}, TypeOpts: nil, IsAKey: true, AttrName: "attribute-key"},
+	{Path: "/switch-model[switch-model-id=*]/attribute[attribute-key=*]/value", ValueType: configapi.ValueType_STRING, Units: "", Description: "the value of attribute-key", Mandatory: true, Default: "", Range: nil, Length: []string{"1..200"}, TypeOpts: nil, IsAKey: false, AttrName: "value"},
+	{Path: "/switch-model[switch-model-id=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/switch-model[switch-model-id=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/switch-model[switch-model-id=*]/pipeline", ValueType: configapi.ValueType_STRING, Units: "", Description: "Pipeline configuration - dual or quad", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "pipeline"},
+	{Path: "/switch-model[switch-model-id=*]/port[cage-number=*]/cage-number", ValueType: configapi.ValueType_UINT, Units: "", Description: "identifier of the cage - physical port on switch", Mandatory: false, Default: "", Range: []string{"0..255"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: true, AttrName: "cage-number"},
+	{Path: "/switch-model[switch-model-id=*]/port[cage-number=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/switch-model[switch-model-id=*]/port[cage-number=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/switch-model[switch-model-id=*]/port[cage-number=*]/max-channel", ValueType: configapi.ValueType_UINT, Units: "", Description: "A splitter can divide the port in to channels.\nThe default value 0 indicates the port is not channelizable", Mandatory: false, Default: "0", Range: []string{"0..16"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: false, AttrName: "max-channel"},
+	{Path: "/switch-model[switch-model-id=*]/port[cage-number=*]/speeds", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "port speed", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "speeds"},
+	{Path: "/switch-model[switch-model-id=*]/switch-model-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "unique identifier for the switch", Mandatory: false, Default: "", Range: nil, Length: []string{"1..100"}, TypeOpts: nil, IsAKey: true, AttrName: "switch-model-id"},
+	{Path: "/switch[switch-id=*]/attribute[attribute-key=*]/attribute-key", ValueType: configapi.ValueType_STRING, Units: "", Description: "the key in a map of attributes", Mandatory: false, Default: "", Range: nil, Length: []string{"1..40"}, TypeOpts: nil, IsAKey: true, AttrName: "attribute-key"},
+	{Path: "/switch[switch-id=*]/attribute[attribute-key=*]/value", ValueType: configapi.ValueType_STRING, Units: "", Description: "the value of attribute-key", Mandatory: true, Default: "", Range: nil, Length: []string{"1..200"}, TypeOpts: nil, IsAKey: false, AttrName: "value"},
+	{Path: "/switch[switch-id=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/switch[switch-id=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/switch[switch-id=*]/management/address", ValueType: configapi.ValueType_STRING, Units: "", Description: "The management IPv4 address", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "address"},
+	{Path: "/switch[switch-id=*]/management/port-number", ValueType: configapi.ValueType_UINT, Units: "", Description: "The mangement port number", Mandatory: false, Default: "", Range: []string{"0..65535"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: false, AttrName: "port-number"},
+	{Path: "/switch[switch-id=*]/model-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "link to switch model", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "model-id"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/cage-number", ValueType: configapi.ValueType_STRING, Units: "", Description: "reference to the cage-number of the port in the switch model", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "cage-number"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/channel-number", ValueType: configapi.ValueType_UINT, Units: "", Description: "reference to the channel-number of the port in the switch model.\nThe value cannot exceed the max-channels of the corresponding port in the switch-model", Mandatory: false, Default: "", Range: []string{"0..16"}, Length: nil, TypeOpts: []uint64{8}, IsAKey: true, AttrName: "channel-number"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/dhcp-connect-point", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "Reference to DHCP connect point", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "dhcp-connect-point"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/speed", ValueType: configapi.ValueType_STRING, Units: "", Description: "configured port speed", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "speed"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/vlans/tagged", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "multiple tagged vlans", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "tagged"},
+	{Path: "/switch[switch-id=*]/port[cage-number=*][channel-number=*]/vlans/untagged", ValueType: configapi.ValueType_STRING, Units: "", Description: "vlan for untagged packets", Mandatory: false, Default: "1", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "untagged"},
+	{Path: "/switch[switch-id=*]/role", ValueType: configapi.ValueType_STRING, Units: "", Description: "The role of the switch in the fabric", Mandatory: true, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "role"},
+	{Path: "/switch[switch-id=*]/switch-id", ValueType: configapi.ValueType_STRING, Units: "", Description: "unique identifier for the switch", Mandatory: false, Default: "", Range: nil, Length: []string{"1..253"}, TypeOpts: nil, IsAKey: true, AttrName: "switch-id"},
+	{Path: "/switch[switch-id=*]/switch-pair/paired-switch", ValueType: configapi.ValueType_STRING, Units: "", Description: "Paired switch identifier. A guard rail will require that\nthis field is present when the pairing-port list has at least 1 elements", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "paired-switch"},
+	{Path: "/switch[switch-id=*]/switch-pair/pairing-port[cage-number=*][channel-number=*]/cage-number", ValueType: configapi.ValueType_STRING, Units: "", Description: "Port cage number used for connecting to ", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "cage-number"},
+	{Path: "/switch[switch-id=*]/switch-pair/pairing-port[cage-number=*][channel-number=*]/channel-number", ValueType: configapi.ValueType_STRING, Units: "", Description: "Port channel number used for Switch A", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: true, AttrName: "channel-number"},
+	{Path: "/switch[switch-id=*]/vlan[vlan-id=*]/description", ValueType: configapi.ValueType_STRING, Units: "", Description: "long description field", Mandatory: false, Default: "", Range: nil, Length: []string{"1..1024"}, TypeOpts: nil, IsAKey: false, AttrName: "description"},
+	{Path: "/switch[switch-id=*]/vlan[vlan-id=*]/display-name", ValueType: configapi.ValueType_STRING, Units: "", Description: "display name to use in GUI or CLI", Mandatory: false, Default: "", Range: nil, Length: []string{"1..80"}, TypeOpts: nil, IsAKey: false, AttrName: "display-name"},
+	{Path: "/switch[switch-id=*]/vlan[vlan-id=*]/subnet", ValueType: configapi.ValueType_LEAFLIST_STRING, Units: "", Description: "Network subnets for VLAN", Mandatory: false, Default: "", Range: nil, Length: nil, TypeOpts: nil, IsAKey: false, AttrName: "subnet"},
+	{Path: "/switch[switch-id=*]/vlan[vlan-id=*]/vlan-id", ValueType: configapi.ValueType_UINT, Units: "", Description: "the VLAN ID", Mandatory: false, Default: "", Range: []string{"0..4096"}, Length: nil, TypeOpts: []uint64{16}, IsAKey: true, AttrName: "vlan-id"},
+}
+
+// ReadOnlyPaths returns the paths of the state attributes of the model, extracted from its
+// schema when the model was compiled
+func ReadOnlyPaths() []*admin.ReadOnlyPath {
+	return readOnlyPaths
+}
+
+// ReadWritePaths returns the paths of the configuration attributes of the model, extracted
+// from its schema when the model was compiled
+func ReadWritePaths() []*admin.ReadWritePath {
+	return readWritePaths
+}
@@ -5,12 +5,12 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.22.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
 	- onf-test1-extra@2021-04-01.yang
 	- onf-test1@2018-02-20.yang
 Imported modules were sourced from:
-	- /config-model/yang/...
+	- yang/...
 */
 package api
 
@@ -651,208 +651,211 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5b, 0x6f, 0xdb, 0x38,
-		0x16, 0x7e, 0xcf, 0xaf, 0x38, 0xd0, 0x4b, 0x93, 0x45, 0x9c, 0xd8, 0x8a, 0x9d, 0xb6, 0x01, 0x06,
-		0x18, 0xf7, 0xb6, 0x0b, 0x4c, 0xdb, 0x29, 0x3a, 0xc1, 0x00, 0xbb, 0xdd, 0x60, 0x41, 0x5b, 0x47,
-		0x36, 0x11, 0x99, 0xf2, 0x48, 0x54, 0x1a, 0x63, 0x90, 0xff, 0xbe, 0xd0, 0xc5, 0xf2, 0x4d, 0x96,
-		0x0e, 0x75, 0x71, 0xec, 0x98, 0xf3, 0x30, 0x75, 0x64, 0x4a, 0x16, 0x79, 0xae, 0xfc, 0xce, 0x47,
-		0xf2, 0xef, 0x13, 0x00, 0x00, 0xe3, 0x2b, 0x9b, 0xa0, 0x71, 0x03, 0x86, 0x85, 0x0f, 0x7c, 0x88,
-		0xc6, 0x79, 0x7c, 0xf5, 0x37, 0x2e, 0x2c, 0xe3, 0x06, 0x3a, 0xc9, 0x9f, 0xef, 0x5d, 0x61, 0xf3,
-		0x91, 0x71, 0x03, 0xed, 0xe4, 0xc2, 0x07, 0xee, 0x19, 0x37, 0x10, 0x3f, 0x22, 0xba, 0x30, 0x74,
-		0x85, 0xec, 0xb0, 0x95, 0x6b, 0x2b, 0x8f, 0x4f, 0xbe, 0x3f, 0x5f, 0xfd, 0xf6, 0x03, 0xfa, 0x43,
-		0x8f, 0x4f, 0x25, 0x77, 0x45, 0xd8, 0xe8, 0x76, 0x8c, 0x20, 0xdd, 0x29, 0x38, 0xf8, 0x80, 0x0e,
-		0x84, 0xb7, 0x30, 0x2e, 0xd0, 0x5b, 0xbf, 0x6b, 0xf5, 0xe5, 0xd2, 0xcb, 0xeb, 0x2f, 0x99, 0x7e,
-		0xf1, 0xcd, 0x43, 0x9b, 0x3f, 0x6e, 0xbc, 0xdb, 0xca, 0xfb, 0xc9, 0xce, 0xda, 0xaf, 0x44, 0xdf,
-		0xfe, 0xe1, 0x06, 0xde, 0x10, 0x33, 0xef, 0x8c, 0xdf, 0x04, 0x67, 0x3f, 0x5d, 0x2f, 0x7c, 0x19,
-		0x63, 0x1a, 0xff, 0xc8, 0x79, 0x76, 0xc3, 0x7f, 0x31, 0xbf, 0xef, 0x8d, 0x82, 0x09, 0x0a, 0x69,
-		0xdc, 0x80, 0xf4, 0x02, 0xdc, 0xd2, 0x70, 0xa9, 0x55, 0xf8, 0x4e, 0x1b, 0x8d, 0x9e, 0x56, 0xae,
-		0x3c, 0xad, 0x8f, 0xe7, 0x9a, 0x58, 0x56, 0xc4, 0x63, 0xb2, 0xed, 0x1d, 0x59, 0x16, 0x93, 0xc9,
-		0xb6, 0xf5, 0x22, 0x43, 0x5c, 0xa6, 0xb0, 0x0a, 0xc4, 0x55, 0x20, 0xb6, 0x42, 0xf1, 0x51, 0xc4,
-		0x48, 0x13, 0x27, 0x55, 0xac, 0xca, 0xe2, 0x55, 0x16, 0x33, 0x59, 0xdc, 0xd9, 0x62, 0xdf, 0x22,
-		0xfe, 0x42, 0x35, 0x48, 0x1b, 0x38, 0xc8, 0xec, 0x1c, 0x75, 0xd8, 0x18, 0xce, 0xa4, 0x7d, 0x41,
-		0x67, 0xd6, 0xd4, 0xe3, 0x6b, 0x30, 0x41, 0x8f, 0x0f, 0x21, 0xbc, 0x19, 0xb8, 0xf0, 0xb9, 0x85,
-		0xf0, 0x7e, 0xae, 0x24, 0x40, 0x79, 0x9c, 0xcd, 0x02, 0x27, 0x1c, 0x9a, 0x1f, 0xb9, 0x0d, 0xa3,
-		0xc6, 0xa6, 0x91, 0xdb, 0xe6, 0xae, 0xe0, 0xb7, 0x12, 0xdd, 0x6c, 0x17, 0x34, 0x2b, 0xd2, 0x51,
-		0x15, 0x5d, 0x55, 0xd3, 0x59, 0x55, 0xdd, 0x2d, 0xad, 0xc3, 0xa5, 0x75, 0x59, 0x59, 0xa7, 0xf3,
-		0x75, 0xbb, 0x40, 0xc7, 0xd3, 0x5f, 0xbb, 0x9d, 0x4d, 0x51, 0x6d, 0x9c, 0x03, 0x2e, 0xe4, 0x1b,
-		0xca, 0x50, 0x27, 0x4a, 0xd1, 0x23, 0x34, 0xfd, 0xce, 0xc4, 0x08, 0x49, 0x9a, 0x1a, 0xfe, 0x47,
-		0x13, 0x5d, 0xf4, 0xe0, 0x2f, 0x5c, 0x90, 0x65, 0x9d, 0xde, 0xf4, 0x27, 0x73, 0x02, 0xdc, 0xee,
-		0x6a, 0xb7, 0xde, 0xf7, 0xc9, 0x63, 0xc3, 0xd0, 0x7a, 0x3f, 0xf0, 0x11, 0x97, 0x7e, 0xb1, 0x9a,
-		0x6f, 0x0e, 0x31, 0x8e, 0x98, 0xe4, 0x0f, 0xe1, 0x6f, 0xdb, 0xcc, 0xf1, 0x91, 0x7c, 0xf7, 0xd3,
-		0xb9, 0xc2, 0x90, 0xb0, 0xc7, 0xf2, 0x43, 0x72, 0x75, 0x38, 0x43, 0x72, 0x52, 0xe3, 0xc0, 0xed,
-		0x4c, 0xe3, 0xb4, 0xca, 0x6d, 0x8e, 0xc9, 0x8b, 0xd3, 0xb9, 0xc2, 0x56, 0x77, 0x95, 0x5c, 0x3a,
-		0x3e, 0x4a, 0x8f, 0xb5, 0x02, 0xe1, 0x4b, 0x36, 0x70, 0x88, 0xce, 0xdd, 0x43, 0x1b, 0x3d, 0x14,
-		0xc3, 0x46, 0x9c, 0xf0, 0x3c, 0x72, 0x7c, 0xff, 0xf4, 0x1e, 0xae, 0xdb, 0xdd, 0xb6, 0xa1, 0xa0,
-		0x3a, 0x8a, 0xf1, 0x3a, 0x2b, 0x6e, 0x2f, 0xfa, 0xa6, 0xa8, 0x07, 0x65, 0x43, 0x78, 0x66, 0x28,
-		0x4f, 0x3b, 0xbf, 0x6f, 0xda, 0x74, 0x52, 0x42, 0xcf, 0xe2, 0x8c, 0x76, 0xa0, 0x98, 0x01, 0x0f,
-		0x14, 0x33, 0xe0, 0x3f, 0x5d, 0x47, 0xb2, 0x11, 0x96, 0xce, 0x80, 0x75, 0x56, 0x7a, 0xa8, 0x59,
-		0xe9, 0x17, 0x26, 0x2c, 0x26, 0x5d, 0x6f, 0x56, 0x9c, 0x85, 0x95, 0xc8, 0x60, 0x2d, 0x1c, 0xf2,
-		0x09, 0x73, 0xae, 0xbb, 0x0a, 0x59, 0x6c, 0xc7, 0x24, 0xb4, 0xdd, 0x88, 0x3c, 0x57, 0xc7, 0x9b,
-		0xfb, 0x5e, 0xbd, 0xb8, 0x44, 0xc4, 0x6c, 0xb7, 0xdb, 0x87, 0x33, 0x2a, 0xfb, 0x1e, 0x3c, 0x86,
-		0x8a, 0xc1, 0x63, 0xa8, 0x18, 0x3c, 0xbe, 0x23, 0xb3, 0xc0, 0x15, 0xce, 0x6c, 0x67, 0xe1, 0xc3,
-		0xd4, 0xe1, 0xe3, 0x60, 0x41, 0x0d, 0x5f, 0x7a, 0x5c, 0x8c, 0x54, 0xe2, 0xc1, 0x9b, 0xa6, 0x0c,
-		0xc3, 0x52, 0x34, 0x0c, 0x4b, 0xd1, 0x30, 0xfa, 0xc2, 0x95, 0x63, 0xf4, 0x20, 0x89, 0x82, 0x3a,
-		0xb1, 0xd2, 0x96, 0xa1, 0x93, 0x25, 0x9d, 0x2c, 0xe9, 0x64, 0x69, 0x8f, 0x93, 0x25, 0x54, 0x8c,
-		0x09, 0xa8, 0x18, 0x13, 0xa2, 0x14, 0xc9, 0xe1, 0xbe, 0xd4, 0xd1, 0x40, 0x47, 0x83, 0xbc, 0x71,
-		0xe6, 0x42, 0x76, 0xae, 0x15, 0x22, 0x81, 0x79, 0xb8, 0x3e, 0xbd, 0xba, 0xff, 0xaa, 0x80, 0x3b,
-		0x87, 0x2a, 0xb3, 0x97, 0x4e, 0x5d, 0x63, 0xf1, 0x4a, 0x16, 0xf6, 0x99, 0xfb, 0xb2, 0x2f, 0xa5,
-		0x47, 0xb3, 0xb2, 0x2f, 0x5c, 0x7c, 0x74, 0x30, 0xb4, 0x7f, 0xe2, 0x50, 0x85, 0xe2, 0x5c, 0xba,
-		0xa3, 0xf3, 0xa6, 0xdb, 0xbd, 0x7e, 0xdd, 0xed, 0xb6, 0x5f, 0x5f, 0xbd, 0x6e, 0xbf, 0xed, 0xf5,
-		0x3a, 0xd7, 0x1d, 0x4a, 0xf5, 0xf5, 0x77, 0xcf, 0x42, 0x0f, 0xad, 0x77, 0x33, 0xe3, 0x06, 0x44,
-		0xe0, 0x38, 0x4d, 0x45, 0x31, 0x5b, 0x31, 0x8a, 0xd9, 0x8a, 0x51, 0x6c, 0xc0, 0x05, 0xf3, 0x56,
-		0xe7, 0xfb, 0x43, 0x1d, 0xc7, 0x74, 0x1c, 0xcb, 0x18, 0xe7, 0x58, 0x55, 0x14, 0x02, 0xd9, 0x5b,
-		0x42, 0xd3, 0xcf, 0x28, 0x46, 0x72, 0xbc, 0x77, 0x91, 0xcc, 0x6c, 0xeb, 0xa2, 0xf2, 0x21, 0x8f,
-		0xc9, 0xbe, 0x4f, 0x4e, 0x46, 0x8a, 0x6e, 0x7d, 0xa4, 0xe8, 0xd6, 0xdf, 0xb9, 0xae, 0x83, 0x4c,
-		0xe8, 0x32, 0xa0, 0xf6, 0xeb, 0xc5, 0x7e, 0x3d, 0xd6, 0x15, 0x15, 0xac, 0xaa, 0x53, 0xd6, 0x2e,
-		0x94, 0x38, 0xa5, 0x7d, 0x21, 0x5c, 0xc9, 0x12, 0x95, 0xce, 0xa1, 0x96, 0xfa, 0xc3, 0x31, 0x4e,
-		0xd8, 0x94, 0x45, 0x71, 0xc4, 0xb8, 0x74, 0x85, 0xdd, 0x92, 0xe8, 0xcb, 0xce, 0x65, 0xcc, 0x00,
-		0xbf, 0xcc, 0x65, 0x18, 0xc7, 0x4f, 0x90, 0x5e, 0x30, 0x94, 0x22, 0x19, 0x90, 0xdf, 0x85, 0x7d,
-		0x1b, 0xde, 0xff, 0xbf, 0xd0, 0x62, 0x3a, 0xfd, 0xe8, 0x1f, 0xb3, 0x9f, 0x2d, 0xb8, 0xcd, 0x1e,
-		0x65, 0xf4, 0x26, 0xb2, 0xe1, 0x0e, 0x81, 0x0b, 0x9d, 0xb4, 0xa3, 0x71, 0xa1, 0x3f, 0x67, 0xda,
-		0xf6, 0xf6, 0xdb, 0xf3, 0x6d, 0x5a, 0x93, 0xa1, 0xeb, 0x23, 0x43, 0x17, 0xda, 0x20, 0xbd, 0x86,
-		0xb2, 0xa8, 0x9d, 0xe4, 0xb4, 0x21, 0x26, 0x51, 0xb4, 0xd9, 0x14, 0xdd, 0x95, 0xce, 0x13, 0x83,
-		0x1e, 0xd1, 0x11, 0x96, 0xcd, 0x07, 0xd4, 0xf3, 0x80, 0x27, 0xda, 0x34, 0x50, 0xbd, 0xab, 0x9d,
-		0xf6, 0xfe, 0xf5, 0xb5, 0xa4, 0x2f, 0xbe, 0xab, 0xe2, 0xcf, 0xb8, 0x4f, 0x5a, 0xdb, 0x91, 0xb4,
-		0xa3, 0xf9, 0xb3, 0x3e, 0xf8, 0x7c, 0x32, 0x75, 0x30, 0x06, 0x55, 0x5d, 0x3b, 0x9c, 0x88, 0xda,
-		0x7c, 0x14, 0x78, 0x51, 0x08, 0x00, 0x2e, 0x71, 0xe2, 0xeb, 0x85, 0x1e, 0x7b, 0xbf, 0xd0, 0x23,
-		0x89, 0xa2, 0xc4, 0xec, 0x36, 0x6a, 0xad, 0x96, 0xdb, 0xde, 0x8e, 0x13, 0x15, 0xe1, 0x3e, 0xdc,
-		0xe3, 0x0c, 0x2d, 0x18, 0xcc, 0x80, 0xf2, 0x1c, 0x9d, 0xd4, 0x1e, 0x4f, 0x52, 0x5b, 0x82, 0x9c,
-		0x70, 0xb8, 0x68, 0x45, 0x57, 0x83, 0x15, 0xeb, 0x43, 0xf2, 0x46, 0x63, 0x15, 0x35, 0x60, 0x15,
-		0x1e, 0x13, 0x23, 0x6c, 0x4d, 0x08, 0x82, 0x48, 0x0d, 0x6f, 0x71, 0x8b, 0x22, 0xc5, 0x06, 0x26,
-		0xec, 0x11, 0x1e, 0x42, 0xf1, 0x81, 0xed, 0x7a, 0x20, 0xc7, 0x08, 0xd1, 0xb3, 0xb4, 0x57, 0xd7,
-		0x5e, 0xfd, 0xf8, 0xd6, 0xd1, 0x69, 0xfc, 0x79, 0x13, 0x7f, 0xee, 0xf5, 0xb4, 0x53, 0xaf, 0xcf,
-		0xa9, 0x13, 0xb4, 0x73, 0xdd, 0xa9, 0x73, 0xa1, 0xec, 0xd4, 0x93, 0x29, 0x5d, 0xf4, 0x00, 0x90,
-		0x2e, 0x48, 0xf4, 0x25, 0x78, 0x81, 0x83, 0x3e, 0x70, 0x01, 0xff, 0xee, 0x7f, 0xfd, 0xe7, 0x05,
-		0x7c, 0xe1, 0x02, 0x26, 0x81, 0x2f, 0x61, 0x80, 0xf0, 0xdf, 0xa0, 0xdd, 0xbe, 0x1a, 0xfe, 0x02,
-		0x84, 0x00, 0xa2, 0x1d, 0xff, 0xa1, 0x3a, 0xfe, 0x66, 0x97, 0xaa, 0xe8, 0x20, 0xa1, 0x83, 0x84,
-		0x0e, 0x12, 0x55, 0x83, 0x04, 0x2a, 0xb1, 0xea, 0xe3, 0xe6, 0xaa, 0xc1, 0x21, 0x5d, 0x65, 0x1a,
-		0x46, 0x86, 0x98, 0x98, 0x1f, 0x46, 0x85, 0x30, 0xf5, 0x37, 0x59, 0xe1, 0xee, 0x2e, 0x3a, 0x10,
-		0x1c, 0xe1, 0x0c, 0x20, 0xd4, 0x12, 0x0f, 0x6d, 0x15, 0x60, 0xe7, 0x35, 0xa1, 0xed, 0xb7, 0x79,
-		0xf1, 0x70, 0xa5, 0x64, 0x78, 0x99, 0x2c, 0x16, 0x69, 0xc0, 0xbe, 0xe4, 0x63, 0x6b, 0xea, 0xfe,
-		0x44, 0x8f, 0x6e, 0x62, 0xe9, 0x1d, 0x8a, 0x68, 0xa9, 0xc7, 0x84, 0x3f, 0xe1, 0x12, 0x48, 0x37,
-		0x6b, 0x53, 0x3a, 0xae, 0xc9, 0xb4, 0x12, 0x31, 0xf9, 0x5a, 0xef, 0x4a, 0xf3, 0x82, 0x12, 0x25,
-		0xcd, 0xe6, 0x22, 0xfb, 0x71, 0xa5, 0x02, 0xd9, 0x6f, 0x38, 0x2b, 0x28, 0x6c, 0xd1, 0x18, 0xce,
-		0x74, 0x66, 0xf3, 0x1a, 0xa3, 0x39, 0xa7, 0x1a, 0x40, 0xa3, 0x2d, 0x6f, 0xeb, 0x99, 0xc2, 0x26,
-		0x29, 0x46, 0x38, 0x9f, 0xaf, 0x83, 0x90, 0x90, 0xd6, 0x09, 0x83, 0xc9, 0x00, 0xbd, 0xd3, 0x8b,
-		0xcb, 0x14, 0x8a, 0x38, 0x4b, 0xb1, 0x82, 0xf5, 0xef, 0xd8, 0xe3, 0x19, 0xc5, 0xab, 0xad, 0x06,
-		0x4a, 0x62, 0x18, 0x49, 0x83, 0xd4, 0x98, 0xfb, 0xc0, 0x7d, 0x60, 0x31, 0x72, 0xe1, 0x4b, 0x26,
-		0x23, 0x01, 0x50, 0xa3, 0x4a, 0x89, 0x3d, 0x5a, 0x96, 0x43, 0x98, 0xb5, 0xf4, 0xee, 0x0a, 0x1e,
-		0xa3, 0xca, 0xee, 0x2c, 0xab, 0xf1, 0x6c, 0x5b, 0xf7, 0x6b, 0x32, 0x55, 0x0a, 0x81, 0xe3, 0xa3,
-		0xe7, 0xb9, 0x5e, 0x7f, 0x3a, 0xbd, 0x65, 0x23, 0x75, 0xf9, 0xc5, 0x80, 0x54, 0xa4, 0xa5, 0xbb,
-		0x91, 0x18, 0x86, 0x6f, 0xdb, 0x62, 0xd3, 0x69, 0x4b, 0xb2, 0xd1, 0xb3, 0xc8, 0x6c, 0xa9, 0xcb,
-		0xbb, 0x96, 0xd2, 0x17, 0xf4, 0x7d, 0x36, 0xc2, 0x92, 0x62, 0x0a, 0xad, 0x3d, 0x05, 0x08, 0x1d,
-		0xf4, 0x7d, 0x90, 0x63, 0x26, 0xc0, 0xf5, 0x00, 0xff, 0x0a, 0x98, 0x13, 0x4e, 0x1e, 0xa9, 0x55,
-		0xa7, 0x7a, 0xa5, 0x39, 0x49, 0xba, 0xf5, 0x6c, 0xd2, 0x54, 0x1a, 0x99, 0xba, 0x84, 0x5e, 0x2f,
-		0xdf, 0xa8, 0x61, 0xee, 0x67, 0x2e, 0x03, 0x09, 0x8a, 0xb9, 0x9f, 0x61, 0xa8, 0xae, 0xc8, 0xfd,
-		0xe4, 0xbe, 0xec, 0xd2, 0xa8, 0x52, 0x5d, 0x32, 0x53, 0x2a, 0xe2, 0xbf, 0xfc, 0xe4, 0x72, 0x0c,
-		0x0c, 0x92, 0x29, 0x31, 0x70, 0x61, 0xe1, 0xe3, 0x7e, 0x10, 0xa4, 0xf0, 0x10, 0x19, 0x52, 0xb8,
-		0x33, 0x8a, 0x14, 0x57, 0x40, 0xd6, 0xb8, 0x2a, 0xac, 0xf6, 0x99, 0x8b, 0xfb, 0x08, 0x4f, 0x8b,
-		0x34, 0x3f, 0x22, 0x46, 0xf9, 0x87, 0x31, 0xed, 0xc7, 0x97, 0x38, 0xef, 0xc7, 0xe3, 0xc3, 0xd0,
-		0x64, 0xe7, 0x26, 0xf1, 0xbe, 0xb2, 0x73, 0x13, 0xab, 0x61, 0xf8, 0x29, 0x72, 0xb1, 0x0d, 0x2d,
-		0xa8, 0xe9, 0x2a, 0xee, 0xab, 0xd7, 0x1d, 0x94, 0x59, 0xed, 0xdf, 0x65, 0xe0, 0x8a, 0xc8, 0xb0,
-		0xba, 0x0c, 0x70, 0x3e, 0xe3, 0xd2, 0xb6, 0xa5, 0x6d, 0xeb, 0x38, 0x79, 0x87, 0x1a, 0x56, 0xd3,
-		0xb0, 0x5a, 0x43, 0x8b, 0x24, 0x23, 0x1f, 0xab, 0xe0, 0xd3, 0xe3, 0xf6, 0xaa, 0x05, 0xc8, 0x34,
-		0x8d, 0xe6, 0x22, 0x4c, 0xa4, 0xd3, 0xa4, 0xda, 0x84, 0x7b, 0x9c, 0xf9, 0xc0, 0xfc, 0x78, 0x01,
-		0xa5, 0x87, 0x36, 0xd5, 0xcd, 0x77, 0xb4, 0x9b, 0x3f, 0x3c, 0x37, 0x5f, 0x94, 0xb0, 0xa7, 0x0d,
-		0x2d, 0xee, 0x4f, 0x1d, 0x36, 0x23, 0x2d, 0x71, 0xd8, 0x90, 0xce, 0xf2, 0xcd, 0xc4, 0x71, 0x58,
-		0x53, 0xd8, 0x70, 0x6a, 0x1f, 0xfd, 0xc1, 0x1c, 0x48, 0x9e, 0x16, 0xe5, 0xf7, 0xc0, 0xa4, 0xf4,
-		0xf8, 0x20, 0x90, 0x38, 0x57, 0x5f, 0x8b, 0xdb, 0x51, 0x69, 0x5d, 0x82, 0x13, 0x85, 0x8e, 0x18,
-		0x06, 0xf0, 0xa9, 0xbf, 0x4b, 0xcb, 0x59, 0x94, 0x95, 0xba, 0x8c, 0x72, 0x97, 0x54, 0xf2, 0x2a,
-		0x40, 0x4f, 0x25, 0xa5, 0xaf, 0x05, 0xe9, 0x29, 0x67, 0x04, 0x8a, 0x8e, 0x9d, 0x28, 0x2b, 0x72,
-		0x0e, 0x54, 0x3e, 0x17, 0x2a, 0x93, 0x13, 0x95, 0xcd, 0x8d, 0xd4, 0x73, 0xa4, 0x4a, 0xb9, 0x52,
-		0xd5, 0x9c, 0xa9, 0xb6, 0x3c, 0xa1, 0x7a, 0xbe, 0x50, 0x22, 0x97, 0xaa, 0x94, 0x53, 0x6d, 0x0c,
-		0x5d, 0xef, 0xf0, 0x87, 0xee, 0xa4, 0xc1, 0x81, 0x7e, 0x36, 0x8d, 0x6e, 0x6b, 0x95, 0x2e, 0x3b,
-		0x76, 0x66, 0xfb, 0xd8, 0x74, 0x9a, 0xdc, 0xfa, 0x6e, 0x87, 0x45, 0x2a, 0xfb, 0x1e, 0x67, 0x1d,
-		0xf5, 0x8c, 0x2e, 0xbe, 0xad, 0x5c, 0x2e, 0x67, 0xbb, 0x1e, 0xf2, 0x91, 0x08, 0x27, 0x1a, 0xd0,
-		0x81, 0x56, 0x38, 0xc7, 0x98, 0xa3, 0xb6, 0x3d, 0x76, 0xa9, 0xf2, 0x60, 0x9d, 0xac, 0xe9, 0x64,
-		0xad, 0x7a, 0xb2, 0x46, 0x07, 0x85, 0xcb, 0x80, 0xc3, 0xf9, 0x20, 0x31, 0x46, 0x28, 0x71, 0x2f,
-		0xfa, 0x14, 0x69, 0xfe, 0x8e, 0x4d, 0xdf, 0x2c, 0x67, 0xfa, 0x66, 0x0d, 0xa6, 0x6f, 0x66, 0x99,
-		0xbe, 0xa9, 0x4d, 0x5f, 0x9b, 0xfe, 0x51, 0x9a, 0xbe, 0xb9, 0x23, 0x96, 0xc2, 0x79, 0x61, 0xe9,
-		0x39, 0x8d, 0xef, 0x40, 0x31, 0xf5, 0x97, 0xb8, 0x69, 0x69, 0x03, 0x47, 0xa6, 0x91, 0x58, 0x81,
-		0xea, 0xf3, 0x99, 0xe5, 0xb3, 0x84, 0x87, 0x4c, 0x9e, 0xbe, 0x8a, 0xd2, 0x6b, 0x78, 0x75, 0x0e,
-		0x31, 0x04, 0x71, 0x7a, 0x71, 0x71, 0xf9, 0x2b, 0xb7, 0xce, 0xce, 0xe1, 0x55, 0x6b, 0xe9, 0xe2,
-		0xe5, 0xaf, 0x91, 0x80, 0xb3, 0x2f, 0x9b, 0x67, 0x67, 0xf0, 0xcb, 0xe2, 0xda, 0x12, 0x78, 0x77,
-		0xa6, 0x62, 0x28, 0x65, 0x48, 0x86, 0x9b, 0xae, 0x34, 0x65, 0xdb, 0xf9, 0x2c, 0x5a, 0x4e, 0x59,
-		0x8a, 0x73, 0x58, 0xd5, 0xd5, 0x42, 0x2d, 0x1c, 0xc4, 0xda, 0xfc, 0x2e, 0x6c, 0xe7, 0x24, 0x66,
-		0x8f, 0x52, 0x43, 0xf3, 0x14, 0x95, 0xd2, 0x52, 0x19, 0xca, 0x62, 0x1e, 0x8e, 0x0c, 0xb6, 0xeb,
-		0x4d, 0xd8, 0x73, 0x29, 0x40, 0x59, 0x4a, 0x63, 0x73, 0x2a, 0x90, 0x31, 0x34, 0xfb, 0x22, 0x75,
-		0x55, 0x0a, 0x64, 0xae, 0xd8, 0xe7, 0x94, 0xbf, 0xb8, 0x8f, 0x12, 0xad, 0xc4, 0x51, 0x81, 0xc3,
-		0xef, 0x11, 0x12, 0xf7, 0x17, 0xd3, 0xa3, 0x2f, 0x2e, 0x2e, 0xb9, 0x15, 0x7d, 0xc4, 0x56, 0x7c,
-		0x25, 0x72, 0x7a, 0x1b, 0x57, 0xcc, 0xf8, 0xca, 0xab, 0x67, 0xd5, 0x25, 0x75, 0x42, 0xe5, 0x6e,
-		0x74, 0xa9, 0xc9, 0xf1, 0x6e, 0x4a, 0x41, 0xf7, 0x62, 0x2f, 0x77, 0x22, 0x8d, 0x33, 0x6d, 0x4f,
-		0xa1, 0x73, 0x76, 0x2f, 0x49, 0xd5, 0x5e, 0xa0, 0x91, 0x3b, 0xbb, 0xf1, 0xff, 0xfb, 0xc6, 0x2e,
-		0x17, 0x87, 0x6c, 0x25, 0xf5, 0x35, 0xbc, 0x34, 0x44, 0x2d, 0x6f, 0xac, 0xb6, 0x5a, 0xa4, 0x4e,
-		0x06, 0x6f, 0xb7, 0x1a, 0x81, 0xb7, 0x5b, 0x95, 0xbf, 0xdb, 0xa3, 0xf1, 0x77, 0x7b, 0x25, 0xf8,
-		0xbb, 0x31, 0xd5, 0x40, 0xd3, 0x76, 0x0f, 0x80, 0xb6, 0x4b, 0x02, 0x89, 0xd3, 0xe1, 0x24, 0x40,
-		0xb8, 0xeb, 0x7a, 0x11, 0x4d, 0x37, 0xdd, 0xe4, 0x54, 0xa1, 0x1e, 0xb4, 0x80, 0x54, 0x41, 0xd5,
-		0x0c, 0xc3, 0x1a, 0xd3, 0x01, 0xcd, 0x30, 0xd4, 0x0c, 0x43, 0xcd, 0x30, 0xac, 0x6b, 0x4c, 0xf6,
-		0x98, 0x61, 0x48, 0x82, 0xfd, 0x97, 0x9d, 0xb9, 0xa9, 0xee, 0xcc, 0xcd, 0x15, 0x67, 0x1e, 0x2f,
-		0x53, 0xd5, 0xce, 0x5c, 0x3b, 0xf3, 0x63, 0xdc, 0xab, 0xca, 0xd4, 0x9e, 0x7c, 0x23, 0xb8, 0x69,
-		0x4f, 0x5e, 0x87, 0x27, 0x77, 0x90, 0xd9, 0x3d, 0xa6, 0xb6, 0xfe, 0xa7, 0xa7, 0xca, 0x15, 0x17,
-		0x6e, 0x5c, 0xaf, 0x5d, 0x30, 0x6d, 0x75, 0x9e, 0xae, 0x5d, 0xbb, 0xce, 0xd3, 0x75, 0x9e, 0xae,
-		0xf3, 0xf4, 0xea, 0xde, 0xbd, 0x0c, 0x86, 0x1a, 0x81, 0x25, 0x39, 0x79, 0xf9, 0x0b, 0x82, 0x52,
-		0x9f, 0x73, 0xe3, 0x9d, 0x79, 0x49, 0xbd, 0xc7, 0x60, 0xa5, 0x46, 0x9e, 0x5d, 0x39, 0xdf, 0x2c,
-		0x9c, 0xc7, 0xd1, 0x76, 0x97, 0x1b, 0xf2, 0x54, 0xa9, 0x91, 0xbf, 0x9c, 0x7d, 0x79, 0x2a, 0xd4,
-		0xc0, 0xf7, 0x60, 0x7b, 0x9e, 0x58, 0x6b, 0xd4, 0xca, 0xdb, 0x87, 0xbf, 0x43, 0xcf, 0x6a, 0xaf,
-		0x0f, 0x66, 0x93, 0x9e, 0xe4, 0xb5, 0x0b, 0x8a, 0xa4, 0x3d, 0x96, 0x54, 0x48, 0x37, 0xca, 0xa1,
-		0xea, 0xd5, 0xe7, 0xc3, 0xdf, 0xbe, 0xa7, 0xce, 0x31, 0x3b, 0xda, 0x8d, 0x7d, 0x7a, 0xd5, 0xca,
-		0x82, 0x3d, 0x72, 0x59, 0xf0, 0x24, 0xa7, 0x67, 0x46, 0x3f, 0x18, 0x85, 0x52, 0x45, 0x2b, 0x33,
-		0xec, 0x16, 0x94, 0x0d, 0x17, 0x64, 0x48, 0x6a, 0xed, 0xd0, 0xb2, 0x80, 0xc1, 0xd0, 0x0d, 0x42,
-		0xef, 0xee, 0xda, 0x20, 0xf0, 0x67, 0x34, 0x0f, 0xf5, 0x41, 0xba, 0x90, 0xff, 0x24, 0x5d, 0x4c,
-		0xac, 0x32, 0x8f, 0xac, 0xb9, 0x98, 0x98, 0xbf, 0x2d, 0xd4, 0xa6, 0x8f, 0x2d, 0xa8, 0x82, 0x43,
-		0x2d, 0xdb, 0x44, 0x11, 0x55, 0x45, 0x43, 0x15, 0xfb, 0x0c, 0x55, 0x14, 0x71, 0x4c, 0xd4, 0x26,
-		0x48, 0xea, 0x13, 0xa5, 0x5a, 0x26, 0x4c, 0x6a, 0x13, 0xa7, 0x8a, 0x00, 0x62, 0x2e, 0xc3, 0x23,
-		0xd3, 0x14, 0x7b, 0x15, 0x4c, 0x31, 0x97, 0xf1, 0xa1, 0x2d, 0xf0, 0xe5, 0x58, 0x60, 0x11, 0x42,
-		0xf1, 0x92, 0x0d, 0xf1, 0x80, 0x17, 0x0f, 0x34, 0x8f, 0x74, 0x54, 0x42, 0x3c, 0x1a, 0x42, 0x3e,
-		0xaa, 0x4c, 0xad, 0xea, 0x43, 0x42, 0x6a, 0x99, 0x68, 0x35, 0x87, 0x8c, 0xa8, 0x01, 0xa6, 0xf0,
-		0x1c, 0xab, 0x03, 0xca, 0x20, 0x27, 0x75, 0xcb, 0x7e, 0xff, 0x16, 0x06, 0x94, 0x40, 0x56, 0x76,
-		0x20, 0xeb, 0xca, 0x6b, 0x02, 0x76, 0x8f, 0xbc, 0x34, 0xa3, 0x2a, 0xfb, 0xc4, 0xfb, 0xdf, 0x39,
-		0x32, 0x53, 0x42, 0xd1, 0xf6, 0xb3, 0x5e, 0x53, 0x80, 0x9b, 0xdc, 0xad, 0xe1, 0x26, 0x84, 0x1c,
-		0x20, 0x3f, 0xe6, 0x13, 0x60, 0x08, 0xe1, 0xca, 0xd3, 0x78, 0x07, 0xd2, 0x1f, 0x3e, 0xca, 0x56,
-		0x72, 0xa4, 0x94, 0x7f, 0x6a, 0xbb, 0x8e, 0xe3, 0xfe, 0xe4, 0x62, 0xd4, 0xf2, 0xf9, 0xc0, 0xe1,
-		0x62, 0x74, 0x93, 0x6e, 0x54, 0x9a, 0x1c, 0xb3, 0x73, 0x0e, 0xf3, 0x4f, 0x67, 0x77, 0x79, 0x31,
-		0x5d, 0x29, 0x86, 0xa7, 0xef, 0xf5, 0xc0, 0x1c, 0x6e, 0xc5, 0xe7, 0xda, 0xdb, 0x8c, 0x3b, 0x3e,
-		0x70, 0x3b, 0xfd, 0xbd, 0x30, 0x4a, 0x09, 0x57, 0x42, 0x20, 0xf8, 0x5f, 0x01, 0xce, 0xb7, 0xa7,
-		0x2b, 0xdc, 0xc8, 0x5a, 0xd5, 0x2a, 0xcb, 0x07, 0xeb, 0x52, 0x36, 0xb7, 0x62, 0x63, 0xa5, 0xbb,
-		0xdf, 0xc0, 0xec, 0x4f, 0x25, 0xea, 0xae, 0xcc, 0x01, 0x4d, 0x46, 0x39, 0x3f, 0xa0, 0xac, 0x48,
-		0x54, 0x63, 0x68, 0x75, 0xa1, 0x2c, 0xf7, 0xa9, 0xa9, 0x71, 0xa6, 0x46, 0xbc, 0x8d, 0x53, 0xaf,
-		0x62, 0x7f, 0x1c, 0xea, 0xc5, 0x00, 0xc1, 0xc3, 0x29, 0xb2, 0xd0, 0x25, 0x37, 0x6d, 0x17, 0xaa,
-		0xd1, 0xa9, 0xba, 0x10, 0xa8, 0xfd, 0x6d, 0xd8, 0x73, 0xdf, 0x65, 0x2c, 0x84, 0x99, 0x7a, 0xe8,
-		0xa3, 0x18, 0x62, 0x15, 0x7f, 0x7c, 0xeb, 0x4e, 0xc1, 0xc1, 0x07, 0x74, 0x42, 0x2b, 0x8f, 0x1f,
-		0x28, 0xeb, 0xc7, 0x89, 0xe3, 0xd7, 0x6c, 0x12, 0x29, 0xce, 0xec, 0x47, 0xf5, 0x51, 0xcf, 0xaf,
-		0x33, 0xe4, 0x57, 0x4e, 0x8a, 0x2a, 0x26, 0x19, 0xe3, 0x91, 0x5f, 0x23, 0x59, 0xed, 0xcf, 0xe2,
-		0x6d, 0x97, 0xde, 0xcb, 0x88, 0x1e, 0x3d, 0x68, 0x45, 0xd3, 0xa8, 0x8d, 0xb7, 0x5a, 0x9e, 0x59,
-		0x2f, 0x5a, 0xad, 0xf5, 0x6a, 0xf3, 0xc4, 0x60, 0x1c, 0xba, 0xc2, 0x02, 0x99, 0x8e, 0x70, 0x7a,
-		0x0e, 0x24, 0xb4, 0x20, 0x9a, 0xc5, 0xb9, 0x22, 0x3e, 0x22, 0x3e, 0x7a, 0xde, 0x82, 0x91, 0xe7,
-		0x5f, 0xc0, 0x47, 0x8b, 0x4b, 0xf0, 0x67, 0x93, 0x81, 0xeb, 0x80, 0x3f, 0x76, 0x03, 0xc7, 0x9a,
-		0x9b, 0xd0, 0x03, 0x0f, 0x03, 0xfd, 0xc6, 0xaf, 0x67, 0xa3, 0x6c, 0x0b, 0x54, 0x6d, 0x8d, 0xb5,
-		0x9a, 0x87, 0xa2, 0xe5, 0x9f, 0x5f, 0x57, 0xa4, 0xc9, 0x64, 0x50, 0x8c, 0xac, 0xb7, 0x85, 0xe7,
-		0xcf, 0xe5, 0x57, 0xb5, 0xb6, 0x15, 0x30, 0x8c, 0xe4, 0x4c, 0xc4, 0xe2, 0x05, 0x71, 0x71, 0x3b,
-		0xea, 0x8a, 0xb8, 0x35, 0x61, 0x16, 0xd4, 0xb0, 0xda, 0x45, 0x35, 0x2c, 0xb3, 0x96, 0x1a, 0xd6,
-		0x21, 0x96, 0xb0, 0xea, 0xaa, 0x60, 0x15, 0x32, 0x1e, 0xe9, 0x07, 0x09, 0x12, 0x0e, 0x10, 0x24,
-		0xb2, 0xd6, 0x69, 0xf0, 0x28, 0x1d, 0x8a, 0x5e, 0x50, 0xb1, 0xdb, 0x6d, 0xea, 0xa6, 0x48, 0x65,
-		0x79, 0x7a, 0xea, 0xfc, 0xbc, 0x27, 0x1a, 0xb6, 0xab, 0xde, 0x5d, 0x73, 0x3f, 0xbb, 0x5b, 0x2f,
-		0x01, 0x81, 0xbc, 0xba, 0xd7, 0x1c, 0xd0, 0x96, 0xf7, 0x9a, 0x03, 0xba, 0x37, 0x8b, 0x8f, 0xbd,
-		0x8f, 0x8a, 0x3e, 0xae, 0x9d, 0x38, 0x37, 0x2e, 0x71, 0x52, 0x79, 0xa5, 0xaf, 0x76, 0x6c, 0x55,
-		0x1d, 0x5b, 0xf1, 0xf1, 0x3c, 0x51, 0x85, 0x9c, 0x7e, 0x42, 0x0f, 0xa5, 0xa0, 0xbe, 0x7e, 0x2a,
-		0xef, 0x38, 0x51, 0x0e, 0x95, 0x6a, 0xbc, 0x3e, 0x95, 0xb7, 0xf6, 0x52, 0xa0, 0x5e, 0x11, 0x56,
-		0xa9, 0x74, 0x56, 0x69, 0xcd, 0x40, 0x5b, 0xaf, 0x19, 0xd8, 0x88, 0xcb, 0xbd, 0x9e, 0x5e, 0x34,
-		0x50, 0x03, 0xd6, 0x14, 0xce, 0x3d, 0xae, 0x86, 0x6a, 0x4b, 0xc2, 0xae, 0x86, 0xca, 0x9c, 0x8e,
-		0xa4, 0x22, 0xb0, 0x58, 0x13, 0xc6, 0x05, 0xc8, 0xc4, 0xb3, 0xd7, 0xed, 0xd3, 0x4d, 0xed, 0xd3,
-		0x0f, 0xd6, 0xa7, 0xeb, 0xa5, 0x60, 0x47, 0xee, 0xd6, 0xf5, 0x52, 0xb0, 0x8a, 0x00, 0x75, 0xc1,
-		0x76, 0x5a, 0x79, 0x27, 0x9f, 0xea, 0x1d, 0xb5, 0x56, 0x81, 0xe0, 0x04, 0x87, 0xbd, 0xcc, 0x9d,
-		0xd1, 0x16, 0xa2, 0xc3, 0xef, 0xfe, 0x08, 0x1f, 0x12, 0x1f, 0x8f, 0xfb, 0xae, 0x26, 0x1e, 0x7d,
-		0x25, 0x7c, 0x3b, 0x1b, 0x5e, 0x26, 0xf6, 0x83, 0x02, 0x75, 0x87, 0x39, 0x42, 0x5f, 0xde, 0xba,
-		0xd3, 0xcf, 0xf8, 0x80, 0xce, 0x76, 0xb0, 0x7b, 0xad, 0x5d, 0x11, 0xdc, 0x1d, 0x1d, 0x30, 0xc6,
-		0x64, 0x94, 0x37, 0x2c, 0x30, 0xef, 0x53, 0xe1, 0x4a, 0xf0, 0x70, 0xe8, 0x4e, 0x26, 0x28, 0x2c,
-		0xb4, 0x60, 0x10, 0xc8, 0x94, 0x92, 0xe0, 0x07, 0xd3, 0xa9, 0xeb, 0x49, 0xb4, 0xce, 0xb6, 0xc0,
-		0xd9, 0xed, 0x6d, 0x70, 0x76, 0xfb, 0x68, 0xe1, 0xec, 0xad, 0x41, 0xbb, 0x38, 0x48, 0xe7, 0x05,
-		0x65, 0xe3, 0x1b, 0x93, 0x12, 0x3d, 0xb1, 0x35, 0x0a, 0x1b, 0x3f, 0xfa, 0xad, 0xff, 0xdc, 0xfd,
-		0x7d, 0xf5, 0xd4, 0xfa, 0xd1, 0x6e, 0xbd, 0xbd, 0xfb, 0x87, 0x51, 0x54, 0xf4, 0x39, 0x59, 0xfd,
-		0x94, 0xf4, 0x63, 0x9b, 0x71, 0x18, 0xdc, 0xff, 0xc4, 0xee, 0xf1, 0xbb, 0xeb, 0x6e, 0x8e, 0xde,
-		0xba, 0xc1, 0x18, 0xcb, 0x5f, 0xad, 0x98, 0xc4, 0x07, 0x7c, 0xe0, 0xc3, 0xc4, 0x08, 0x9e, 0x4e,
-		0x9e, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x28, 0xed, 0xc2, 0x7a,
-		0xa7, 0xd3, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0xeb, 0x6f, 0x1b, 0x37,
+		0x12, 0xff, 0xae, 0xbf, 0x62, 0xb0, 0x5f, 0x62, 0x1f, 0x24, 0x5b, 0x5a, 0x4b, 0x4e, 0x6a, 0xa0,
+		0x40, 0x95, 0xa6, 0xbd, 0x03, 0x9a, 0xb4, 0x45, 0x6a, 0x14, 0xb8, 0xcb, 0x19, 0x07, 0x4a, 0x4b,
+		0xc9, 0x44, 0x56, 0xa4, 0xba, 0xe4, 0x3a, 0x36, 0x0a, 0xff, 0xef, 0x87, 0x7d, 0xe8, 0x2d, 0xed,
+		0xce, 0xec, 0x43, 0x96, 0x2c, 0xea, 0x43, 0xeb, 0xac, 0xc8, 0x15, 0x39, 0x6f, 0xce, 0xfc, 0x48,
+		0xfe, 0xdd, 0x00, 0x00, 0x70, 0x7e, 0x65, 0x13, 0xee, 0xdc, 0x80, 0xe3, 0xf1, 0x07, 0x31, 0xe4,
+		0x4e, 0x33, 0x79, 0xfa, 0x8b, 0x90, 0x9e, 0x73, 0x03, 0x9d, 0xf4, 0x9f, 0x3f, 0x2a, 0x39, 0x12,
+		0x63, 0xe7, 0x06, 0xda, 0xe9, 0x83, 0x0f, 0x22, 0x70, 0x6e, 0x20, 0x79, 0x05, 0x00, 0x80, 0x33,
+		0x54, 0xd2, 0x74, 0xd8, 0xca, 0xb3, 0x95, 0xd7, 0xa7, 0xdf, 0x37, 0x57, 0xbf, 0xfd, 0xc0, 0xf5,
+		0x30, 0x10, 0x53, 0x23, 0x94, 0x8c, 0x1a, 0xdd, 0xde, 0x73, 0x30, 0x6a, 0x0a, 0x3e, 0x7f, 0xe0,
+		0x3e, 0x44, 0x5d, 0x98, 0x90, 0x3c, 0x58, 0xef, 0xb5, 0x3a, 0xb8, 0xf9, 0xe3, 0xf5, 0x41, 0xce,
+		0xbf, 0xf8, 0x3d, 0xe0, 0x23, 0xf1, 0xb8, 0x31, 0xb6, 0x95, 0xf1, 0x99, 0x8e, 0xd3, 0xdc, 0xfc,
+		0xf6, 0x0f, 0x15, 0x06, 0x43, 0xbe, 0xb5, 0x67, 0x32, 0x12, 0xfe, 0xf4, 0x4d, 0x05, 0xd1, 0x60,
+		0x9c, 0x69, 0xf2, 0x23, 0xcd, 0xed, 0x0d, 0xff, 0xc5, 0x74, 0x3f, 0x18, 0x87, 0x13, 0x2e, 0x8d,
+		0x73, 0x03, 0x26, 0x08, 0xf9, 0x8e, 0x86, 0x4b, 0xad, 0xa2, 0x31, 0x6d, 0x34, 0x7a, 0x5e, 0x79,
+		0xf2, 0xbc, 0x4e, 0xcf, 0x35, 0xb6, 0xac, 0xb0, 0xc7, 0x65, 0xbb, 0x27, 0xb2, 0xcc, 0x26, 0x97,
+		0xed, 0x9a, 0xc5, 0x16, 0x76, 0xb9, 0xd2, 0xcb, 0x61, 0x57, 0x0e, 0xdb, 0x72, 0xd9, 0x87, 0x61,
+		0x23, 0x8e, 0x9d, 0x58, 0xb6, 0x92, 0xd9, 0x4b, 0x66, 0x33, 0x9a, 0xdd, 0xdb, 0xd9, 0xbe, 0x83,
+		0xfd, 0xb9, 0x62, 0x30, 0xfb, 0x38, 0x3e, 0x67, 0x23, 0x97, 0x65, 0xb6, 0x59, 0x21, 0x67, 0xda,
+		0x3e, 0x67, 0x32, 0x6b, 0xe2, 0xf1, 0x6b, 0x38, 0xe1, 0x81, 0x18, 0x42, 0xd4, 0x19, 0x84, 0xd4,
+		0xc2, 0xe3, 0xf0, 0xe3, 0x4c, 0x48, 0x00, 0xf3, 0xba, 0x11, 0x0b, 0xfd, 0x88, 0x34, 0x5f, 0x32,
+		0x1b, 0x02, 0x00, 0x38, 0xae, 0x93, 0xd9, 0xe6, 0x2e, 0xe7, 0xb7, 0x52, 0xd9, 0x6c, 0xe7, 0x34,
+		0xcb, 0x93, 0x51, 0x8a, 0xac, 0xd2, 0x64, 0x96, 0x2a, 0xbb, 0x85, 0x65, 0xb8, 0xb0, 0x2c, 0x93,
+		0x65, 0x3a, 0x5b, 0xb6, 0x73, 0x64, 0x7c, 0xf6, 0x71, 0x6e, 0x9f, 0xa6, 0x9c, 0x46, 0xe7, 0x50,
+		0x48, 0xf3, 0x0e, 0x43, 0xea, 0x54, 0x28, 0x7a, 0x88, 0xa6, 0x9f, 0x99, 0x1c, 0x73, 0x94, 0xa4,
+		0x02, 0x00, 0x92, 0x75, 0x00, 0x00, 0xce, 0x27, 0x21, 0x9d, 0x1b, 0x42, 0x07, 0x00, 0x00, 0xe7,
+		0x4f, 0xe6, 0x87, 0x7c, 0xb7, 0xa9, 0xdd, 0xf5, 0x71, 0x7e, 0x0e, 0xd8, 0x30, 0xd2, 0xde, 0x0f,
+		0x62, 0x2c, 0x8c, 0xce, 0x17, 0xf3, 0x4d, 0x12, 0xf3, 0x31, 0x33, 0xe2, 0x21, 0xfa, 0xed, 0x11,
+		0xf3, 0x35, 0x47, 0xf7, 0x7e, 0x6e, 0x12, 0x48, 0xc2, 0x1e, 0x8b, 0x93, 0xe4, 0xea, 0x78, 0x48,
+		0xd2, 0xa8, 0x90, 0x70, 0x7b, 0x93, 0x38, 0x2b, 0x72, 0x9b, 0x34, 0x79, 0x75, 0x32, 0x97, 0xdb,
+		0xea, 0xae, 0x94, 0x49, 0xe7, 0x8f, 0x26, 0x60, 0xad, 0x50, 0x6a, 0xc3, 0x06, 0x3e, 0xd2, 0xb8,
+		0x07, 0x7c, 0xc4, 0x03, 0x2e, 0x87, 0xb5, 0x18, 0xe1, 0x99, 0xe7, 0xf8, 0xfc, 0xf3, 0x8f, 0x70,
+		0xdd, 0xee, 0xb6, 0x1d, 0x82, 0xe8, 0x10, 0xfd, 0xf5, 0x36, 0xbf, 0xbd, 0x98, 0x1b, 0x51, 0x0e,
+		0x8a, 0xba, 0xf0, 0xad, 0xae, 0x7c, 0x3e, 0xf9, 0x43, 0x93, 0xa6, 0x46, 0x01, 0x39, 0x4b, 0x22,
+		0xda, 0x01, 0x31, 0x02, 0x1e, 0x10, 0x23, 0xe0, 0x3f, 0x95, 0x6f, 0xd8, 0x98, 0x17, 0x8e, 0x80,
+		0x6d, 0x54, 0x5a, 0x99, 0x48, 0xef, 0x39, 0x2a, 0xfd, 0xc4, 0xa4, 0xc7, 0x8c, 0x0a, 0x9e, 0xf2,
+		0xa3, 0xb0, 0x02, 0x11, 0xac, 0xc7, 0x87, 0x62, 0xc2, 0xfc, 0xeb, 0x2e, 0x21, 0x8a, 0xed, 0xb8,
+		0xcd, 0x06, 0xdd, 0xf3, 0x5c, 0x9d, 0x6e, 0xec, 0x7b, 0xf5, 0xea, 0x02, 0x11, 0xb7, 0xdd, 0x6e,
+		0x1f, 0x0f, 0x55, 0x0e, 0xdd, 0x79, 0x0c, 0x89, 0xce, 0x63, 0x48, 0x74, 0x1e, 0x9f, 0x39, 0xf3,
+		0x40, 0x49, 0xff, 0x69, 0x6f, 0xee, 0xc3, 0xb5, 0xee, 0xe3, 0x40, 0xdc, 0x07, 0xdd, 0x25, 0x68,
+		0x13, 0x08, 0x39, 0xa6, 0xf8, 0x83, 0x77, 0x75, 0x29, 0x86, 0x47, 0x54, 0x0c, 0x8f, 0xa8, 0x18,
+		0x7d, 0xa9, 0xcc, 0x3d, 0x0f, 0x20, 0xf5, 0x82, 0x36, 0xb0, 0xb2, 0x9a, 0x91, 0x45, 0x67, 0x1b,
+		0x2c, 0xe5, 0x47, 0x06, 0x36, 0x58, 0x02, 0xb0, 0xc1, 0x52, 0x8d, 0xc1, 0x12, 0x27, 0xfa, 0x04,
+		0x4e, 0xf4, 0x09, 0x51, 0x27, 0xf0, 0x85, 0x36, 0xd6, 0x1b, 0x00, 0x58, 0x6f, 0xb0, 0x9b, 0xce,
+		0x42, 0x9a, 0xce, 0x35, 0xc1, 0x13, 0xb8, 0xc7, 0x6b, 0xd3, 0xcb, 0xdb, 0xaf, 0x12, 0x79, 0xe7,
+		0x48, 0x64, 0x0e, 0xd2, 0xa8, 0xdb, 0x5c, 0x3c, 0x49, 0xc3, 0x3e, 0x0a, 0x6d, 0xfa, 0xc6, 0x04,
+		0x38, 0x2d, 0xfb, 0x24, 0xe4, 0x4f, 0x3e, 0x8f, 0xf4, 0x1f, 0x49, 0xaa, 0x88, 0x9d, 0x4b, 0x3d,
+		0x3a, 0xef, 0xba, 0xdd, 0xeb, 0xb7, 0xdd, 0x6e, 0xfb, 0xed, 0xd5, 0xdb, 0xf6, 0x77, 0xbd, 0x5e,
+		0xe7, 0xba, 0x83, 0xa9, 0xbe, 0xfe, 0x16, 0x78, 0x3c, 0xe0, 0xde, 0xfb, 0x27, 0xe7, 0x06, 0x64,
+		0xe8, 0xfb, 0x75, 0x79, 0xb1, 0x11, 0xd1, 0x8b, 0x8d, 0x88, 0x5e, 0x6c, 0x20, 0x24, 0x0b, 0x56,
+		0xd7, 0xfb, 0x43, 0xeb, 0xc7, 0xac, 0x1f, 0xdb, 0x42, 0xe7, 0x44, 0x54, 0x08, 0x8e, 0xec, 0x3b,
+		0x44, 0xd3, 0x8f, 0x5c, 0x8e, 0xcd, 0xfd, 0xc1, 0x79, 0x32, 0xb7, 0x6d, 0x8b, 0xca, 0xc7, 0x4c,
+		0x93, 0x43, 0x5f, 0x9c, 0x8c, 0x89, 0x66, 0x7d, 0x4c, 0x34, 0xeb, 0xef, 0x95, 0xf2, 0x39, 0x93,
+		0xb6, 0x0c, 0x68, 0xed, 0x7a, 0xbe, 0x5d, 0x4f, 0x64, 0x85, 0x92, 0xab, 0xea, 0x14, 0xd5, 0x0b,
+		0x12, 0xa6, 0xb4, 0x2f, 0xa5, 0x32, 0x2c, 0x15, 0xe9, 0xdd, 0x13, 0x72, 0xf4, 0xf0, 0x9e, 0x4f,
+		0xd8, 0x94, 0xc5, 0x7e, 0xc4, 0xb9, 0x54, 0x72, 0xd4, 0x32, 0x5c, 0x9b, 0xce, 0x65, 0x82, 0x00,
+		0xbf, 0xcc, 0x44, 0x18, 0x27, 0x6f, 0x30, 0x41, 0x38, 0x34, 0x32, 0x25, 0xc8, 0x6f, 0x72, 0x74,
+		0x1b, 0xf5, 0xff, 0x5f, 0xa4, 0x31, 0x9d, 0x7e, 0xfc, 0x3f, 0xb7, 0xef, 0x34, 0x70, 0x33, 0xda,
+		0x32, 0x9b, 0x58, 0x87, 0x3b, 0x08, 0x2c, 0x74, 0xda, 0x0e, 0x87, 0x85, 0xfe, 0xb8, 0x55, 0xb7,
+		0x77, 0x77, 0xcf, 0xd6, 0x69, 0x0b, 0x86, 0xae, 0x0e, 0x0c, 0x9d, 0xab, 0x83, 0xf8, 0x1a, 0xca,
+		0xa2, 0x76, 0xd2, 0x6c, 0x94, 0x0d, 0xa2, 0x70, 0xab, 0x29, 0xbc, 0x29, 0x9d, 0x05, 0x06, 0xbd,
+		0x66, 0xa3, 0xd6, 0x78, 0x80, 0x1e, 0x07, 0x3c, 0xe3, 0x96, 0x81, 0xf4, 0xa9, 0x76, 0xda, 0x87,
+		0x37, 0xd7, 0x82, 0xb6, 0xf8, 0xae, 0x8c, 0x3d, 0x13, 0x1a, 0xb5, 0xb7, 0x23, 0x6d, 0x87, 0xb3,
+		0x67, 0x7d, 0xd0, 0x62, 0x32, 0xf5, 0x79, 0x92, 0x54, 0x55, 0xa3, 0x68, 0x21, 0x3a, 0x12, 0xe3,
+		0x30, 0x88, 0x5d, 0x00, 0x08, 0xc3, 0x27, 0xda, 0x6e, 0xf4, 0x38, 0xf8, 0x8d, 0x1e, 0xa9, 0x17,
+		0x45, 0x46, 0xb7, 0x71, 0x6b, 0x5a, 0x6c, 0x7b, 0x7b, 0x9f, 0x8a, 0x88, 0xd0, 0xf0, 0x95, 0x3f,
+		0x71, 0x0f, 0x06, 0x4f, 0x80, 0x79, 0x8f, 0x0d, 0x6a, 0x4b, 0x0b, 0x15, 0x59, 0xb8, 0x90, 0x56,
+		0xea, 0x10, 0xc0, 0x09, 0xc7, 0x9b, 0xad, 0xe8, 0xda, 0x64, 0xc5, 0x3a, 0x49, 0xde, 0xd9, 0x5c,
+		0x05, 0xb2, 0x7f, 0x06, 0x4b, 0x9c, 0x20, 0x2a, 0x33, 0xb5, 0x26, 0x08, 0x46, 0xcc, 0x15, 0x6f,
+		0xd1, 0x85, 0x08, 0xb1, 0x81, 0x09, 0x7b, 0x84, 0x87, 0x88, 0x7d, 0x30, 0x52, 0x01, 0x98, 0x7b,
+		0x0e, 0xf1, 0xbb, 0xac, 0x55, 0xb7, 0x56, 0xfd, 0xf4, 0xf6, 0xd1, 0xd9, 0xfc, 0xf3, 0x06, 0x49,
+		0xdc, 0x5e, 0xcf, 0x1a, 0xf5, 0xea, 0x8c, 0x3a, 0x42, 0x3a, 0xd7, 0x8d, 0xba, 0x90, 0x64, 0xa3,
+		0x9e, 0x2e, 0xe9, 0xe2, 0x17, 0x80, 0x51, 0x10, 0x25, 0xe6, 0x20, 0x08, 0x7d, 0xae, 0x41, 0x48,
+		0xf8, 0x77, 0xff, 0xd7, 0x7f, 0x5e, 0xc0, 0x27, 0x21, 0x61, 0x12, 0x6a, 0x03, 0x03, 0x0e, 0xff,
+		0x0d, 0xdb, 0xed, 0xab, 0xe1, 0xf7, 0x80, 0x70, 0x20, 0xd6, 0xf0, 0x03, 0xd8, 0xad, 0x2a, 0x00,
+		0xd6, 0x49, 0x1c, 0x81, 0x3d, 0xb4, 0x4e, 0xa2, 0x88, 0xf9, 0x87, 0x97, 0x73, 0x12, 0x9c, 0x84,
+		0xaa, 0x4f, 0x9a, 0x53, 0x9d, 0xc3, 0x7c, 0x97, 0x29, 0x18, 0x05, 0x09, 0x30, 0x1f, 0x84, 0x8c,
+		0x43, 0x7f, 0x97, 0xe5, 0x9e, 0xee, 0xb2, 0xae, 0xc7, 0xd6, 0x11, 0x1c, 0x9d, 0x23, 0xa0, 0x1b,
+		0xf7, 0x48, 0x4a, 0x02, 0x3e, 0xa2, 0x24, 0x76, 0xde, 0x22, 0xda, 0xfe, 0x3e, 0x2b, 0x1e, 0xae,
+		0x94, 0x0c, 0x2f, 0x13, 0x99, 0x74, 0x6a, 0xd0, 0x2f, 0xf3, 0xd8, 0x9a, 0xaa, 0x6f, 0x3c, 0xc0,
+		0xab, 0xd8, 0xbc, 0x07, 0x31, 0x5b, 0x1a, 0x30, 0xa9, 0x27, 0xc2, 0x00, 0xaa, 0xb3, 0x55, 0xa5,
+		0xd3, 0x51, 0xa5, 0x90, 0x0a, 0x4c, 0xbe, 0xb6, 0xa7, 0xd2, 0xd4, 0x1f, 0x13, 0x58, 0x34, 0x17,
+		0x51, 0x31, 0xf6, 0x10, 0x27, 0x91, 0x0a, 0x64, 0xbf, 0xf0, 0xa7, 0x9c, 0xc2, 0x16, 0x0e, 0xe1,
+		0x8c, 0x47, 0x36, 0xaf, 0x21, 0x9a, 0x33, 0xaa, 0x01, 0x38, 0xd8, 0xf2, 0xae, 0x99, 0x11, 0x0e,
+		0x49, 0x71, 0xa2, 0xf5, 0x7c, 0x15, 0x80, 0x84, 0x79, 0x9d, 0x30, 0x9c, 0x0c, 0x78, 0x70, 0x76,
+		0x71, 0x39, 0x4f, 0x45, 0x9c, 0xcf, 0x73, 0x05, 0xeb, 0xdf, 0xb1, 0xc7, 0x73, 0x8c, 0x55, 0x5b,
+		0x75, 0x94, 0x48, 0x37, 0x32, 0x77, 0x52, 0xf7, 0x42, 0x83, 0xd0, 0xc0, 0x92, 0xcc, 0x85, 0x36,
+		0xcc, 0xc4, 0x0c, 0xc0, 0x7a, 0x15, 0xa2, 0xfb, 0x82, 0x35, 0x17, 0xe6, 0x2d, 0x8d, 0x9d, 0x60,
+		0x31, 0x8a, 0xfa, 0x32, 0xd8, 0xf0, 0x67, 0xbb, 0xa6, 0x5f, 0x91, 0xaa, 0x62, 0x00, 0x1c, 0x3f,
+		0x05, 0x81, 0x0a, 0xfa, 0xd3, 0xe9, 0x2d, 0x1b, 0xd3, 0xf9, 0x17, 0x8b, 0x4a, 0x3c, 0xf8, 0x3d,
+		0x71, 0x8c, 0x47, 0xa3, 0x6d, 0xb1, 0xe9, 0xb4, 0x65, 0xd8, 0xf8, 0x45, 0x78, 0xb6, 0x34, 0xe5,
+		0x7d, 0x73, 0xe9, 0x13, 0xd7, 0x9a, 0x8d, 0x79, 0x41, 0x36, 0x45, 0xda, 0x3e, 0x4f, 0x10, 0xfa,
+		0x5c, 0x6b, 0x30, 0xf7, 0x4c, 0x82, 0x0a, 0x80, 0xff, 0x15, 0x32, 0x1f, 0x8c, 0x02, 0x6c, 0xd5,
+		0xa9, 0x5a, 0x6e, 0x4e, 0xd2, 0x69, 0xbd, 0x18, 0x37, 0x49, 0x94, 0xa9, 0x8a, 0xe9, 0xd5, 0xe2,
+		0x8d, 0x6a, 0xc6, 0x7e, 0x66, 0x22, 0x90, 0x20, 0x1f, 0xfb, 0x19, 0xb9, 0xea, 0x92, 0xd8, 0x4f,
+		0xa1, 0x4d, 0x17, 0x07, 0x95, 0xea, 0xa2, 0x91, 0x52, 0x51, 0x6b, 0xf8, 0x26, 0xcc, 0x3d, 0x30,
+		0x48, 0x97, 0xc4, 0x20, 0xa4, 0xc7, 0x1f, 0x0f, 0x03, 0x20, 0xc5, 0x8f, 0x11, 0x21, 0xc5, 0xf7,
+		0x06, 0x91, 0x12, 0x84, 0xcc, 0x9a, 0xa0, 0xa6, 0xd5, 0x3e, 0x0a, 0xf9, 0x35, 0xce, 0xa7, 0xc5,
+		0x92, 0x1f, 0x03, 0xa3, 0xf4, 0x71, 0x2c, 0xfb, 0xf9, 0x6b, 0x5c, 0xf7, 0xf3, 0xd3, 0xcb, 0xa1,
+		0x99, 0xce, 0x4d, 0x6a, 0x7d, 0x4d, 0xe7, 0x26, 0x11, 0xc3, 0xe8, 0xaf, 0xd8, 0xc4, 0xd6, 0xb4,
+		0xa1, 0xa6, 0x4b, 0x3c, 0x57, 0xaf, 0x3b, 0x28, 0xb2, 0xdb, 0xbf, 0xcb, 0x40, 0xc9, 0x58, 0xb1,
+		0xba, 0x0c, 0xf8, 0x6c, 0xc5, 0x65, 0x75, 0xcb, 0xea, 0xd6, 0x69, 0xe2, 0x0e, 0x6d, 0x5a, 0x0d,
+		0xc0, 0xa6, 0xd5, 0x6a, 0xd9, 0x24, 0x19, 0xdb, 0x58, 0x82, 0x4d, 0x4f, 0xda, 0x53, 0x0b, 0x90,
+		0xf3, 0x30, 0x5a, 0x48, 0x60, 0x8b, 0x7f, 0x81, 0x1b, 0x01, 0xcb, 0x35, 0x30, 0x1d, 0x07, 0xd7,
+		0x51, 0x9d, 0x12, 0x6b, 0xe6, 0x3b, 0xd6, 0xcc, 0x1f, 0x9f, 0x99, 0xcf, 0x0b, 0xd8, 0x67, 0x1f,
+		0xc7, 0x13, 0x7a, 0xea, 0xb3, 0x27, 0xd4, 0x16, 0x87, 0x0d, 0xee, 0x2c, 0x77, 0x46, 0xd2, 0x61,
+		0x4d, 0x60, 0xa3, 0xa5, 0x7d, 0xfc, 0x0f, 0xe6, 0x43, 0xfa, 0xb6, 0x38, 0xbe, 0x07, 0x66, 0x4c,
+		0x20, 0x06, 0xa1, 0xe1, 0x33, 0xf1, 0xf5, 0xc4, 0x28, 0x2e, 0xad, 0x1b, 0xf0, 0x63, 0xd7, 0x91,
+		0xa4, 0x01, 0x34, 0xf6, 0x77, 0x71, 0x31, 0x0b, 0x59, 0xa8, 0x8b, 0x08, 0x77, 0x41, 0x21, 0x2f,
+		0x2a, 0xec, 0xa5, 0x85, 0xbe, 0xb4, 0xf0, 0x17, 0x57, 0x02, 0xa2, 0x61, 0x47, 0xf2, 0x0a, 0x1d,
+		0x03, 0x15, 0x8f, 0x85, 0x8a, 0xc4, 0x44, 0x45, 0x63, 0xa3, 0xd9, 0x87, 0x26, 0x0e, 0x50, 0x34,
+		0x56, 0xda, 0x08, 0x10, 0x88, 0x31, 0x53, 0x65, 0x71, 0x42, 0xf9, 0x78, 0x81, 0x28, 0x38, 0xa5,
+		0x63, 0xaa, 0x0d, 0xd2, 0xf5, 0x8e, 0x9f, 0x74, 0x8d, 0x1a, 0x09, 0xfd, 0x62, 0x12, 0xdd, 0xb6,
+		0x22, 0x5d, 0x94, 0x76, 0x6e, 0xfb, 0xd4, 0x64, 0x1a, 0xdd, 0xfa, 0x6e, 0x8f, 0x45, 0xaa, 0xd1,
+		0x57, 0xfe, 0xd4, 0xa1, 0x47, 0x74, 0x49, 0xb7, 0x62, 0xb1, 0xdc, 0x48, 0x05, 0x5c, 0x8c, 0x65,
+		0xb4, 0xd0, 0x80, 0x0e, 0xb4, 0xa2, 0x35, 0xc6, 0x2c, 0x6b, 0xdb, 0x63, 0x97, 0x94, 0x17, 0xdb,
+		0x60, 0xcd, 0x06, 0x6b, 0x00, 0x00, 0xe5, 0x82, 0x35, 0x7c, 0x52, 0xb8, 0x48, 0x72, 0x38, 0x3b,
+		0x49, 0xcc, 0xe3, 0x2c, 0x71, 0x2f, 0xfe, 0x2b, 0x96, 0xfc, 0x3d, 0xab, 0xbe, 0x5b, 0x4c, 0xf5,
+		0xdd, 0x0a, 0x54, 0xdf, 0xdd, 0xa6, 0xfa, 0xae, 0x55, 0x7d, 0xab, 0xfa, 0x27, 0xa9, 0xfa, 0xee,
+		0x9e, 0x50, 0x0a, 0xcd, 0xdc, 0xd2, 0xf3, 0xdc, 0xbf, 0x03, 0x46, 0xd5, 0x5f, 0xe3, 0xa1, 0xa5,
+		0x35, 0x5c, 0x99, 0x86, 0x42, 0x05, 0xce, 0x3e, 0x05, 0x24, 0x79, 0xa8, 0xe4, 0x90, 0x99, 0xb3,
+		0x37, 0x71, 0x78, 0x0d, 0x6f, 0x9a, 0x90, 0xa4, 0x20, 0xce, 0x2e, 0x2e, 0x2e, 0x7f, 0x10, 0xde,
+		0x79, 0x13, 0xde, 0xb4, 0x96, 0x1e, 0x5e, 0xfe, 0x10, 0x33, 0x78, 0xfb, 0x63, 0xf7, 0xfc, 0x1c,
+		0xbe, 0x5f, 0x3c, 0x5b, 0x4a, 0xde, 0x9d, 0x53, 0x14, 0xa5, 0x08, 0xc8, 0x70, 0xd3, 0x94, 0xce,
+		0xd1, 0x76, 0x9a, 0xc5, 0xdb, 0x29, 0x0b, 0x61, 0x0e, 0xcb, 0x9a, 0x5a, 0xa8, 0x04, 0x83, 0x58,
+		0x99, 0xdd, 0x85, 0xdd, 0x98, 0xc4, 0xed, 0x54, 0xaa, 0x69, 0x9d, 0x42, 0x29, 0x2d, 0x15, 0x81,
+		0x2c, 0x6e, 0x48, 0xc3, 0x92, 0x28, 0xc2, 0x48, 0x05, 0x13, 0xf6, 0x52, 0x02, 0x50, 0x14, 0xd2,
+		0x58, 0x9f, 0x08, 0x6c, 0x21, 0xcd, 0xa1, 0x70, 0x9d, 0x0a, 0x81, 0xcc, 0x64, 0xfb, 0x0c, 0xf2,
+		0x97, 0xcc, 0xd1, 0x70, 0x2f, 0x35, 0x54, 0xe0, 0x8b, 0xaf, 0x1c, 0x52, 0xf3, 0x97, 0xc0, 0xa3,
+		0x2f, 0x2e, 0x2e, 0x85, 0x17, 0xff, 0xc9, 0x5b, 0xc9, 0x93, 0xd8, 0xe8, 0x6d, 0x3c, 0x71, 0x93,
+		0x27, 0x6f, 0x5e, 0x54, 0x96, 0xe8, 0x80, 0xca, 0xfd, 0xc8, 0x52, 0x9d, 0xf4, 0xae, 0x4b, 0x40,
+		0x0f, 0xe2, 0x2c, 0x77, 0x24, 0x8c, 0x93, 0x04, 0xe7, 0xec, 0x5e, 0xa2, 0xaa, 0xbd, 0x80, 0x03,
+		0x77, 0x76, 0x93, 0xff, 0xf6, 0x9d, 0x7d, 0x6e, 0x0e, 0xd9, 0x09, 0xea, 0xab, 0x79, 0x6b, 0x08,
+		0x2d, 0x6e, 0x2c, 0xb7, 0x5b, 0xa4, 0x4a, 0x04, 0x6f, 0xb7, 0x1c, 0x80, 0xb7, 0x5b, 0x16, 0xbf,
+		0xdb, 0xc3, 0xe1, 0x77, 0x7b, 0x05, 0xf0, 0xbb, 0x09, 0xd4, 0xc0, 0xc2, 0x76, 0x8f, 0x00, 0xb6,
+		0x8b, 0x4a, 0x12, 0xcf, 0xc9, 0x89, 0x48, 0xe1, 0xae, 0xcb, 0x45, 0xd4, 0x05, 0x54, 0x7a, 0xab,
+		0x50, 0x0f, 0x5a, 0x80, 0xaa, 0xa0, 0x5a, 0x84, 0x61, 0x05, 0x42, 0x45, 0x17, 0x2e, 0x9c, 0x0b,
+		0xb5, 0x08, 0x43, 0x8b, 0x30, 0xac, 0xa4, 0x74, 0x68, 0x11, 0x86, 0xf4, 0xfe, 0x19, 0x3c, 0x71,
+		0x50, 0x69, 0xff, 0x65, 0x63, 0xee, 0xd2, 0x8d, 0xb9, 0xbb, 0x62, 0xcc, 0x93, 0x6d, 0xaa, 0xd6,
+		0x98, 0x5b, 0x63, 0x7e, 0x8a, 0x67, 0x55, 0xb9, 0xd6, 0x92, 0x97, 0x05, 0xce, 0x58, 0x4b, 0xbe,
+		0xed, 0x13, 0x97, 0xaf, 0x7a, 0x8c, 0xb6, 0xff, 0xa7, 0x47, 0xc5, 0x8a, 0x4b, 0x95, 0xd4, 0x6b,
+		0x17, 0x48, 0x5b, 0x1b, 0xa7, 0x5b, 0xd3, 0x9e, 0x45, 0x69, 0x1b, 0xa7, 0x03, 0xd8, 0x38, 0xdd,
+		0x5a, 0x77, 0x8c, 0x75, 0x2f, 0x92, 0x43, 0x8d, 0x93, 0x25, 0x19, 0x71, 0xf9, 0x2b, 0x4a, 0xa5,
+		0xbe, 0xe4, 0xc1, 0x3b, 0xb3, 0x92, 0x7a, 0x8f, 0xc1, 0x4a, 0x8d, 0x7c, 0x7b, 0xe5, 0x7c, 0xb3,
+		0x70, 0x9e, 0x78, 0xdb, 0x7d, 0x1e, 0xc8, 0x53, 0xa6, 0x46, 0xfe, 0x7a, 0xce, 0xe5, 0x29, 0x51,
+		0x03, 0x3f, 0x80, 0xe3, 0x79, 0x12, 0xa9, 0xa1, 0x95, 0xb7, 0x8f, 0xff, 0x84, 0x9e, 0xd5, 0x59,
+		0x1f, 0xcd, 0x21, 0x3d, 0xe9, 0xb0, 0x73, 0x8a, 0xa4, 0x3d, 0x96, 0x56, 0x48, 0x37, 0xca, 0xa1,
+		0xf4, 0xea, 0xf3, 0xf1, 0x1f, 0xdf, 0x53, 0x25, 0xcd, 0x4e, 0xf6, 0x60, 0x9f, 0x5e, 0xb9, 0xb2,
+		0x60, 0x0f, 0x5d, 0x16, 0x6c, 0x64, 0xcc, 0xcc, 0xe9, 0x87, 0xe3, 0x88, 0xab, 0xdc, 0xdb, 0xea,
+		0x76, 0x73, 0xca, 0x86, 0x0b, 0x30, 0x24, 0xb6, 0x76, 0xe8, 0x79, 0xc0, 0x60, 0xa8, 0xc2, 0xc8,
+		0xba, 0xab, 0x11, 0x48, 0xfe, 0x2d, 0x5e, 0x87, 0x6a, 0x30, 0x0a, 0xb2, 0xdf, 0x64, 0x8b, 0x89,
+		0x65, 0xd6, 0x91, 0x15, 0x17, 0x13, 0xb3, 0x8f, 0x85, 0xda, 0xb4, 0xb1, 0x39, 0x55, 0x70, 0xa8,
+		0xe4, 0x98, 0x28, 0xa4, 0xa8, 0xa0, 0x45, 0x86, 0x22, 0x3a, 0x44, 0x11, 0x2a, 0xea, 0x0a, 0x5e,
+		0x7f, 0xaa, 0x22, 0x0f, 0x63, 0x42, 0x5b, 0x20, 0xd1, 0x17, 0x4a, 0x95, 0x2c, 0x98, 0x68, 0x0b,
+		0xa7, 0x7c, 0xc2, 0xe5, 0x1d, 0x36, 0xd1, 0xa3, 0xa9, 0x62, 0xaf, 0x84, 0x2a, 0x66, 0x22, 0x3e,
+		0xac, 0x06, 0xbe, 0x1e, 0x0d, 0xcc, 0xcb, 0x50, 0xbc, 0x66, 0x45, 0x3c, 0xe2, 0xcd, 0x03, 0xf5,
+		0x67, 0x3a, 0x76, 0x58, 0x09, 0xbb, 0x3b, 0xa0, 0xbc, 0xaa, 0xef, 0x56, 0xfb, 0x13, 0xd9, 0x1d,
+		0x50, 0x24, 0x73, 0x52, 0x35, 0xef, 0x0f, 0x6f, 0x63, 0x40, 0x81, 0xcc, 0xca, 0x1e, 0x78, 0x5d,
+		0x7a, 0x4f, 0xc0, 0xfe, 0x33, 0x2f, 0xf5, 0x88, 0xca, 0x21, 0xe1, 0xfe, 0xf7, 0x9e, 0x99, 0x29,
+		0x20, 0x68, 0x87, 0x59, 0xaf, 0xc9, 0xc9, 0x9b, 0xdc, 0x35, 0x1b, 0xd4, 0x18, 0x20, 0xdb, 0xe7,
+		0x23, 0xd2, 0x10, 0x52, 0x99, 0xb3, 0xe4, 0x04, 0xd2, 0x2f, 0x9a, 0x9b, 0x56, 0x7a, 0xa5, 0x94,
+		0x3e, 0x1b, 0x29, 0xdf, 0x57, 0xdf, 0x84, 0x1c, 0xb7, 0xb4, 0x18, 0xf8, 0x42, 0x8e, 0x6f, 0xe6,
+		0x07, 0x95, 0xa6, 0xd7, 0xec, 0x34, 0x61, 0xf6, 0xd7, 0xf9, 0x5d, 0x96, 0x4f, 0x27, 0xf9, 0xf0,
+		0xf9, 0xb8, 0x1e, 0x98, 0x2f, 0xbc, 0x38, 0x0b, 0x06, 0x23, 0x26, 0x7c, 0x0d, 0x62, 0x34, 0xff,
+		0x3d, 0x10, 0x1a, 0xa4, 0x32, 0x10, 0x4a, 0xf1, 0x57, 0xc8, 0x67, 0xc7, 0xd3, 0xe5, 0x1e, 0x64,
+		0x4d, 0xd5, 0xca, 0xe2, 0xce, 0xba, 0x90, 0xce, 0xad, 0xe8, 0x58, 0xe1, 0xe9, 0xd7, 0xb0, 0xfa,
+		0xa3, 0x78, 0xdd, 0x95, 0x35, 0xa0, 0xcb, 0x30, 0xf7, 0x07, 0x14, 0x65, 0x09, 0xd5, 0x87, 0x96,
+		0x67, 0xca, 0xf2, 0x9c, 0xea, 0xa2, 0x33, 0xd6, 0xe3, 0x6d, 0xdc, 0x7a, 0x15, 0x0f, 0x2b, 0x96,
+		0x8b, 0x01, 0x87, 0x80, 0x4f, 0x39, 0x8b, 0x4c, 0x72, 0xdd, 0x7a, 0x41, 0xf5, 0x4e, 0xe5, 0x99,
+		0x80, 0x9d, 0x6f, 0xcd, 0x96, 0xfb, 0x6e, 0x73, 0xd8, 0xd1, 0x5a, 0x5d, 0x47, 0x97, 0xf5, 0x95,
+		0xb1, 0xc7, 0xb7, 0x6a, 0x0a, 0x3e, 0x7f, 0xe0, 0x3e, 0x08, 0x0d, 0xc9, 0x0b, 0x4d, 0xf5, 0x79,
+		0xe2, 0x64, 0x98, 0x75, 0x66, 0x8a, 0xb7, 0xce, 0xa3, 0x3c, 0xd5, 0xb3, 0xeb, 0x0c, 0xd9, 0x95,
+		0x93, 0xbc, 0x8a, 0xc9, 0x16, 0x7a, 0x64, 0xd7, 0x48, 0x9c, 0xc6, 0xf6, 0xd1, 0x2e, 0x8d, 0xcb,
+		0x89, 0x5f, 0x3d, 0x68, 0xc5, 0xcb, 0xa8, 0x8d, 0x51, 0x2d, 0xaf, 0xac, 0x17, 0xad, 0x9a, 0x8d,
+		0x0c, 0xf7, 0xe9, 0xf4, 0x41, 0xf3, 0xa1, 0x92, 0x1e, 0x98, 0x39, 0x85, 0xe7, 0xf7, 0x40, 0x42,
+		0x0b, 0xe2, 0x55, 0x9c, 0x92, 0x71, 0x58, 0x96, 0x2c, 0xde, 0x16, 0x88, 0x3c, 0x7d, 0x01, 0x3f,
+		0x79, 0xc2, 0x80, 0x7e, 0x9a, 0x0c, 0x94, 0x0f, 0xfa, 0x5e, 0x85, 0xbe, 0x37, 0x53, 0xa1, 0x07,
+		0x11, 0x39, 0xfa, 0x8d, 0x5f, 0xdf, 0x9e, 0x65, 0x5b, 0x64, 0xd5, 0xd6, 0x50, 0xab, 0x59, 0x59,
+		0xb4, 0xec, 0xfb, 0xeb, 0xf2, 0x24, 0x19, 0x9d, 0x14, 0x43, 0xcb, 0x6d, 0xee, 0xfd, 0x73, 0xd9,
+		0x55, 0xad, 0x5d, 0x05, 0x0c, 0x27, 0xbd, 0x13, 0x31, 0x7f, 0x43, 0x5c, 0xd2, 0x0e, 0x59, 0xd5,
+		0x5a, 0x67, 0x66, 0x4e, 0x0d, 0xab, 0x9d, 0x57, 0xc3, 0x72, 0x2b, 0xa9, 0x61, 0x1d, 0x63, 0x09,
+		0xab, 0xaa, 0x0a, 0x56, 0x2e, 0xe2, 0x11, 0x7f, 0x91, 0x20, 0xe2, 0x02, 0x41, 0x24, 0x6a, 0x1d,
+		0x97, 0x1e, 0xc5, 0xa7, 0xa2, 0x17, 0x50, 0xec, 0x76, 0x1b, 0x7b, 0x28, 0x52, 0x51, 0x9c, 0x1e,
+		0x1d, 0x9f, 0xf7, 0x8c, 0xcb, 0xed, 0xd2, 0xa7, 0xeb, 0x1e, 0xe6, 0x74, 0xab, 0x05, 0x20, 0xa0,
+		0x77, 0xf7, 0xba, 0x03, 0xdc, 0xf6, 0x5e, 0x77, 0x80, 0xb7, 0x66, 0xc9, 0xb5, 0xf7, 0x51, 0x2f,
+		0x50, 0xa3, 0xd4, 0xb8, 0x09, 0xc3, 0x27, 0xa5, 0x77, 0xfa, 0x5a, 0xc3, 0x56, 0xd6, 0xb0, 0xe5,
+		0x5f, 0xcf, 0x13, 0x57, 0xc8, 0xf1, 0x37, 0xf4, 0x60, 0x0a, 0xea, 0xeb, 0xb7, 0xf2, 0xde, 0xa7,
+		0xc2, 0x41, 0xa9, 0xc6, 0xdb, 0x5b, 0x79, 0x8b, 0xcb, 0x12, 0x59, 0xa6, 0x90, 0x46, 0xc9, 0xee,
+		0x08, 0x2b, 0x0c, 0x90, 0x6f, 0xdb, 0x3d, 0x03, 0xeb, 0x24, 0x71, 0x7b, 0x3d, 0xbb, 0x69, 0x00,
+		0xd9, 0x3f, 0x6f, 0x4b, 0xd8, 0xd5, 0x90, 0xb6, 0x25, 0xec, 0x6a, 0x48, 0xc6, 0x74, 0xa4, 0x15,
+		0x81, 0xf9, 0xa2, 0x05, 0x84, 0x04, 0x93, 0x5a, 0xf6, 0xaa, 0x6d, 0xba, 0x6b, 0x6d, 0xfa, 0xd1,
+		0xda, 0x74, 0xbb, 0x15, 0xec, 0xc4, 0xcd, 0xba, 0xdd, 0x0a, 0x86, 0xb6, 0xea, 0x85, 0x8e, 0xd3,
+		0xca, 0xba, 0xf9, 0xd4, 0x9e, 0xa8, 0xb5, 0x9a, 0x08, 0x4e, 0xf3, 0xb0, 0x97, 0x99, 0x2b, 0x5a,
+		0xc8, 0xcb, 0x0e, 0xbf, 0xff, 0x23, 0x7a, 0x49, 0x72, 0x3d, 0xee, 0xfb, 0x8a, 0x70, 0xf4, 0xa5,
+		0xf2, 0xdb, 0xdb, 0xd3, 0xcb, 0xc8, 0x79, 0x60, 0x52, 0xdd, 0x51, 0x8c, 0xd0, 0x37, 0xb7, 0x6a,
+		0xfa, 0x31, 0xca, 0x4a, 0xef, 0x4e, 0x76, 0xaf, 0xb5, 0xcb, 0x4b, 0x77, 0x47, 0xcd, 0x81, 0x99,
+		0x38, 0x6e, 0x58, 0xe4, 0xbc, 0xcf, 0xa4, 0x32, 0x10, 0xf0, 0xa1, 0x9a, 0x4c, 0xb8, 0xf4, 0xb8,
+		0x07, 0x83, 0xd0, 0xcc, 0x21, 0x09, 0x3a, 0x9c, 0x4e, 0x55, 0x60, 0xb8, 0x77, 0xbe, 0x23, 0x9d,
+		0xdd, 0xde, 0x95, 0xce, 0x6e, 0x9f, 0x6c, 0x3a, 0x7b, 0xa7, 0xd3, 0xce, 0x77, 0xd2, 0x59, 0x4e,
+		0x39, 0x3a, 0xd4, 0xda, 0xf0, 0x40, 0xee, 0xf4, 0xc2, 0xce, 0x97, 0x7e, 0xeb, 0x3f, 0x77, 0x7f,
+		0x5f, 0x3d, 0xb7, 0xbe, 0xb4, 0x5b, 0xdf, 0xdd, 0xfd, 0xc3, 0xc9, 0x2b, 0xfa, 0x34, 0x56, 0xff,
+		0x4a, 0xe7, 0xb1, 0x4b, 0x39, 0x1c, 0xa1, 0x7f, 0x66, 0x5f, 0xf9, 0x67, 0xa5, 0x36, 0xa9, 0xb7,
+		0xae, 0x30, 0x4e, 0xb3, 0xb1, 0x43, 0x25, 0x3e, 0xf0, 0x07, 0x31, 0x4c, 0x95, 0xe0, 0xb9, 0xf1,
+		0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x28, 0xed, 0xc2, 0x7a, 0xa7, 0xd3, 0x00,
+		0x00,
 	}
 )
 
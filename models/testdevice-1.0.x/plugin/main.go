@@ -10,8 +10,8 @@ package main
 import (
 	"context"
 	"github.com/onosproject/config-models/models/testdevice-1.0.x/api"
-    "github.com/onosproject/config-models/pkg/path"
-	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/config-models/pkg/validation"
 	"github.com/onosproject/onos-api/go/onos/config/admin"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
@@ -30,14 +30,11 @@ type modelPlugin struct {
 type server struct {
 }
 
-// gRPC path lists; derived from native path maps
-var roPaths []*admin.ReadOnlyPath
-var rwPaths []*admin.ReadWritePath
-
 func (p *modelPlugin) Register(gs *grpc.Server) {
 	log.Info("Registering model plugin service")
 	server := &server{}
 	admin.RegisterModelPluginServiceServer(gs, server)
+	validation.RegisterDeltaValidationServiceServer(gs, server)
 }
 
 func main() {
@@ -55,12 +52,6 @@ func main() {
 	}
 	port := int16(i)
 
-	entries, err := api.UnzipSchema()
-	if err != nil {
-		log.Fatalf("Unable to extract model schema: %+v", err)
-	}
-	roPaths, rwPaths = path.ExtractPaths(entries)
-
 	// Start gRPC server
 	log.Info("Starting model plugin")
 	p := modelPlugin{}
@@ -100,8 +91,8 @@ func (s server) GetModelInfo(ctx context.Context, request *admin.ModelInfoReques
 			ModelData:          api.ModelData(),
 			SupportedEncodings: api.Encodings(),
 			GetStateMode:       0,
-			ReadOnlyPath:       roPaths,
-			ReadWritePath:      rwPaths,
+			ReadOnlyPath:       api.ReadOnlyPaths(),
+			ReadWritePath:      api.ReadWritePaths(),
 		},
 	}, nil
 }
@@ -113,16 +104,34 @@ func (s server) ValidateConfig(ctx context.Context, request *admin.ValidateConfi
 		return nil, errors.Status(err).Err()
 	}
 
-	if err := s.validate(gostruct); err != nil {
+	violations, err := s.validate(*gostruct)
+	if err != nil {
 		return nil, errors.Status(err).Err()
 	}
-
-	if err := s.validateMust(*gostruct); err != nil {
-		return nil, errors.Status(err).Err()
+	if len(violations) > 0 {
+		// All the violations are returned in the details of the status
+		return nil, validation.Status(violations).Err()
 	}
 	return &admin.ValidateConfigResponse{Valid: true}, nil
 }
 
+func (s server) ValidateConfigDelta(ctx context.Context, request *validation.ValidateConfigDeltaRequest) (*validation.ValidateConfigDeltaResponse, error) {
+	log.Infof("Received validate config delta request: %s", request.String())
+	schema, err := api.Schema()
+	if err != nil {
+		return nil, errors.Status(errors.NewInvalid("Unable to get schema: %+v", err)).Err()
+	}
+	violations, err := validation.ValidateDelta(schema, request.Json, request.Changes)
+	if err != nil {
+		return nil, errors.Status(errors.NewInvalid("Unable to validate model testdevice-1.0.x: %+v", err)).Err()
+	}
+	if len(violations) > 0 {
+		// All the violations are returned in the details of the status
+		return nil, validation.Status(violations).Err()
+	}
+	return &validation.ValidateConfigDeltaResponse{Valid: true}, nil
+}
+
 func (s server) GetPathValues(ctx context.Context, request *admin.PathValuesRequest) (*admin.PathValuesResponse, error) {
 	log.Infof("Received path values request: %+v", request)
 	pathValues, err := path.GetPathValues(request.PathPrefix, request.Json)
@@ -141,27 +150,16 @@ func (s server) unmarshallConfigValues(jsonTree []byte) (*ygot.ValidatedGoStruct
 	return &vgs, nil
 }
 
-func (s server) validate(ygotModel *ygot.ValidatedGoStruct, opts ...ygot.ValidationOption) error {
-	deviceDeref := *ygotModel
-	device, ok := deviceDeref.(*api.Device)
-	if !ok {
-		return errors.NewInvalid("Unable to convert model testdevice-1.0.x")
-	}
-	return device.Validate()
-}
-
-func (s server) validateMust(device ygot.ValidatedGoStruct) error {
-	log.Infof("Received validateMust request for device: %v", device)
+// validate returns all the schema and must violations of the device
+func (s server) validate(device ygot.ValidatedGoStruct) ([]*validation.Violation, error) {
+	log.Infof("Received validate request for device: %v", device)
 	schema, err := api.Schema()
 	if err != nil {
-		return errors.NewInvalid("Unable to get schema: %+v", err)
+		return nil, errors.NewInvalid("Unable to get schema: %+v", err)
 	}
-
-	nn := navigator.NewYangNodeNavigator(schema.RootSchema(), device, true)
-	ynn, ok := nn.(*navigator.YangNodeNavigator)
-	if !ok {
-		return errors.NewInvalid("Cannot cast NodeNavigator to YangNodeNavigator")
+	violations, err := validation.Validate(schema.RootSchema(), device)
+	if err != nil {
+		return nil, errors.NewInvalid("Unable to validate model testdevice-1.0.x: %+v", err)
 	}
-	return ynn.WalkAndValidateMust()
+	return violations, nil
 }
-
@@ -5,13 +5,13 @@ of structs which represent a YANG schema. The generated schema can be
 compressed by a series of transformations (compression was false
 in this case).
 
-This package was generated by /go/pkg/mod/github.com/openconfig/ygot@v0.22.1/genutil/names.go
+This package was generated by model-compiler
 using the following YANG input files:
 	- onf-test1-augmented@2020-02-29.yang
 	- onf-test1-identities@2020-09-01.yang
 	- onf-test1@2019-06-10.yang
 Imported modules were sourced from:
-	- /config-model/yang/...
+	- yang/...
 */
 package api
 
@@ -567,172 +567,175 @@ var (
 	// fields within the struct.
 	ySchema = []byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x5f, 0x6f, 0xdb, 0x38,
-		0x12, 0x7f, 0xef, 0xa7, 0x18, 0xf8, 0x25, 0xdb, 0x43, 0xdd, 0xc8, 0xb2, 0x93, 0xa6, 0x01, 0xee,
-		0xc1, 0x69, 0x52, 0x5c, 0xb1, 0xed, 0x6e, 0xd1, 0x06, 0x7b, 0xd8, 0xeb, 0x05, 0x07, 0x5a, 0x1a,
-		0xdb, 0x44, 0x65, 0xd2, 0x47, 0x52, 0x6e, 0x7c, 0x8b, 0x7c, 0xf7, 0x03, 0x25, 0xd9, 0xf1, 0x5f,
-		0x89, 0x94, 0x64, 0xc7, 0x6e, 0xb8, 0x2f, 0xdb, 0xd8, 0xa4, 0x2c, 0x0e, 0x67, 0x7e, 0xf3, 0x23,
-		0x67, 0x86, 0xfc, 0xeb, 0x05, 0x00, 0x40, 0xe3, 0x37, 0x32, 0xc2, 0xc6, 0x25, 0x34, 0x42, 0x9c,
-		0xd0, 0x00, 0x1b, 0xaf, 0xd2, 0x4f, 0x7f, 0xa5, 0x2c, 0x6c, 0x5c, 0x42, 0x2b, 0xfb, 0xf3, 0x1d,
-		0x67, 0x7d, 0x3a, 0x68, 0x5c, 0x82, 0x97, 0x7d, 0x70, 0x4d, 0x45, 0xe3, 0x12, 0xd2, 0x47, 0x24,
-		0x1f, 0x04, 0x9c, 0xa9, 0x16, 0x59, 0xfa, 0x6c, 0xe9, 0xf1, 0xd9, 0xf7, 0xaf, 0x96, 0xbf, 0xbd,
-		0x46, 0x19, 0x08, 0x3a, 0x56, 0x94, 0x33, 0xdd, 0xe8, 0x76, 0x88, 0xa0, 0xf8, 0x18, 0x22, 0x9c,
-		0x60, 0x04, 0xba, 0x0b, 0xa1, 0x0c, 0xc5, 0x6a, 0xaf, 0xe5, 0x97, 0x9b, 0x7f, 0xbc, 0xfa, 0x92,
-		0xf3, 0x2f, 0x3e, 0x0b, 0xec, 0xd3, 0xfb, 0xb5, 0x77, 0x5b, 0x7a, 0x3f, 0xd5, 0x5a, 0xf9, 0x95,
-		0xe4, 0xdb, 0xaf, 0x3c, 0x16, 0x01, 0x6e, 0xec, 0x99, 0xbe, 0x09, 0x4e, 0x7f, 0x70, 0xa1, 0x5f,
-		0xa6, 0x31, 0x4e, 0x7f, 0xe4, 0xd5, 0xe6, 0x86, 0xff, 0x20, 0xb2, 0x2b, 0x06, 0xf1, 0x08, 0x99,
-		0x6a, 0x5c, 0x82, 0x12, 0x31, 0x6e, 0x69, 0xb8, 0xd0, 0x4a, 0xbf, 0xd3, 0x5a, 0xa3, 0x87, 0xa5,
-		0x4f, 0x1e, 0x56, 0xe5, 0xb9, 0x32, 0x2d, 0x4b, 0xd3, 0xe3, 0x93, 0xed, 0x03, 0x59, 0x9c, 0x26,
-		0x9f, 0x6c, 0x1b, 0xc5, 0x86, 0xe9, 0xf2, 0x59, 0x58, 0x30, 0x5d, 0x05, 0xd3, 0x56, 0x38, 0x7d,
-		0x26, 0xd3, 0x68, 0x36, 0x9d, 0xa6, 0xd3, 0x6a, 0x3d, 0xbd, 0xd6, 0xd3, 0x6c, 0x3c, 0xdd, 0x9b,
-		0xa7, 0x7d, 0xcb, 0xf4, 0x17, 0xaa, 0xc1, 0xbc, 0x41, 0x84, 0xa4, 0x9f, 0xa3, 0x0e, 0x6b, 0xe2,
-		0xcc, 0xda, 0x17, 0x0c, 0x66, 0x45, 0x3d, 0x7e, 0x8b, 0x47, 0x28, 0x68, 0x00, 0xba, 0x33, 0x50,
-		0x26, 0x69, 0x88, 0xf0, 0x6e, 0xa6, 0x24, 0x60, 0xf2, 0xb8, 0x3e, 0x89, 0x23, 0x2d, 0x9a, 0x6f,
-		0xb9, 0x0d, 0x93, 0xc6, 0x7e, 0x23, 0xb7, 0xcd, 0x5d, 0xc1, 0x6f, 0x65, 0xba, 0xe9, 0x15, 0x34,
-		0x2b, 0xd2, 0x51, 0x1b, 0x5d, 0xb5, 0xd3, 0x59, 0x5b, 0xdd, 0x2d, 0xad, 0xc3, 0xa5, 0x75, 0xd9,
-		0x5a, 0xa7, 0xf3, 0x75, 0xbb, 0x40, 0xc7, 0xe7, 0xbf, 0x76, 0x3b, 0x1d, 0xa3, 0x9d, 0x9c, 0x63,
-		0xca, 0xd4, 0x85, 0x89, 0xa8, 0x33, 0xa5, 0x38, 0x33, 0x68, 0xfa, 0x85, 0xb0, 0x01, 0x1a, 0x69,
-		0xaa, 0xfe, 0xcf, 0x6c, 0xea, 0x92, 0x07, 0x7f, 0xa2, 0xcc, 0x78, 0xae, 0xe7, 0x9d, 0xfe, 0x20,
-		0x51, 0x8c, 0xdb, 0xa1, 0x76, 0x6b, 0xbf, 0xf7, 0x82, 0x04, 0xda, 0x7a, 0xaf, 0xe9, 0x80, 0x2a,
-		0x59, 0xac, 0xe6, 0xeb, 0x22, 0xc6, 0x01, 0x51, 0x74, 0xa2, 0x7f, 0xbb, 0x4f, 0x22, 0x89, 0xc6,
-		0xbd, 0x1f, 0x5e, 0x59, 0x88, 0x84, 0xdc, 0x97, 0x17, 0x49, 0xfb, 0x78, 0x44, 0xf2, 0xa2, 0x46,
-		0xc1, 0xed, 0x4d, 0xe3, 0x9c, 0xca, 0xad, 0xcb, 0xe4, 0xa7, 0xd3, 0xb9, 0xc2, 0x56, 0x77, 0x95,
-		0x20, 0x1d, 0xef, 0x95, 0x20, 0xcd, 0x98, 0x49, 0x45, 0x7a, 0x91, 0x21, 0xb8, 0x0b, 0xec, 0xa3,
-		0x40, 0x16, 0xec, 0x04, 0x84, 0x67, 0x9e, 0xe3, 0xcb, 0xfb, 0x77, 0x70, 0xee, 0x75, 0xbc, 0x86,
-		0x85, 0xea, 0x58, 0xfa, 0xeb, 0x4d, 0x7e, 0xfb, 0x71, 0x6c, 0x96, 0x7a, 0x50, 0xd6, 0x85, 0x6f,
-		0x74, 0xe5, 0xf3, 0xc1, 0x1f, 0x9a, 0x36, 0xbd, 0x28, 0xa1, 0x67, 0x29, 0xa3, 0xed, 0x59, 0x32,
-		0xe0, 0x9e, 0x25, 0x03, 0xfe, 0x83, 0x47, 0x8a, 0x0c, 0xb0, 0x34, 0x03, 0x76, 0xac, 0xf4, 0x58,
-		0x59, 0xe9, 0x27, 0xc2, 0x42, 0xa2, 0xb8, 0x98, 0x16, 0xb3, 0xb0, 0x12, 0x0c, 0x36, 0xc4, 0x80,
-		0x8e, 0x48, 0x74, 0xde, 0xb1, 0x60, 0xb1, 0x2d, 0xdf, 0xa0, 0xed, 0x9a, 0xe7, 0x69, 0x3f, 0x5f,
-		0xee, 0xdb, 0x2e, 0xef, 0x74, 0xb5, 0x5a, 0x1e, 0x1e, 0x0f, 0xf1, 0x3d, 0xcf, 0x7b, 0x42, 0xa1,
-		0x1c, 0x24, 0x13, 0x29, 0xef, 0x3b, 0x02, 0x4b, 0xdf, 0x11, 0x58, 0xfa, 0x8e, 0x2f, 0x48, 0x42,
-		0xe0, 0x2c, 0x9a, 0xee, 0xcd, 0x7b, 0xf8, 0xce, 0x7b, 0x1c, 0xed, 0x9e, 0x86, 0x54, 0x82, 0xb2,
-		0x81, 0x8d, 0x3b, 0xb8, 0xd8, 0x95, 0x61, 0x84, 0x96, 0x86, 0x11, 0x5a, 0x1a, 0x46, 0x97, 0x71,
-		0x35, 0x44, 0x01, 0x99, 0x13, 0x74, 0xbc, 0xca, 0x59, 0x86, 0xe3, 0x4a, 0xc7, 0xcb, 0x95, 0x0e,
-		0x74, 0xd3, 0xc6, 0x91, 0xa5, 0x3a, 0x7d, 0x02, 0x5a, 0xfa, 0x04, 0xb4, 0xf4, 0x09, 0x09, 0x45,
-		0x8a, 0xa8, 0x54, 0xce, 0x1b, 0x38, 0x6f, 0x90, 0x27, 0x67, 0xca, 0x54, 0xeb, 0xdc, 0xc2, 0x13,
-		0xf8, 0xc7, 0x8b, 0xe9, 0xd5, 0xf1, 0xcb, 0xfb, 0x09, 0x57, 0xc0, 0x6e, 0x2b, 0xde, 0xca, 0xc2,
-		0x3e, 0x52, 0xa9, 0xba, 0x4a, 0x09, 0x33, 0x2b, 0xfb, 0x44, 0xd9, 0x4d, 0x84, 0xda, 0xfe, 0x0d,
-		0x45, 0xa5, 0xa7, 0x73, 0xa1, 0x47, 0xeb, 0xa2, 0xd3, 0x39, 0x7f, 0xd3, 0xe9, 0x78, 0x6f, 0xda,
-		0x6f, 0xbc, 0xb7, 0x67, 0x67, 0xad, 0xf3, 0x96, 0x49, 0xf0, 0xf5, 0x77, 0x11, 0xa2, 0xc0, 0xf0,
-		0x6a, 0xda, 0xb8, 0x04, 0x16, 0x47, 0xd1, 0xae, 0xbc, 0x58, 0xdf, 0xd2, 0x8b, 0xf5, 0x2d, 0xbd,
-		0x58, 0x8f, 0x32, 0x22, 0xa6, 0x6e, 0xb7, 0xd8, 0xf9, 0xb1, 0x42, 0x39, 0xa7, 0xaa, 0x62, 0xe1,
-		0xc8, 0xde, 0x1a, 0x34, 0xfd, 0x88, 0x6c, 0xa0, 0x86, 0x07, 0xe7, 0xc9, 0x7c, 0xcf, 0xc5, 0x94,
-		0x8f, 0x59, 0x26, 0x87, 0xbe, 0x38, 0x19, 0x58, 0xc2, 0xfa, 0xc0, 0x12, 0xd6, 0xaf, 0x38, 0x8f,
-		0x90, 0x30, 0x87, 0xeb, 0x0e, 0xd7, 0x8b, 0x71, 0x3d, 0xd5, 0x15, 0x9b, 0xbd, 0xaa, 0x56, 0x59,
-		0xbb, 0xb0, 0x4a, 0x29, 0xb5, 0xc8, 0xc9, 0x68, 0x8c, 0x62, 0x59, 0x9c, 0xb5, 0x69, 0xbd, 0xb9,
-		0xfd, 0x4b, 0x6a, 0x7c, 0x2f, 0xe1, 0xef, 0x70, 0xa2, 0x67, 0xfc, 0x04, 0xb8, 0x00, 0x16, 0x8f,
-		0x7a, 0x28, 0x7e, 0x79, 0x7d, 0x9a, 0x66, 0xa8, 0xbe, 0x84, 0x7f, 0xc7, 0x9e, 0xd7, 0x0e, 0xc0,
-		0x68, 0xb7, 0xef, 0x46, 0x08, 0x2e, 0x3e, 0xa1, 0x94, 0x64, 0x60, 0xa1, 0xb4, 0xb3, 0xb7, 0xfa,
-		0xd0, 0x87, 0x8f, 0xda, 0xa2, 0x7d, 0x02, 0x54, 0x02, 0xe9, 0xf1, 0x09, 0x42, 0x07, 0xd4, 0x10,
-		0x53, 0x4b, 0xf7, 0x07, 0xa0, 0xe5, 0x00, 0x3d, 0x4c, 0xf4, 0x13, 0xfa, 0x5c, 0xe8, 0x2f, 0x61,
-		0x42, 0x22, 0x1a, 0x12, 0x8d, 0x0d, 0xa0, 0x38, 0x8c, 0x89, 0x94, 0xa6, 0x4a, 0x5f, 0x22, 0x93,
-		0x64, 0xd1, 0xc2, 0x50, 0x8f, 0xb6, 0x39, 0xca, 0x86, 0x6b, 0xe1, 0xa8, 0xaa, 0x64, 0x91, 0x2c,
-		0x19, 0x5c, 0x7d, 0x12, 0xab, 0xc9, 0xa5, 0x3c, 0x94, 0x34, 0x9d, 0x3b, 0x2b, 0xd3, 0xe9, 0x32,
-		0xc6, 0x15, 0xc9, 0xbc, 0x41, 0x8e, 0xd9, 0xc8, 0x60, 0x88, 0x23, 0x32, 0x26, 0x09, 0x05, 0x6b,
-		0x9c, 0x72, 0xd6, 0x6f, 0x2a, 0x94, 0xaa, 0x75, 0x9a, 0xd6, 0x4e, 0x9c, 0xe6, 0xe6, 0xe6, 0xa7,
-		0x4f, 0x50, 0x22, 0x0e, 0x14, 0xcb, 0x14, 0xf4, 0x77, 0xd6, 0xbf, 0xd5, 0xfd, 0xff, 0xa3, 0x9d,
-		0x4d, 0xab, 0x9b, 0xfc, 0xcf, 0xef, 0x6e, 0x96, 0xdd, 0xfa, 0x58, 0x37, 0x8c, 0x26, 0xad, 0x0e,
-		0x08, 0x0d, 0xab, 0x08, 0x42, 0xc3, 0x2a, 0x82, 0x05, 0x57, 0x18, 0x1e, 0x46, 0xe9, 0x00, 0x39,
-		0xc6, 0xda, 0x01, 0xb2, 0xdf, 0xe2, 0x81, 0xb0, 0x6d, 0x1b, 0x00, 0xd7, 0x3d, 0xec, 0x88, 0x53,
-		0x8a, 0xfb, 0x29, 0x6f, 0xe2, 0x6c, 0x91, 0x33, 0x85, 0x40, 0xe2, 0x81, 0x1e, 0x3b, 0x86, 0x1a,
-		0x13, 0x36, 0x16, 0x17, 0x1d, 0x28, 0x8f, 0x22, 0x3f, 0x23, 0x91, 0x22, 0x2e, 0x22, 0xbe, 0x05,
-		0x91, 0x19, 0x09, 0xbe, 0x9b, 0x1b, 0x4a, 0xda, 0xdc, 0xda, 0x4a, 0x08, 0x1d, 0x0c, 0x15, 0xf4,
-		0x05, 0x1f, 0xc1, 0x97, 0xf7, 0xef, 0x9a, 0xe7, 0x9e, 0xef, 0x19, 0x1a, 0xc3, 0x99, 0x33, 0x86,
-		0xe3, 0x33, 0x86, 0x22, 0x84, 0x7e, 0x44, 0x6a, 0xa2, 0xb0, 0xc9, 0xb4, 0x72, 0xd8, 0xd3, 0xdb,
-		0x85, 0xbe, 0x86, 0x52, 0xc8, 0x54, 0xaa, 0x63, 0xd8, 0xdc, 0x54, 0xb5, 0xca, 0xa8, 0x58, 0x49,
-		0x55, 0xab, 0x42, 0xb5, 0x2b, 0xa9, 0x5e, 0x2d, 0x5c, 0xbb, 0x9c, 0x2a, 0x5a, 0x6e, 0xd3, 0x18,
-		0xce, 0x95, 0xa9, 0x8a, 0x3e, 0x52, 0xcb, 0x21, 0x0f, 0xb8, 0xd6, 0x39, 0x7b, 0xa1, 0xcf, 0x59,
-		0xe7, 0xfc, 0x11, 0x96, 0x32, 0x5b, 0x01, 0x54, 0x64, 0xf1, 0x08, 0x05, 0xd1, 0xdc, 0x22, 0xdb,
-		0xb2, 0x81, 0x80, 0x48, 0x84, 0x47, 0x8b, 0xa8, 0x81, 0x8d, 0x94, 0x64, 0x27, 0x95, 0xad, 0xa8,
-		0x8a, 0x35, 0x55, 0xb4, 0xaa, 0xaa, 0xd6, 0x55, 0x9b, 0x95, 0xd5, 0x66, 0x6d, 0xd5, 0xad, 0xce,
-		0xce, 0xfa, 0x4a, 0x6c, 0x33, 0xdb, 0xb1, 0xa7, 0xad, 0x33, 0x3d, 0xb3, 0x09, 0x6d, 0x22, 0x25,
-		0x66, 0x7c, 0x46, 0xb1, 0x3a, 0x25, 0xfa, 0xde, 0xb0, 0x78, 0xa4, 0x5f, 0xde, 0x52, 0x44, 0x4f,
-		0x04, 0x7c, 0x86, 0x8b, 0xfd, 0x72, 0x8b, 0xff, 0xf0, 0x34, 0x21, 0x88, 0xa7, 0x0b, 0xbe, 0xb9,
-		0xae, 0x9d, 0x10, 0x03, 0x1e, 0x26, 0xc7, 0x5c, 0x28, 0xd9, 0x24, 0x02, 0x19, 0xb1, 0xe7, 0x13,
-		0x4b, 0xbd, 0x1d, 0xa3, 0x70, 0x8c, 0x62, 0xd7, 0x8c, 0xa2, 0x87, 0x28, 0xca, 0x93, 0x89, 0xa4,
-		0x77, 0x35, 0x1e, 0xe1, 0xb3, 0x10, 0x70, 0x34, 0x56, 0xd3, 0x65, 0x1a, 0xb1, 0x68, 0x08, 0x8e,
-		0x48, 0x38, 0x22, 0xf1, 0xcc, 0x88, 0x84, 0x36, 0x88, 0x2a, 0x14, 0xa2, 0xbd, 0x2b, 0x1a, 0x60,
-		0x01, 0xe6, 0x63, 0x81, 0xea, 0x7f, 0x18, 0x95, 0x47, 0x97, 0xd9, 0x03, 0x2a, 0x2e, 0x54, 0x1c,
-		0xb8, 0x38, 0x70, 0x71, 0xe0, 0x72, 0x0c, 0xe0, 0x72, 0x7c, 0x6b, 0x8c, 0x25, 0xbe, 0xbe, 0x9f,
-		0x78, 0x6b, 0x51, 0xbc, 0xcb, 0x6e, 0xd8, 0x56, 0xc3, 0x6d, 0xec, 0x25, 0x89, 0xa2, 0xd6, 0x48,
-		0x70, 0x58, 0x31, 0x12, 0x7c, 0x5d, 0x25, 0x12, 0xac, 0x9d, 0x4e, 0xcb, 0xe0, 0x3c, 0xb9, 0xac,
-		0x9d, 0x59, 0x24, 0xf8, 0xe3, 0xc6, 0x04, 0xa9, 0xed, 0xdd, 0xf3, 0x9d, 0x91, 0x3b, 0x50, 0xae,
-		0xbe, 0x03, 0xe5, 0x0a, 0xa1, 0xd9, 0x3c, 0xec, 0xf6, 0x18, 0x6e, 0xcb, 0x69, 0x63, 0x98, 0x89,
-		0x6a, 0x96, 0x92, 0x6e, 0xbe, 0x57, 0x31, 0xcb, 0xae, 0x3c, 0x33, 0x04, 0xe2, 0xb2, 0x49, 0x95,
-		0xf6, 0xc9, 0x94, 0x0f, 0x66, 0xb9, 0xf4, 0xf6, 0x43, 0x6d, 0x79, 0x87, 0x37, 0xd6, 0x7a, 0xb3,
-		0x72, 0xcc, 0xf0, 0x8c, 0x4a, 0xa3, 0xf3, 0x31, 0xb3, 0x76, 0x66, 0x78, 0xd6, 0x05, 0x49, 0x47,
-		0xe3, 0x08, 0xd3, 0xca, 0x34, 0xde, 0xd7, 0x9c, 0xbb, 0x4f, 0x07, 0x71, 0xba, 0x8f, 0x0a, 0x54,
-		0xe1, 0x48, 0xba, 0xc3, 0x32, 0x0f, 0xfe, 0xb0, 0xcc, 0xcc, 0x8b, 0x1a, 0x86, 0xf0, 0x93, 0xd6,
-		0x76, 0x11, 0xfc, 0xdb, 0x61, 0xa6, 0x22, 0x54, 0xc2, 0x77, 0x9c, 0x62, 0x08, 0xbd, 0x29, 0x98,
-		0x3c, 0xc7, 0x65, 0x06, 0xd7, 0xb6, 0x58, 0xfa, 0x19, 0x4f, 0x78, 0x38, 0xde, 0x92, 0x8f, 0x8e,
-		0xab, 0xf8, 0x58, 0x15, 0xc9, 0x85, 0x2b, 0xf8, 0x30, 0x5d, 0x93, 0xe5, 0x38, 0x33, 0x71, 0xdf,
-		0x1c, 0xf3, 0x1f, 0x06, 0x81, 0x81, 0xb9, 0xdd, 0xcd, 0x7b, 0xd8, 0x1e, 0xdf, 0x13, 0x20, 0x9d,
-		0x20, 0x18, 0xf5, 0x75, 0x38, 0xfe, 0x7c, 0x70, 0x3c, 0xb6, 0x2d, 0x41, 0x3f, 0x3f, 0xda, 0x12,
-		0x74, 0x57, 0xb8, 0xb7, 0x2e, 0x93, 0xb6, 0x2b, 0xdc, 0xab, 0x03, 0xc7, 0x95, 0x35, 0x8e, 0xab,
-		0x72, 0x38, 0x7e, 0x2b, 0x08, 0x93, 0x23, 0xaa, 0x1c, 0x90, 0x3b, 0x20, 0x7f, 0xb6, 0x40, 0xee,
-		0x0e, 0xf5, 0x3e, 0x6a, 0xdf, 0xf6, 0xd4, 0x38, 0x6e, 0xb5, 0x1d, 0xf3, 0x2b, 0x4e, 0x0b, 0xb6,
-		0x51, 0xcc, 0x0e, 0x25, 0x31, 0x3f, 0x8c, 0x64, 0xe5, 0x10, 0x92, 0x9c, 0xb5, 0xa7, 0xd9, 0x49,
-		0x23, 0x87, 0x58, 0x42, 0x3b, 0xaf, 0x93, 0x9d, 0xb9, 0xc1, 0x79, 0xa5, 0xec, 0xfc, 0x9b, 0xd9,
-		0x42, 0xe7, 0xa5, 0x09, 0xa4, 0x2d, 0x7b, 0x49, 0xcb, 0x54, 0xc0, 0xd9, 0x3b, 0xcc, 0xcb, 0x3d,
-		0x23, 0x94, 0x12, 0xd4, 0x90, 0x30, 0x98, 0xbd, 0x04, 0x34, 0x93, 0xd2, 0xcf, 0xe4, 0xfc, 0xd3,
-		0x89, 0x36, 0x39, 0xfd, 0xe7, 0x14, 0x02, 0xc2, 0x40, 0x0e, 0x89, 0x40, 0xa0, 0x12, 0x7c, 0x6f,
-		0x4f, 0x95, 0xb3, 0xe1, 0xc2, 0x60, 0x9f, 0xa2, 0x6e, 0xb6, 0x3e, 0x79, 0xed, 0x31, 0x5b, 0xb4,
-		0x5a, 0x71, 0xf5, 0x7c, 0xc8, 0x54, 0xc2, 0x40, 0x20, 0x51, 0x28, 0xd2, 0x01, 0x73, 0x01, 0xf8,
-		0xdf, 0x98, 0x44, 0xa0, 0x38, 0x18, 0x2e, 0xcd, 0xeb, 0x52, 0x83, 0xa7, 0x2f, 0xa0, 0xb6, 0x93,
-		0xca, 0xb3, 0x2c, 0x92, 0xce, 0x0d, 0xd0, 0x40, 0x71, 0x68, 0x5c, 0xfb, 0x16, 0x8b, 0x22, 0xe9,
-		0xdc, 0xdb, 0xd8, 0xba, 0xb3, 0x7c, 0xab, 0x8d, 0x48, 0x5e, 0x10, 0x69, 0x3a, 0x55, 0xad, 0xcb,
-		0xdc, 0x1c, 0xad, 0xd5, 0x95, 0xca, 0xcd, 0xbd, 0x42, 0x16, 0x52, 0x36, 0xb0, 0x89, 0x9d, 0xbb,
-		0x8a, 0xea, 0xed, 0x4b, 0x8e, 0xfd, 0x55, 0x54, 0x17, 0xd4, 0xd5, 0xaf, 0x09, 0xb4, 0x30, 0xff,
-		0x03, 0xca, 0xd5, 0xd9, 0x1b, 0x6a, 0xc7, 0xbe, 0xd6, 0xb1, 0xae, 0x3a, 0xb4, 0x16, 0xa2, 0x5d,
-		0x80, 0x5a, 0x2b, 0x57, 0xe3, 0x99, 0xd0, 0x54, 0x2d, 0x39, 0x99, 0x7b, 0xd9, 0x8e, 0x01, 0x2a,
-		0xdc, 0xce, 0x2f, 0xfa, 0xa4, 0x12, 0xd2, 0x07, 0xaa, 0xfa, 0x61, 0x42, 0x1a, 0xdc, 0x9b, 0x53,
-		0x0d, 0x28, 0x36, 0x8e, 0xa3, 0xf2, 0x1c, 0xdd, 0xe5, 0x7b, 0x96, 0x7c, 0x6f, 0x59, 0xe4, 0x25,
-		0x37, 0x5d, 0x75, 0x9a, 0xeb, 0x16, 0x97, 0xc7, 0xf3, 0xf8, 0xb6, 0x0b, 0xef, 0x95, 0xde, 0xf0,
-		0xda, 0x6b, 0x4a, 0xb5, 0xa9, 0x88, 0x73, 0xf9, 0x1e, 0xd8, 0x59, 0xab, 0xfc, 0xdb, 0x60, 0xbb,
-		0x20, 0x31, 0xe0, 0x2c, 0xdc, 0x74, 0x25, 0x6c, 0xc2, 0x77, 0xa9, 0x04, 0xce, 0xd2, 0x73, 0x62,
-		0x92, 0xe7, 0x01, 0x51, 0x4a, 0xd0, 0x5e, 0xac, 0x50, 0xbe, 0x86, 0x9b, 0x90, 0x2a, 0x90, 0xd3,
-		0x51, 0x8f, 0x47, 0x20, 0x87, 0x3c, 0x8e, 0x42, 0x60, 0x3c, 0x61, 0xcf, 0x13, 0x2a, 0xa9, 0xd6,
-		0x6c, 0xcb, 0x5b, 0x65, 0x7d, 0x77, 0xab, 0xec, 0x46, 0xbf, 0x15, 0x18, 0x9e, 0x07, 0x13, 0x18,
-		0xdf, 0x2a, 0x4b, 0x65, 0x72, 0x3e, 0x10, 0x30, 0xfc, 0xb1, 0x30, 0xe3, 0x24, 0x0c, 0x31, 0x04,
-		0xca, 0x92, 0x85, 0x8e, 0xef, 0xb5, 0xde, 0xc2, 0x04, 0x85, 0xa4, 0x9c, 0xbd, 0x06, 0xf8, 0x27,
-		0x42, 0xc8, 0xd9, 0x89, 0x82, 0x21, 0x99, 0xa0, 0x26, 0xc1, 0x92, 0x4c, 0x81, 0xaa, 0x13, 0x09,
-		0x27, 0x69, 0xce, 0x4d, 0xba, 0x65, 0x72, 0x02, 0x4d, 0xa0, 0xa3, 0x71, 0x44, 0xd3, 0x14, 0x8b,
-		0x31, 0x11, 0xdb, 0x31, 0xc7, 0x65, 0xe0, 0x54, 0xd8, 0x93, 0xdd, 0xc1, 0x89, 0x33, 0x6d, 0xcb,
-		0xeb, 0x6a, 0xdb, 0xa4, 0xe4, 0xbd, 0x12, 0xd9, 0x11, 0x6c, 0x30, 0x21, 0x82, 0x92, 0x75, 0x9c,
-		0x70, 0x7b, 0xfe, 0xcf, 0x78, 0xcf, 0x7f, 0x9f, 0xc7, 0xf3, 0x15, 0x1c, 0x5b, 0xd9, 0xb6, 0xbc,
-		0xbc, 0xae, 0xdd, 0x2b, 0x69, 0x0f, 0xd9, 0x29, 0x4c, 0xce, 0x1c, 0x9c, 0x39, 0xac, 0xca, 0xf9,
-		0x59, 0xe5, 0xa4, 0x9d, 0xb9, 0x20, 0xd8, 0x5a, 0x5c, 0xd0, 0x05, 0xc1, 0x76, 0x13, 0x04, 0xab,
-		0x67, 0x37, 0x34, 0x5b, 0x66, 0x9d, 0xe6, 0xd2, 0xef, 0xc2, 0xc5, 0xdf, 0xd5, 0x57, 0xfd, 0x90,
-		0xb4, 0x66, 0xe8, 0x5d, 0xd5, 0x9a, 0x21, 0x93, 0xd3, 0x23, 0x73, 0x6f, 0x05, 0xdb, 0xb0, 0x48,
-		0x5c, 0x5e, 0xfa, 0x55, 0x2d, 0x16, 0xf2, 0x1d, 0x9d, 0xaf, 0xa8, 0xbc, 0xe6, 0xc5, 0x42, 0x85,
-		0x29, 0x14, 0x06, 0xa9, 0x13, 0x86, 0x29, 0x13, 0xbb, 0x2a, 0x15, 0x6a, 0x79, 0xc6, 0x17, 0x45,
-		0xfd, 0x0c, 0xe5, 0x42, 0xfe, 0x61, 0x0e, 0xf7, 0xa9, 0x2a, 0x86, 0x7a, 0x86, 0x15, 0x43, 0xbd,
-		0xb2, 0x15, 0x43, 0x29, 0xb8, 0x25, 0x95, 0x42, 0xaf, 0xe0, 0x07, 0x55, 0x43, 0x20, 0x10, 0xf2,
-		0xb8, 0x17, 0x21, 0x7c, 0xc7, 0x69, 0xd5, 0xad, 0x0b, 0x87, 0x75, 0x3b, 0xdf, 0xba, 0xa0, 0x2c,
-		0xc4, 0xfb, 0x96, 0xf9, 0x52, 0x2d, 0x6b, 0x5f, 0xb6, 0x80, 0x48, 0xf7, 0x76, 0x2b, 0x34, 0xb7,
-		0x42, 0x5b, 0xf4, 0xb0, 0x17, 0x16, 0x0b, 0xb4, 0xb3, 0xa3, 0xcd, 0x51, 0x74, 0xb9, 0xe6, 0xeb,
-		0xce, 0xfa, 0xec, 0xcc, 0xad, 0xcf, 0x6a, 0xd8, 0x6e, 0x4b, 0x50, 0xd5, 0xb7, 0xc4, 0x70, 0xdf,
-		0x61, 0xb8, 0xc3, 0x70, 0x87, 0xe1, 0x0e, 0xc3, 0x1d, 0x86, 0x1f, 0x02, 0x86, 0x27, 0x21, 0x10,
-		0xcb, 0x2b, 0x2b, 0xac, 0x2f, 0xac, 0xe8, 0xce, 0x82, 0x25, 0xf3, 0xdd, 0xa8, 0x59, 0xbc, 0x5a,
-		0x23, 0x7b, 0xdd, 0x98, 0xee, 0x3b, 0x4c, 0x3f, 0x5a, 0x4c, 0x7f, 0x56, 0x91, 0x13, 0x57, 0x3e,
-		0xb4, 0x61, 0x1f, 0xcd, 0xa1, 0x7a, 0x5d, 0xa8, 0x1e, 0x5a, 0xa2, 0x7a, 0x68, 0x1d, 0x08, 0x07,
-		0x1a, 0x22, 0x53, 0x54, 0x4d, 0x05, 0xf6, 0xf7, 0x83, 0xed, 0x8e, 0xaf, 0x1f, 0x2f, 0xb6, 0x2f,
-		0x28, 0x8b, 0x0d, 0xc0, 0x9b, 0xd0, 0xf6, 0x0f, 0xd9, 0xa3, 0xaf, 0x88, 0x2c, 0x51, 0x54, 0xf3,
-		0xe9, 0xcf, 0xab, 0xee, 0xd7, 0x1b, 0xd3, 0xf9, 0x49, 0xa0, 0x4a, 0x1a, 0x3b, 0x13, 0x3b, 0x87,
-		0xb2, 0xf4, 0x5e, 0x1f, 0xae, 0x6f, 0xff, 0xfc, 0x7c, 0xd3, 0x6a, 0xec, 0x02, 0xa6, 0x2b, 0xbd,
-		0x92, 0x5f, 0xf7, 0x61, 0xe7, 0x77, 0xfb, 0xce, 0xfd, 0xce, 0x2f, 0xb2, 0x4c, 0x37, 0x9b, 0x21,
-		0x77, 0xbf, 0x62, 0xc7, 0xd5, 0x96, 0x17, 0xbb, 0xaa, 0xb6, 0xac, 0x37, 0x84, 0x9e, 0x1b, 0xc5,
-		0x01, 0xc3, 0x10, 0x7a, 0x52, 0x5b, 0x74, 0x55, 0x53, 0x6d, 0x51, 0xa5, 0x0c, 0xf0, 0xcd, 0x09,
-		0xd8, 0x86, 0xe3, 0x30, 0x49, 0x06, 0xd7, 0x8e, 0xb6, 0xab, 0x6e, 0xf9, 0xf8, 0x23, 0x4e, 0x36,
-		0x1c, 0x94, 0xbd, 0xe4, 0x90, 0x17, 0xda, 0x15, 0x25, 0x84, 0x27, 0x67, 0x5d, 0x13, 0x95, 0xb8,
-		0xdd, 0xc7, 0xac, 0xf0, 0x5f, 0x18, 0x57, 0x20, 0x30, 0xe0, 0xa3, 0x11, 0xb2, 0x10, 0x43, 0xe8,
-		0xc5, 0x6a, 0x5e, 0x2b, 0x29, 0xe3, 0xf1, 0x98, 0x0b, 0x85, 0xe1, 0xcb, 0x2d, 0x09, 0xdf, 0xde,
-		0xb6, 0x84, 0x6f, 0xef, 0xd9, 0x26, 0x7c, 0x6f, 0xf5, 0x79, 0xc5, 0xeb, 0x97, 0xbc, 0xf5, 0x4a,
-		0xe3, 0x33, 0x51, 0x0a, 0x05, 0xdb, 0xea, 0x53, 0x1a, 0xdf, 0xba, 0xcd, 0x7f, 0xdd, 0xfd, 0xd5,
-		0x7e, 0x68, 0x7e, 0xf3, 0x9a, 0x6f, 0xef, 0xfe, 0xd6, 0x28, 0x2a, 0x8b, 0x78, 0xb1, 0xfc, 0xaf,
-		0x6c, 0x1c, 0xdb, 0x8c, 0xa3, 0x41, 0xe5, 0x7b, 0xf2, 0x1d, 0xbf, 0x70, 0xbe, 0x2e, 0xbd, 0x55,
-		0x83, 0x69, 0x2c, 0x7e, 0xb5, 0x64, 0x12, 0xd7, 0x38, 0xa1, 0x41, 0x66, 0x04, 0x0f, 0x2f, 0x1e,
-		0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x63, 0x73, 0xbe, 0x47,
+		0x12, 0x7f, 0xf7, 0xa7, 0x18, 0xe8, 0x25, 0xdb, 0x43, 0xdc, 0xc8, 0x72, 0x92, 0xa6, 0x01, 0xee,
+		0xc1, 0x69, 0x52, 0x5c, 0xb1, 0xed, 0x6e, 0xd1, 0x06, 0x7b, 0xd8, 0xeb, 0x05, 0x07, 0x5a, 0xa2,
+		0x6d, 0xa2, 0x32, 0xe9, 0x23, 0x29, 0x37, 0xbe, 0x45, 0xbe, 0xfb, 0x81, 0x96, 0xec, 0xfa, 0xaf,
+		0xc4, 0x91, 0x14, 0xc7, 0x4e, 0x98, 0x97, 0x36, 0x36, 0xa9, 0x88, 0xc3, 0xdf, 0xfc, 0x66, 0xc8,
+		0x99, 0x21, 0xff, 0x6a, 0x00, 0x00, 0x78, 0xbf, 0x91, 0x21, 0xf5, 0x2e, 0xc1, 0x8b, 0xe8, 0x98,
+		0x85, 0xd4, 0x3b, 0x4e, 0x3f, 0xfd, 0x95, 0xf1, 0xc8, 0xbb, 0x84, 0x56, 0xf6, 0xeb, 0x3b, 0xc1,
+		0x7b, 0xac, 0xef, 0x5d, 0x82, 0x9f, 0x7d, 0x70, 0xcd, 0xa4, 0x77, 0x09, 0xe9, 0x23, 0x00, 0x00,
+		0xbc, 0x50, 0x70, 0xdd, 0x22, 0x4b, 0x9f, 0x2d, 0x3d, 0x3e, 0xfb, 0xfe, 0x78, 0xf9, 0xdb, 0x6b,
+		0xaa, 0x42, 0xc9, 0x46, 0x9a, 0x09, 0x6e, 0x1a, 0xdd, 0x0e, 0x28, 0x68, 0x31, 0x82, 0x98, 0x8e,
+		0x69, 0x0c, 0xa6, 0x0b, 0x61, 0x9c, 0xca, 0xd5, 0x5e, 0xcb, 0x2f, 0x37, 0xff, 0x78, 0xf5, 0x25,
+		0xe7, 0x5f, 0x7c, 0x96, 0xb4, 0xc7, 0xee, 0xd7, 0xde, 0x6d, 0xe9, 0xfd, 0x74, 0xcb, 0x3b, 0x5e,
+		0xff, 0xf6, 0xab, 0x48, 0x64, 0x48, 0x37, 0xf6, 0x4c, 0xdf, 0x84, 0x4e, 0x7e, 0x08, 0x69, 0x5e,
+		0xc6, 0x1b, 0xa5, 0x7f, 0xe4, 0x78, 0x73, 0xc3, 0x7f, 0x10, 0xd5, 0x91, 0xfd, 0x64, 0x48, 0xb9,
+		0xf6, 0x2e, 0x41, 0xcb, 0x84, 0x6e, 0x69, 0xb8, 0xd0, 0xca, 0xbc, 0xd3, 0x5a, 0xa3, 0x87, 0xa5,
+		0x4f, 0x1e, 0x56, 0xe5, 0xb9, 0x32, 0x2d, 0x4b, 0xd3, 0x13, 0x90, 0xed, 0x03, 0x59, 0x9c, 0xa6,
+		0x80, 0x6c, 0x1b, 0xc5, 0x86, 0xe9, 0x0a, 0x78, 0x54, 0x30, 0x5d, 0x05, 0xd3, 0x56, 0x38, 0x7d,
+		0x36, 0xd3, 0x68, 0x37, 0x9d, 0xb6, 0xd3, 0x8a, 0x9e, 0x5e, 0xf4, 0x34, 0x5b, 0x4f, 0xf7, 0xe6,
+		0x69, 0xdf, 0x32, 0xfd, 0x85, 0x30, 0x98, 0xfd, 0x78, 0x31, 0x25, 0xbd, 0x80, 0xe4, 0xb6, 0x59,
+		0x12, 0x67, 0xd6, 0xbe, 0x60, 0x30, 0x2b, 0xf0, 0xf8, 0x2d, 0x19, 0x52, 0xc9, 0x42, 0x30, 0x9d,
+		0x81, 0x71, 0xc5, 0x22, 0x0a, 0xef, 0x66, 0x20, 0x01, 0x9b, 0xc7, 0xf5, 0x48, 0x12, 0x1b, 0xd1,
+		0x7c, 0xcb, 0x6d, 0x08, 0x00, 0xe0, 0x05, 0x5e, 0x6e, 0x9b, 0xbb, 0x82, 0xbf, 0x95, 0x61, 0xd3,
+		0x2f, 0x68, 0x56, 0x84, 0x51, 0x0c, 0x56, 0x71, 0x98, 0xc5, 0x62, 0xb7, 0x34, 0x86, 0x4b, 0x63,
+		0x19, 0x8d, 0xe9, 0x7c, 0x6c, 0x17, 0x60, 0x7c, 0xf6, 0xe3, 0xdd, 0x4e, 0x46, 0x14, 0x27, 0xe7,
+		0x84, 0x71, 0x7d, 0x61, 0x23, 0xea, 0x0c, 0x14, 0x67, 0x16, 0x4d, 0xbf, 0x10, 0xde, 0xa7, 0x56,
+		0x48, 0x05, 0x00, 0xcb, 0xa9, 0x03, 0x00, 0xf0, 0x3e, 0x31, 0xee, 0x5d, 0x22, 0x3a, 0x00, 0x00,
+		0x78, 0x7f, 0x90, 0x38, 0xa1, 0xdb, 0xa9, 0x76, 0xdb, 0x8f, 0xf7, 0x5e, 0x92, 0xd0, 0x68, 0xef,
+		0x35, 0xeb, 0x33, 0xad, 0x8a, 0x61, 0xbe, 0x2e, 0x62, 0xda, 0x27, 0x9a, 0x8d, 0xcd, 0xdf, 0xee,
+		0x91, 0x58, 0x51, 0xeb, 0xde, 0x0f, 0xc7, 0x08, 0x91, 0x90, 0xfb, 0xf2, 0x22, 0x69, 0x1f, 0x8e,
+		0x48, 0x1a, 0x35, 0x0a, 0x6e, 0x67, 0x88, 0x73, 0x90, 0x5b, 0x97, 0xc9, 0xb3, 0xc3, 0x5c, 0x61,
+		0xab, 0xbb, 0x4a, 0x94, 0x4e, 0xef, 0xb5, 0x24, 0xcd, 0x84, 0x2b, 0x4d, 0xba, 0xb1, 0x25, 0xb9,
+		0x4b, 0xda, 0xa3, 0x92, 0xf2, 0xf0, 0x51, 0x48, 0x78, 0x66, 0x39, 0xbe, 0xbc, 0x7f, 0x07, 0xe7,
+		0xfe, 0xa9, 0xef, 0x21, 0xa0, 0x83, 0xb4, 0xd7, 0x9b, 0xec, 0xf6, 0xcf, 0xb1, 0x21, 0x71, 0x50,
+		0xd6, 0x84, 0x6f, 0x34, 0xe5, 0xf3, 0xc1, 0xef, 0x1b, 0x9a, 0x1a, 0x25, 0x70, 0x96, 0x7a, 0xb4,
+		0x5d, 0xa4, 0x07, 0xdc, 0x45, 0x7a, 0xc0, 0x7f, 0x88, 0x58, 0x93, 0x3e, 0x2d, 0xed, 0x01, 0x3b,
+		0xaf, 0xb4, 0x36, 0x48, 0xef, 0xd8, 0x2b, 0xfd, 0x44, 0x78, 0x44, 0xb4, 0x90, 0x93, 0x62, 0x2f,
+		0xac, 0x84, 0x07, 0x1b, 0xd1, 0x90, 0x0d, 0x49, 0x7c, 0x7e, 0x8a, 0xf0, 0x62, 0x5b, 0xc1, 0x71,
+		0x03, 0x6f, 0x79, 0xda, 0x2f, 0xd7, 0xf7, 0x6d, 0x97, 0x37, 0xba, 0x06, 0x96, 0xfb, 0xe7, 0x87,
+		0x04, 0xbe, 0xef, 0x3f, 0xa1, 0x50, 0xf6, 0xd2, 0x13, 0x29, 0x6f, 0x3b, 0x42, 0xa4, 0xed, 0x08,
+		0x91, 0xb6, 0xe3, 0x0b, 0x25, 0x11, 0x08, 0x1e, 0x4f, 0x76, 0x66, 0x3d, 0x02, 0x67, 0x3d, 0xf6,
+		0xc4, 0x7a, 0xe0, 0x2d, 0x82, 0xd2, 0x92, 0xf1, 0x3e, 0xc6, 0x1c, 0x5c, 0x3c, 0x96, 0x62, 0x44,
+		0x48, 0xc5, 0x88, 0x90, 0x8a, 0xd1, 0xe1, 0x42, 0x0f, 0xa8, 0x84, 0xcc, 0x08, 0x3a, 0xbf, 0xca,
+		0x69, 0x46, 0x9e, 0x9c, 0x9d, 0xaf, 0x54, 0xec, 0x19, 0xb4, 0x0e, 0xc7, 0x2d, 0x70, 0xce, 0x12,
+		0x5a, 0xa7, 0xf6, 0xc0, 0x59, 0xa2, 0x48, 0x9b, 0x40, 0x91, 0x36, 0xc1, 0x74, 0x82, 0x98, 0x29,
+		0xed, 0xac, 0x01, 0x80, 0xb3, 0x06, 0xdb, 0xe5, 0xcc, 0xb8, 0x6e, 0x9d, 0x23, 0x2c, 0x41, 0x70,
+		0xb8, 0x9c, 0x5e, 0x9d, 0xbf, 0xfc, 0x67, 0xb8, 0x02, 0x76, 0x5b, 0xf1, 0x28, 0x0d, 0xfb, 0xc8,
+		0x94, 0xee, 0x68, 0x2d, 0xed, 0xb4, 0xec, 0x13, 0xe3, 0x37, 0x31, 0x35, 0xfa, 0x6f, 0x29, 0x2a,
+		0x33, 0x9d, 0x0b, 0x3d, 0x5a, 0x17, 0xa7, 0xa7, 0xe7, 0x6f, 0x4e, 0x4f, 0xfd, 0x37, 0xed, 0x37,
+		0xfe, 0xdb, 0xb3, 0xb3, 0xd6, 0x79, 0xcb, 0x26, 0xf8, 0xfa, 0xbb, 0x8c, 0xa8, 0xa4, 0xd1, 0xd5,
+		0xc4, 0xbb, 0x04, 0x9e, 0xc4, 0xf1, 0x63, 0x59, 0xb1, 0x1e, 0xd2, 0x8a, 0xf5, 0x90, 0x56, 0xac,
+		0xcb, 0x38, 0x91, 0x13, 0xb7, 0x5b, 0x0c, 0xe0, 0xec, 0x58, 0x81, 0x9c, 0x53, 0xa8, 0x20, 0x0c,
+		0xd9, 0x5b, 0x8b, 0xa6, 0x1f, 0x29, 0xef, 0xeb, 0xc1, 0xde, 0x59, 0xb2, 0xc0, 0x77, 0x31, 0xe5,
+		0x43, 0x96, 0xc9, 0xbe, 0x2f, 0x4e, 0xfa, 0x48, 0x5a, 0xef, 0x23, 0x69, 0xfd, 0x4a, 0x88, 0x98,
+		0x12, 0xee, 0x78, 0xdd, 0xf1, 0x7a, 0x31, 0xaf, 0xa7, 0x58, 0xc1, 0xec, 0x55, 0xb5, 0xca, 0xea,
+		0x05, 0x2a, 0xa5, 0x14, 0x91, 0x93, 0xe1, 0x0d, 0x13, 0x55, 0x9c, 0xb5, 0x89, 0xde, 0xdc, 0xfe,
+		0x25, 0x55, 0xbe, 0x57, 0xf0, 0x77, 0x38, 0x32, 0x33, 0x7e, 0x04, 0x42, 0x02, 0x4f, 0x86, 0x5d,
+		0x2a, 0x7f, 0x79, 0x7d, 0x32, 0xfd, 0x92, 0xbc, 0x82, 0x7f, 0x27, 0xbe, 0xdf, 0x0e, 0xc1, 0x6a,
+		0xb7, 0xef, 0x46, 0x4a, 0x21, 0x3f, 0x51, 0xa5, 0x48, 0x1f, 0x01, 0xda, 0xd9, 0x5b, 0x7d, 0xe8,
+		0xc1, 0x47, 0xa3, 0xd1, 0x01, 0x01, 0xa6, 0x80, 0x74, 0xc5, 0x98, 0xc2, 0x29, 0xe8, 0x01, 0x4d,
+		0x35, 0x3d, 0xe8, 0x83, 0x91, 0x03, 0x74, 0xe9, 0x14, 0x9f, 0xd0, 0x13, 0xd2, 0x7c, 0x09, 0x63,
+		0x12, 0xb3, 0x88, 0x18, 0x6e, 0x00, 0x2d, 0x60, 0x44, 0x94, 0xb2, 0x05, 0x3d, 0x52, 0xbb, 0x56,
+		0x35, 0x8c, 0x9a, 0xd1, 0x36, 0x87, 0xd9, 0x70, 0x11, 0x86, 0xaa, 0xac, 0xb2, 0xad, 0x29, 0x5c,
+		0x7d, 0x12, 0xab, 0xc9, 0xa4, 0x3c, 0x94, 0x54, 0x9d, 0x3b, 0x94, 0xea, 0x74, 0x38, 0x17, 0x9a,
+		0x64, 0xd6, 0x20, 0x47, 0x6d, 0x54, 0x38, 0xa0, 0x43, 0x32, 0x22, 0x53, 0x17, 0xcc, 0x3b, 0x11,
+		0xbc, 0xd7, 0xd4, 0x54, 0xe9, 0xd6, 0x49, 0x5a, 0x3b, 0x71, 0x92, 0x9b, 0x9b, 0x9f, 0x3e, 0x41,
+		0xcb, 0x24, 0xd4, 0x3c, 0x03, 0xe8, 0xef, 0xbc, 0x77, 0x6b, 0xfa, 0xff, 0xc7, 0x18, 0x9b, 0x56,
+		0x67, 0xfa, 0x4f, 0xd0, 0xf1, 0x1a, 0x76, 0x63, 0xdd, 0x30, 0x9a, 0xb4, 0x3a, 0x20, 0xb2, 0xac,
+		0x22, 0x88, 0x2c, 0xab, 0x08, 0x16, 0x4c, 0x61, 0xb4, 0x1f, 0xa5, 0x03, 0xe4, 0x10, 0x6b, 0x07,
+		0xc8, 0x6e, 0x8b, 0x07, 0xa2, 0x36, 0x36, 0x00, 0x6e, 0x7a, 0xe0, 0x1c, 0xa7, 0x94, 0xf7, 0xa7,
+		0xdc, 0x00, 0x82, 0x2f, 0xfa, 0x4c, 0x11, 0x90, 0xa4, 0x6f, 0xc6, 0x4e, 0x23, 0xd0, 0x02, 0x36,
+		0x16, 0x17, 0x6d, 0x03, 0xd1, 0x13, 0xfb, 0x51, 0xe4, 0x39, 0x3a, 0x52, 0xc4, 0x45, 0xc4, 0xb7,
+		0x30, 0x32, 0x27, 0xe1, 0x77, 0x7b, 0x45, 0x49, 0x9b, 0xa3, 0xb5, 0x84, 0xb0, 0xfe, 0x40, 0x43,
+		0x4f, 0x8a, 0x21, 0x7c, 0x79, 0xff, 0xae, 0x79, 0xee, 0x07, 0xbe, 0xa5, 0x32, 0x9c, 0x39, 0x65,
+		0x38, 0x3c, 0x65, 0x28, 0x62, 0xe8, 0x9f, 0x4c, 0x4d, 0x34, 0x6d, 0x72, 0x03, 0x0e, 0xbc, 0x7b,
+		0xbb, 0xd0, 0xd7, 0x52, 0x0a, 0x19, 0xa4, 0x4e, 0x2d, 0x9b, 0xdb, 0x42, 0xab, 0x0c, 0xc4, 0x4a,
+		0x42, 0xad, 0x2c, 0xe4, 0x2a, 0x43, 0xaf, 0x32, 0x04, 0xcb, 0x43, 0xd1, 0x0e, 0x92, 0x96, 0xd0,
+		0x44, 0x43, 0x74, 0xf6, 0xe3, 0x85, 0x03, 0x11, 0x0a, 0x83, 0x39, 0xbc, 0xd0, 0xe7, 0x5e, 0xe7,
+		0xfc, 0x11, 0x48, 0x99, 0xad, 0x10, 0x2a, 0xe5, 0xa6, 0x70, 0x91, 0x18, 0xdf, 0x22, 0xdb, 0xb2,
+		0x81, 0x90, 0x28, 0x0a, 0x3f, 0x35, 0xa2, 0x06, 0x6f, 0x64, 0x9b, 0xf6, 0x60, 0xb7, 0xe5, 0xb0,
+		0x5a, 0x54, 0x45, 0x9b, 0x2a, 0x6a, 0x55, 0x55, 0xed, 0xaa, 0x4d, 0xcb, 0x6a, 0xd3, 0xb6, 0xea,
+		0x5a, 0x87, 0xd3, 0x3e, 0xa4, 0x16, 0xe2, 0xbd, 0xa7, 0xad, 0x33, 0x3d, 0xd3, 0x09, 0xa3, 0x22,
+		0x25, 0x66, 0x7c, 0xe6, 0x62, 0x9d, 0x96, 0xe8, 0x7b, 0xc3, 0x93, 0xa1, 0x79, 0x79, 0xa4, 0x88,
+		0x9e, 0x88, 0xf8, 0x2c, 0x17, 0xfb, 0xe5, 0x16, 0xff, 0xd1, 0xc9, 0xd4, 0x41, 0x3c, 0x59, 0xb0,
+		0xcd, 0x75, 0xed, 0x84, 0x14, 0x0f, 0xcf, 0x53, 0x23, 0x21, 0xb5, 0x6a, 0x12, 0x49, 0x39, 0xc1,
+		0xfb, 0x13, 0x4b, 0xbd, 0x9d, 0x47, 0xb1, 0x2b, 0xae, 0x7b, 0xb9, 0x1e, 0x45, 0x97, 0x52, 0x59,
+		0xde, 0x99, 0x98, 0xf6, 0xae, 0xe6, 0x47, 0x98, 0xa3, 0x31, 0xe8, 0x70, 0xa4, 0x27, 0xcb, 0x6e,
+		0xc4, 0xa2, 0x22, 0x38, 0x47, 0xc2, 0x39, 0x12, 0xf5, 0x28, 0x1b, 0x4e, 0xe9, 0x90, 0xca, 0x57,
+		0xa7, 0x23, 0x61, 0x14, 0xa2, 0x8a, 0x0b, 0xd1, 0x7e, 0x2c, 0x37, 0x00, 0x41, 0xe6, 0x23, 0x49,
+		0xf5, 0xff, 0x68, 0x5c, 0x9e, 0x5d, 0x66, 0x0f, 0xa8, 0xb8, 0x50, 0x71, 0xe4, 0xe2, 0xc8, 0xc5,
+		0x91, 0xcb, 0x21, 0x90, 0xcb, 0xe1, 0xad, 0x31, 0x96, 0xfc, 0xf5, 0xdd, 0xc4, 0x5b, 0x8f, 0x1b,
+		0x75, 0x0e, 0x1b, 0x35, 0x5c, 0x6f, 0x27, 0x49, 0x14, 0xb5, 0x46, 0x82, 0xa3, 0x8a, 0x91, 0xe0,
+		0xeb, 0x2a, 0x91, 0x60, 0x63, 0x74, 0x5a, 0x16, 0xe7, 0xc9, 0x65, 0xed, 0xec, 0x22, 0xc1, 0x1f,
+		0x37, 0x26, 0x48, 0x6d, 0xef, 0x9e, 0x6f, 0x8c, 0xdc, 0x81, 0x72, 0xf5, 0x1d, 0x28, 0x57, 0x48,
+		0xcd, 0xf6, 0x61, 0xb7, 0x9f, 0xe1, 0xb6, 0xe3, 0x46, 0xd5, 0x4c, 0x54, 0xbb, 0x94, 0x74, 0xfb,
+		0xbd, 0x8a, 0x59, 0x76, 0xe5, 0xd9, 0x71, 0xe3, 0x51, 0x93, 0x2a, 0xf1, 0xc9, 0x94, 0x0f, 0x76,
+		0xb9, 0xf4, 0xf8, 0xa1, 0xb6, 0xfc, 0xfd, 0x1b, 0x6b, 0xbd, 0x59, 0x39, 0x76, 0x7c, 0xc6, 0x94,
+		0xd5, 0xf9, 0x98, 0x59, 0x3b, 0x3b, 0x3e, 0xeb, 0x80, 0x62, 0xc3, 0x51, 0x4c, 0xd3, 0xca, 0x34,
+		0xd1, 0x33, 0x3e, 0x77, 0x8f, 0xf5, 0x93, 0x74, 0x1f, 0x15, 0x98, 0xa6, 0x43, 0xe5, 0x0e, 0xcb,
+		0xdc, 0xfb, 0xc3, 0x32, 0x33, 0x2b, 0x6a, 0x19, 0xc2, 0x9f, 0xb6, 0xc6, 0x45, 0xf0, 0x6f, 0x07,
+		0x19, 0x44, 0x98, 0x82, 0xef, 0x74, 0x42, 0x23, 0xe8, 0x4e, 0xc0, 0xe6, 0x39, 0x2e, 0x33, 0xb8,
+		0x32, 0xa8, 0xd0, 0xe0, 0xb2, 0x64, 0xa9, 0x7d, 0xc8, 0x67, 0x39, 0xdc, 0x92, 0x8f, 0x53, 0x57,
+		0xf1, 0xb1, 0x2a, 0x92, 0x0b, 0x57, 0xf0, 0x61, 0xd9, 0x3f, 0x67, 0x4a, 0x3c, 0x79, 0xdf, 0x1c,
+		0x89, 0x1f, 0x16, 0x81, 0x81, 0xb9, 0xde, 0xcd, 0x7b, 0x60, 0x8f, 0xef, 0x09, 0x29, 0x1b, 0x53,
+		0xb0, 0xea, 0xeb, 0x78, 0xfc, 0x65, 0x9d, 0x3e, 0x8c, 0x2a, 0x41, 0x3f, 0x3f, 0xd8, 0x12, 0x74,
+		0x57, 0xb8, 0xb7, 0x2e, 0x93, 0xb6, 0x2b, 0xdc, 0xab, 0x83, 0xc7, 0x35, 0x9a, 0xc7, 0x75, 0x39,
+		0x1e, 0xbf, 0x95, 0x84, 0xab, 0x21, 0xd3, 0x8e, 0xc8, 0x1d, 0x91, 0xbf, 0x58, 0x22, 0x77, 0x87,
+		0x7a, 0x1f, 0xb4, 0x6d, 0x7b, 0x6a, 0x1e, 0x47, 0x6d, 0xc7, 0xfc, 0x4a, 0x27, 0x05, 0xdb, 0x28,
+		0x76, 0x87, 0x92, 0xd8, 0x1f, 0x46, 0xb2, 0x72, 0x08, 0x49, 0xce, 0xda, 0xd3, 0xee, 0xa4, 0x91,
+		0x7d, 0x2c, 0xa1, 0x9d, 0xd7, 0xc9, 0xce, 0xcc, 0xe0, 0xbc, 0x52, 0x76, 0xfe, 0xcd, 0x6c, 0xa1,
+		0xf3, 0xca, 0x86, 0xd2, 0x96, 0xad, 0x24, 0x32, 0x15, 0x70, 0xf6, 0x0e, 0xf3, 0x72, 0xcf, 0x98,
+		0x2a, 0x05, 0x7a, 0x40, 0x38, 0xcc, 0x5e, 0x02, 0x9a, 0xd3, 0xd2, 0xcf, 0xe9, 0xf9, 0xa7, 0x63,
+		0xa3, 0x72, 0xe6, 0xd7, 0x09, 0x84, 0x84, 0x83, 0x1a, 0x10, 0x49, 0x81, 0x29, 0x08, 0xfc, 0x1d,
+		0x55, 0xce, 0x46, 0x0b, 0x83, 0x7d, 0x8a, 0xba, 0xd9, 0xfa, 0xe4, 0xb5, 0xc3, 0x6c, 0xd1, 0x6a,
+		0xc5, 0xd5, 0xf3, 0x21, 0x33, 0x05, 0x7d, 0x49, 0x89, 0xa6, 0x32, 0x1d, 0xb0, 0x90, 0x40, 0xff,
+		0x9b, 0x90, 0x18, 0xb4, 0x00, 0xcb, 0xa5, 0xf9, 0xf3, 0x29, 0xa0, 0xc6, 0x49, 0xe5, 0x45, 0x16,
+		0x49, 0xe7, 0x06, 0x68, 0xa0, 0x38, 0x34, 0x6e, 0x6c, 0x0b, 0xa2, 0x48, 0x3a, 0xf7, 0x36, 0xb6,
+		0xce, 0x2c, 0xdf, 0x6a, 0x23, 0x93, 0x17, 0x44, 0x9a, 0x4e, 0x74, 0xeb, 0x32, 0x37, 0x47, 0x6b,
+		0x75, 0xa5, 0x72, 0x73, 0xaf, 0x29, 0x8f, 0x18, 0xef, 0x63, 0x62, 0xe7, 0xae, 0xa2, 0xba, 0x54,
+		0x82, 0x54, 0xcd, 0x11, 0xa6, 0x82, 0xba, 0xfa, 0x35, 0x81, 0x16, 0xe6, 0x7f, 0x40, 0xb9, 0x3a,
+		0x7b, 0x4b, 0x74, 0x58, 0xa3, 0x04, 0x83, 0x16, 0x24, 0x6a, 0xca, 0x92, 0xfa, 0x33, 0xa8, 0x0e,
+		0xc5, 0xa2, 0xb2, 0x80, 0xb5, 0x56, 0xae, 0xc6, 0xb3, 0x71, 0x53, 0x8d, 0xe4, 0x54, 0xee, 0x65,
+		0x3b, 0x16, 0xac, 0x70, 0x3b, 0xbf, 0xe8, 0x93, 0x29, 0x48, 0x1f, 0xa8, 0xeb, 0xa7, 0x09, 0x65,
+		0x71, 0x6f, 0x4e, 0x35, 0xa2, 0xd8, 0x38, 0x8e, 0xca, 0x73, 0x74, 0x97, 0x6f, 0x59, 0xf2, 0xad,
+		0x65, 0x91, 0x95, 0xdc, 0x20, 0x8f, 0x7c, 0xb3, 0xe8, 0x35, 0x36, 0xbf, 0xed, 0xc2, 0x7b, 0xa5,
+		0x37, 0xbc, 0x76, 0x9b, 0x4a, 0x6f, 0x2a, 0xe2, 0x5c, 0xbe, 0x07, 0x76, 0xd6, 0x2a, 0xff, 0x36,
+		0xd8, 0x0e, 0x28, 0x1a, 0x0a, 0x1e, 0x6d, 0xba, 0x12, 0x76, 0xea, 0xef, 0x32, 0x05, 0x82, 0xa7,
+		0xe7, 0xc4, 0x4c, 0x9f, 0x07, 0x44, 0x6b, 0xc9, 0xba, 0x89, 0xa6, 0xea, 0x35, 0xdc, 0x44, 0x4c,
+		0x83, 0x9a, 0x0c, 0xbb, 0x22, 0x06, 0x35, 0x10, 0x49, 0x1c, 0x01, 0x17, 0x53, 0xef, 0x79, 0xcc,
+		0x14, 0xeb, 0xc6, 0x6b, 0x7f, 0xbd, 0xe8, 0x56, 0xd9, 0xc0, 0xdd, 0x2a, 0xbb, 0xd1, 0x6e, 0x85,
+		0x96, 0xe7, 0xc1, 0x84, 0xd6, 0xb7, 0xca, 0x32, 0x05, 0x4c, 0x01, 0x01, 0x4e, 0x7f, 0x2c, 0xcc,
+		0x38, 0x89, 0x22, 0x1a, 0x01, 0xe3, 0xd3, 0x85, 0x4e, 0xe0, 0xb7, 0xde, 0xc2, 0x98, 0x4a, 0xc5,
+		0x04, 0x7f, 0x0d, 0xf0, 0x4f, 0x0a, 0x91, 0xe0, 0x47, 0x1a, 0x06, 0x64, 0x4c, 0x41, 0x0b, 0x50,
+		0x64, 0x02, 0x4c, 0x1f, 0x29, 0x38, 0x4a, 0x73, 0x6e, 0xd2, 0x2d, 0x93, 0x23, 0x68, 0x82, 0xc9,
+		0xc8, 0x61, 0x69, 0x8a, 0xc5, 0x88, 0xc8, 0xed, 0x9c, 0xe3, 0x32, 0x70, 0x4a, 0xe0, 0xa8, 0x80,
+		0xe5, 0xaa, 0x9c, 0x38, 0xd3, 0x46, 0x5e, 0x57, 0xdb, 0x26, 0x25, 0xef, 0x95, 0xc8, 0x8e, 0x60,
+		0x83, 0x31, 0x91, 0x8c, 0xac, 0xf3, 0xc4, 0x36, 0x9c, 0xb8, 0x3d, 0xff, 0xf2, 0xb8, 0x42, 0xe3,
+		0x2b, 0x1f, 0x67, 0x05, 0x78, 0xab, 0xb0, 0xe7, 0xbf, 0xcb, 0xe3, 0xf9, 0x0a, 0x8e, 0xad, 0x6c,
+		0x23, 0x2f, 0xaf, 0x6b, 0x77, 0x4b, 0xea, 0x43, 0x9a, 0x78, 0xe4, 0xd4, 0xc1, 0xa9, 0xc3, 0x9a,
+		0x9c, 0x5f, 0x54, 0x4e, 0xda, 0x99, 0x0b, 0x82, 0x95, 0x4d, 0x1e, 0xdf, 0x07, 0x99, 0x1c, 0x54,
+		0x10, 0xac, 0x9e, 0xdd, 0xd0, 0x6c, 0x99, 0x75, 0x92, 0xeb, 0x7e, 0x43, 0xd1, 0xe2, 0xef, 0xea,
+		0xab, 0x79, 0xc8, 0xf4, 0xff, 0xc1, 0xbb, 0xaa, 0x35, 0x43, 0x36, 0xa7, 0x47, 0xe6, 0xde, 0x0a,
+		0xb6, 0x61, 0x91, 0xb8, 0xbc, 0xf4, 0xab, 0x5a, 0x2c, 0x14, 0x38, 0x77, 0xbe, 0x22, 0x78, 0xed,
+		0x8b, 0x85, 0x0a, 0x53, 0x28, 0x2c, 0x52, 0x27, 0x2c, 0x53, 0x26, 0x1e, 0xab, 0x54, 0xa8, 0xe5,
+		0x5b, 0x5f, 0x14, 0xf5, 0x1c, 0xca, 0x85, 0x82, 0xfd, 0x1c, 0xee, 0x53, 0x55, 0x0c, 0x75, 0x2d,
+		0x2b, 0x86, 0xba, 0x65, 0x2b, 0x86, 0x52, 0x72, 0x9b, 0x56, 0x0a, 0x1d, 0xc3, 0x0f, 0xa6, 0x07,
+		0x40, 0x20, 0x12, 0x49, 0x37, 0xa6, 0xa6, 0x52, 0xa4, 0xea, 0xd6, 0x85, 0xe3, 0xba, 0x47, 0xdf,
+		0xba, 0x60, 0x3c, 0xa2, 0xf7, 0x2d, 0xfb, 0xa5, 0x5a, 0xd6, 0xbe, 0x6c, 0x01, 0x91, 0xe9, 0xed,
+		0x56, 0x68, 0x6e, 0x85, 0xb6, 0x68, 0x61, 0x2f, 0x10, 0x0b, 0xb4, 0xb3, 0x83, 0xcd, 0x51, 0x74,
+		0xb9, 0xe6, 0x6b, 0x22, 0x09, 0xce, 0xce, 0xdc, 0xfa, 0xcc, 0xb2, 0x7f, 0xce, 0xa4, 0xa4, 0x9c,
+		0x1c, 0x20, 0x39, 0x3c, 0x70, 0x1c, 0xee, 0x38, 0xdc, 0x71, 0xb8, 0xe3, 0x70, 0xc7, 0xe1, 0xfb,
+		0xc0, 0xe1, 0xd3, 0x10, 0x08, 0xf2, 0xca, 0x0a, 0xf4, 0x85, 0x15, 0x9d, 0x59, 0xb0, 0x64, 0xbe,
+		0x1b, 0x35, 0x8b, 0x57, 0x1b, 0x66, 0xaf, 0x9b, 0xd3, 0x03, 0xc7, 0xe9, 0x07, 0xcb, 0xe9, 0x2f,
+		0x2a, 0x72, 0xe2, 0xca, 0x87, 0xd6, 0x69, 0xdd, 0x45, 0x4e, 0x6a, 0x63, 0xf5, 0x08, 0xc9, 0xea,
+		0x11, 0x3a, 0x10, 0x0e, 0x2c, 0xa2, 0x5c, 0x33, 0x3d, 0x91, 0xb4, 0xb7, 0x1b, 0x6e, 0x77, 0xfe,
+		0xfa, 0xe1, 0x72, 0xfb, 0x02, 0x58, 0x30, 0x04, 0x6f, 0xe3, 0xb6, 0x7f, 0xc8, 0x1e, 0x7d, 0x45,
+		0x54, 0x89, 0xa2, 0x9a, 0x4f, 0x7f, 0x5e, 0x75, 0xbe, 0xde, 0xd8, 0xce, 0xcf, 0x94, 0xaa, 0x94,
+		0xb5, 0x31, 0x01, 0x94, 0x41, 0x59, 0x7a, 0xaf, 0x0f, 0xd7, 0xb7, 0x7f, 0x7e, 0xbe, 0x69, 0x79,
+		0x8f, 0x41, 0xd3, 0x95, 0x5e, 0x29, 0xa8, 0xfb, 0xb0, 0xf3, 0xbb, 0x5d, 0xe7, 0x7e, 0xe7, 0x17,
+		0x59, 0xa6, 0x9b, 0xcd, 0x90, 0xbb, 0x5f, 0xf1, 0xc8, 0xd5, 0x96, 0x17, 0x8f, 0x55, 0x6d, 0x59,
+		0x6f, 0x08, 0x3d, 0x37, 0x8a, 0x03, 0x96, 0x21, 0xf4, 0x69, 0x6d, 0xd1, 0x55, 0x4d, 0xb5, 0x45,
+		0x95, 0x32, 0xc0, 0x37, 0x27, 0x60, 0x5b, 0x8e, 0xc3, 0x26, 0x19, 0xdc, 0x18, 0xda, 0x8e, 0xbe,
+		0x15, 0xa3, 0x8f, 0x26, 0x6f, 0x7b, 0x7b, 0x3a, 0xf8, 0x4a, 0xbb, 0xa2, 0x84, 0x70, 0xd3, 0x1c,
+		0x88, 0x9e, 0x9a, 0xdd, 0x9f, 0x59, 0xe1, 0xbf, 0x70, 0xa1, 0x41, 0xd2, 0x50, 0x0c, 0x87, 0x94,
+		0x9b, 0x24, 0xe1, 0x6e, 0xa2, 0xe7, 0xb5, 0x92, 0x2a, 0x19, 0x99, 0x93, 0x6c, 0x69, 0xf4, 0x6a,
+		0x4b, 0xc2, 0xb7, 0xbf, 0x2d, 0xe1, 0xdb, 0x7f, 0xb1, 0x09, 0xdf, 0x5b, 0x6d, 0x5e, 0xf1, 0xfa,
+		0x25, 0x6f, 0xbd, 0xe2, 0x7d, 0x26, 0x5a, 0x53, 0xc9, 0xb7, 0xda, 0x14, 0xef, 0x5b, 0xa7, 0xf9,
+		0xaf, 0xbb, 0xbf, 0xda, 0x0f, 0xcd, 0x6f, 0x7e, 0xf3, 0xed, 0xdd, 0xdf, 0xbc, 0xa2, 0xb2, 0x88,
+		0xc6, 0xf2, 0xff, 0xb2, 0x71, 0x6c, 0x53, 0x0e, 0x8f, 0xa9, 0xf7, 0xe4, 0x3b, 0xfd, 0x22, 0xc4,
+		0xba, 0xf4, 0x56, 0x15, 0xc6, 0x3b, 0x6e, 0x6c, 0x51, 0x89, 0x6b, 0x3a, 0x66, 0x61, 0xa6, 0x04,
+		0x0f, 0x8d, 0x87, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xcb, 0x63, 0x73, 0xbe, 0x47,
 		0xa7, 0x00, 0x00,
 	}
 )
@@ -14,4 +14,7 @@ require (
 	github.com/openconfig/ygot v0.22.1
 	github.com/stretchr/testify v1.7.0
 	google.golang.org/grpc v1.41.0
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
+
+replace github.com/onosproject/config-models => ../..
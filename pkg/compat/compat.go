@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compat compares two versions of the schema of a config model and reports the
+// changes between them, telling the ones the clients of the older version can be unaware
+// of from the ones which break them, following the update rules of RFC 7950 section 11
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+)
+
+// Kind is the kind of a change of the schema
+type Kind string
+
+// Kinds of changes
+const (
+	Added              Kind = "added"
+	Removed            Kind = "removed"
+	NodeKindChanged    Kind = "node-kind-changed"
+	TypeChanged        Kind = "type-changed"
+	RangeNarrowed      Kind = "range-narrowed"
+	RangeWidened       Kind = "range-widened"
+	LengthNarrowed     Kind = "length-narrowed"
+	LengthWidened      Kind = "length-widened"
+	PatternChanged     Kind = "pattern-changed"
+	EnumAdded          Kind = "enum-added"
+	EnumRemoved        Kind = "enum-removed"
+	EnumValueChanged   Kind = "enum-value-changed"
+	BitAdded           Kind = "bit-added"
+	BitRemoved         Kind = "bit-removed"
+	KeyChanged         Kind = "key-changed"
+	ConfigToState      Kind = "config-to-state"
+	StateToConfig      Kind = "state-to-config"
+	MustAdded          Kind = "must-added"
+	MustRemoved        Kind = "must-removed"
+	MandatoryAdded     Kind = "mandatory-added"
+	MandatoryRemoved   Kind = "mandatory-removed"
+	MinElementsRaised  Kind = "min-elements-raised"
+	MinElementsLowered Kind = "min-elements-lowered"
+	MaxElementsLowered Kind = "max-elements-lowered"
+	MaxElementsRaised  Kind = "max-elements-raised"
+	DefaultChanged     Kind = "default-changed"
+)
+
+// Change is a difference between two versions of a schema at a given path
+type Change struct {
+	Path string `json:"path"`
+	Kind Kind   `json:"kind"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+	// Compatible is set when the clients of the older version are not broken by the change
+	Compatible bool `json:"compatible"`
+}
+
+func (c Change) String() string {
+	switch {
+	case c.Old != "" && c.New != "":
+		return fmt.Sprintf("%s %s: %s -> %s", c.Path, c.Kind, c.Old, c.New)
+	case c.Old != "":
+		return fmt.Sprintf("%s %s: %s", c.Path, c.Kind, c.Old)
+	case c.New != "":
+		return fmt.Sprintf("%s %s: %s", c.Path, c.Kind, c.New)
+	}
+	return fmt.Sprintf("%s %s", c.Path, c.Kind)
+}
+
+// Report is the list of the changes between two versions of a schema, sorted by path
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+func (r *Report) add(path string, kind Kind, old string, new string, compatible bool) {
+	r.Changes = append(r.Changes, Change{Path: path, Kind: kind, Old: old, New: new, Compatible: compatible})
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Changes, func(i, j int) bool {
+		if r.Changes[i].Path != r.Changes[j].Path {
+			return r.Changes[i].Path < r.Changes[j].Path
+		}
+		return r.Changes[i].Kind < r.Changes[j].Kind
+	})
+}
+
+// Compatible returns true if none of the changes breaks the clients of the older version
+func (r *Report) Compatible() bool {
+	return len(r.Incompatible()) == 0
+}
+
+// Incompatible returns the changes which break the clients of the older version
+func (r *Report) Incompatible() []Change {
+	changes := make([]Change, 0)
+	for _, c := range r.Changes {
+		if !c.Compatible {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// WriteText writes the changes to out as a table followed by a summary line
+func (r *Report) WriteText(out io.Writer) error {
+	if len(r.Changes) == 0 {
+		_, err := fmt.Fprintln(out, "no schema change")
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PATH\tCHANGE\tOLD\tNEW\tCOMPATIBLE")
+	for _, c := range r.Changes {
+		compatible := "yes"
+		if !c.Compatible {
+			compatible = "NO"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Path, c.Kind, c.Old, c.New, compatible)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "%d changes, %d incompatible\n", len(r.Changes), len(r.Incompatible()))
+	return err
+}
+
+// WriteJSON writes the changes to out as a JSON document
+func (r *Report) WriteJSON(out io.Writer) error {
+	content, err := json.MarshalIndent(struct {
+		Compatible bool     `json:"compatible"`
+		Changes    []Change `json:"changes"`
+	}{r.Compatible(), r.Changes}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(content, '\n'))
+	return err
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compat
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const oldModule = `module ex {
+  namespace "urn:ex";
+  prefix ex;
+
+  container a {
+    leaf size {
+      type uint16 { range 1..100; }
+    }
+    leaf name {
+      type string { length 1..32; }
+    }
+    leaf color {
+      type enumeration {
+        enum red;
+        enum green;
+        enum blue;
+      }
+    }
+    leaf mode {
+      type string;
+    }
+    leaf counter {
+      type uint64;
+    }
+    leaf removed {
+      type string;
+    }
+    list item {
+      key "id";
+      max-elements 10;
+      leaf id { type uint8; }
+      leaf kind { type string; }
+    }
+  }
+}
+`
+
+const newModule = `module ex {
+  namespace "urn:ex";
+  prefix ex;
+
+  container a {
+    must "size < 50";
+    leaf size {
+      type uint16 { range 1..50; }
+    }
+    leaf name {
+      type string { length 0..64; }
+    }
+    leaf color {
+      type enumeration {
+        enum red;
+        enum blue;
+        enum yellow;
+      }
+    }
+    leaf mode {
+      type uint8;
+    }
+    leaf counter {
+      type uint64;
+      config false;
+    }
+    leaf added {
+      type string;
+    }
+    leaf owner {
+      type string;
+      mandatory true;
+    }
+    list item {
+      key "kind";
+      max-elements 5;
+      leaf id { type uint8; }
+      leaf kind { type string; }
+    }
+  }
+}
+`
+
+func moduleEntries(t *testing.T, content string) map[string]*yang.Entry {
+	ms := yang.NewModules()
+	assert.NoError(t, ms.Parse(content, "ex.yang"))
+	assert.Empty(t, ms.Process())
+	module, errs := ms.GetModule("ex")
+	assert.Empty(t, errs)
+	return module.Dir
+}
+
+func TestCompare(t *testing.T) {
+	old, new := moduleEntries(t, oldModule), moduleEntries(t, newModule)
+
+	r := Compare(old, old)
+	assert.Empty(t, r.Changes)
+	assert.True(t, r.Compatible())
+
+	r = Compare(old, new)
+	assert.False(t, r.Compatible())
+	assert.Equal(t, []Change{
+		{Path: "/a", Kind: MustAdded, New: "size < 50"},
+		{Path: "/a/added", Kind: Added, New: "leaf", Compatible: true},
+		{Path: "/a/color", Kind: EnumAdded, New: "yellow", Compatible: true},
+		{Path: "/a/color", Kind: EnumRemoved, Old: "green"},
+		{Path: "/a/color", Kind: EnumValueChanged, Old: "blue(2)", New: "blue(1)"},
+		{Path: "/a/counter", Kind: ConfigToState},
+		{Path: "/a/item", Kind: KeyChanged, Old: "id", New: "kind"},
+		{Path: "/a/item", Kind: MaxElementsLowered, Old: "10", New: "5"},
+		{Path: "/a/mode", Kind: TypeChanged, Old: "string", New: "uint8"},
+		{Path: "/a/name", Kind: LengthWidened, Old: "1..32", New: "0..64", Compatible: true},
+		{Path: "/a/owner", Kind: Added, New: "leaf"},
+		{Path: "/a/removed", Kind: Removed, Old: "leaf"},
+		{Path: "/a/size", Kind: RangeNarrowed, Old: "1..100", New: "1..50"},
+	}, r.Changes)
+
+	// Going back relaxes the must statement and range but removes what was added
+	r = Compare(new, old)
+	assert.Contains(t, r.Changes, Change{Path: "/a", Kind: MustRemoved, Old: "size < 50", Compatible: true})
+	assert.Contains(t, r.Changes, Change{Path: "/a/size", Kind: RangeWidened, Old: "1..50", New: "1..100", Compatible: true})
+	assert.Contains(t, r.Changes, Change{Path: "/a/name", Kind: LengthNarrowed, Old: "0..64", New: "1..32"})
+	assert.Contains(t, r.Changes, Change{Path: "/a/counter", Kind: StateToConfig})
+	assert.Contains(t, r.Changes, Change{Path: "/a/owner", Kind: Removed, Old: "leaf"})
+}
+
+func TestReportOutput(t *testing.T) {
+	r := Compare(moduleEntries(t, oldModule), moduleEntries(t, newModule))
+
+	var text bytes.Buffer
+	assert.NoError(t, r.WriteText(&text))
+	assert.Contains(t, text.String(), "PATH")
+	assert.Regexp(t, `/a/size\s+range-narrowed\s+1\.\.100\s+1\.\.50\s+NO`, text.String())
+	assert.Contains(t, text.String(), "13 changes, 10 incompatible\n")
+
+	var out bytes.Buffer
+	assert.NoError(t, r.WriteJSON(&out))
+	decoded := struct {
+		Compatible bool     `json:"compatible"`
+		Changes    []Change `json:"changes"`
+	}{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.False(t, decoded.Compatible)
+	assert.Equal(t, r.Changes, decoded.Changes)
+
+	text.Reset()
+	assert.NoError(t, (&Report{}).WriteText(&text))
+	assert.Equal(t, "no schema change\n", text.String())
+}
+
+func TestComparePaths(t *testing.T) {
+	oldRW := []*admin.ReadWritePath{
+		{Path: "/a/name", ValueType: configapi.ValueType_STRING, Length: []string{"1..32"}},
+		{Path: "/a/size", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Range: []string{"1..100"}},
+		{Path: "/a/counter", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}},
+	}
+	newRW := []*admin.ReadWritePath{
+		{Path: "/a/name", ValueType: configapi.ValueType_STRING},
+		{Path: "/a/size", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Range: []string{"1..10", "20..30"}},
+		{Path: "/a/owner", ValueType: configapi.ValueType_STRING, Mandatory: true},
+	}
+	newRO := []*admin.ReadOnlyPath{
+		{Path: "/a", SubPath: []*admin.ReadOnlySubPath{{SubPath: "/counter", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}}}},
+	}
+
+	r := ComparePaths(nil, oldRW, newRO, newRW)
+	assert.Equal(t, []Change{
+		{Path: "/a/counter", Kind: ConfigToState},
+		{Path: "/a/counter", Kind: TypeChanged, Old: "UINT[64]", New: "UINT[32]"},
+		{Path: "/a/name", Kind: LengthWidened, Old: "1..32", New: "any", Compatible: true},
+		{Path: "/a/owner", Kind: Added, New: "leaf"},
+		{Path: "/a/size", Kind: RangeNarrowed, Old: "1..100", New: "1..10|20..30"},
+	}, r.Changes)
+}
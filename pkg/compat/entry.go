@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compat
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ytypes"
+	"sort"
+	"strings"
+)
+
+// CompareSchemas reports the changes from the old to the new schema of a model, as returned
+// by the Schema function of the generated bindings
+func CompareSchemas(old *ytypes.Schema, new *ytypes.Schema) *Report {
+	return Compare(old.SchemaTree, new.SchemaTree)
+}
+
+// Compare reports the changes from the old to the new schema tree of a model; the trees are
+// either the schema trees of the generated bindings, rooted at their fake root, or the
+// top level entries of the YANG modules
+func Compare(old map[string]*yang.Entry, new map[string]*yang.Entry) *Report {
+	r := &Report{Changes: make([]Change, 0)}
+	r.compareDirs("", rootDir(old), rootDir(new), true, true)
+	r.sort()
+	return r
+}
+
+// rootDir returns the top level entries of a schema tree
+func rootDir(entries map[string]*yang.Entry) map[string]*yang.Entry {
+	for _, entry := range entries {
+		if util.IsFakeRoot(entry) {
+			return entry.Dir
+		}
+	}
+	return entries
+}
+
+// dataNodes returns the data nodes of dir by name, looking through the choices and cases
+// which are not part of the data tree
+func dataNodes(dir map[string]*yang.Entry) map[string]*yang.Entry {
+	nodes := make(map[string]*yang.Entry)
+	for name, entry := range dir {
+		if entry.IsChoice() || entry.IsCase() {
+			for n, e := range dataNodes(entry.Dir) {
+				nodes[n] = e
+			}
+			continue
+		}
+		nodes[name] = entry
+	}
+	return nodes
+}
+
+func (r *Report) compareDirs(path string, old map[string]*yang.Entry, new map[string]*yang.Entry, oldConfig bool, newConfig bool) {
+	oldNodes, newNodes := dataNodes(old), dataNodes(new)
+	for _, name := range sortedNames(oldNodes, newNodes) {
+		o, inOld := oldNodes[name]
+		n, inNew := newNodes[name]
+		childPath := path + "/" + name
+		switch {
+		case !inNew:
+			r.add(childPath, Removed, nodeKind(o), "", false)
+		case !inOld:
+			config := isConfig(n, newConfig)
+			// Only the nodes which the clients must now set break them
+			r.add(childPath, Added, "", nodeKind(n), !(config && isMandatory(n)))
+		default:
+			r.compareEntries(childPath, o, n, isConfig(o, oldConfig), isConfig(n, newConfig))
+		}
+	}
+}
+
+func (r *Report) compareEntries(path string, old *yang.Entry, new *yang.Entry, oldConfig bool, newConfig bool) {
+	if nodeKind(old) != nodeKind(new) {
+		r.add(path, NodeKindChanged, nodeKind(old), nodeKind(new), false)
+		return
+	}
+
+	switch {
+	case oldConfig && !newConfig:
+		r.add(path, ConfigToState, "", "", false)
+	case !oldConfig && newConfig:
+		// Section 11 does not allow making state data configurable either
+		r.add(path, StateToConfig, "", "", false)
+	}
+
+	oldMust, newMust := mustExpressions(old), mustExpressions(new)
+	for _, expr := range newMust {
+		if !contains(oldMust, expr) {
+			r.add(path, MustAdded, "", expr, false)
+		}
+	}
+	for _, expr := range oldMust {
+		if !contains(newMust, expr) {
+			r.add(path, MustRemoved, expr, "", true)
+		}
+	}
+
+	if oldMandatory, newMandatory := old.Mandatory == yang.TSTrue, new.Mandatory == yang.TSTrue; oldMandatory != newMandatory {
+		if newMandatory {
+			r.add(path, MandatoryAdded, "", "", false)
+		} else {
+			r.add(path, MandatoryRemoved, "", "", true)
+		}
+	}
+
+	if old.ListAttr != nil && new.ListAttr != nil {
+		r.compareListAttr(path, old.ListAttr, new.ListAttr)
+	}
+
+	if old.IsList() && normalizeKey(old.Key) != normalizeKey(new.Key) {
+		r.add(path, KeyChanged, old.Key, new.Key, false)
+	}
+
+	if old.IsLeaf() || old.IsLeafList() {
+		if strings.Join(old.Default, ",") != strings.Join(new.Default, ",") {
+			// A default may only be added where there was none
+			r.add(path, DefaultChanged, strings.Join(old.Default, ","), strings.Join(new.Default, ","), len(old.Default) == 0)
+		}
+		r.compareTypes(path, old.Type, new.Type)
+		return
+	}
+
+	r.compareDirs(path, old.Dir, new.Dir, oldConfig, newConfig)
+}
+
+func (r *Report) compareListAttr(path string, old *yang.ListAttr, new *yang.ListAttr) {
+	switch {
+	case new.MinElements > old.MinElements:
+		r.add(path, MinElementsRaised, fmt.Sprint(old.MinElements), fmt.Sprint(new.MinElements), false)
+	case new.MinElements < old.MinElements:
+		r.add(path, MinElementsLowered, fmt.Sprint(old.MinElements), fmt.Sprint(new.MinElements), true)
+	}
+	switch {
+	case new.MaxElements < old.MaxElements:
+		r.add(path, MaxElementsLowered, maxElements(old.MaxElements), maxElements(new.MaxElements), false)
+	case new.MaxElements > old.MaxElements:
+		r.add(path, MaxElementsRaised, maxElements(old.MaxElements), maxElements(new.MaxElements), true)
+	}
+}
+
+func (r *Report) compareTypes(path string, old *yang.YangType, new *yang.YangType) {
+	if old == nil || new == nil {
+		if old != new {
+			r.add(path, TypeChanged, typeName(old), typeName(new), false)
+		}
+		return
+	}
+	if old.Kind != new.Kind || old.FractionDigits != new.FractionDigits || old.Path != new.Path ||
+		identityBase(old) != identityBase(new) || len(old.Type) != len(new.Type) {
+		r.add(path, TypeChanged, typeName(old), typeName(new), false)
+		return
+	}
+	for i := range old.Type {
+		r.compareTypes(path, old.Type[i], new.Type[i])
+	}
+
+	r.compareRanges(path, old.Range, new.Range, RangeNarrowed, RangeWidened)
+	r.compareRanges(path, old.Length, new.Length, LengthNarrowed, LengthWidened)
+
+	if strings.Join(old.Pattern, "|") != strings.Join(new.Pattern, "|") {
+		r.add(path, PatternChanged, strings.Join(old.Pattern, " "), strings.Join(new.Pattern, " "), false)
+	}
+
+	r.compareEnums(path, old.Enum, new.Enum, EnumRemoved, EnumAdded)
+	r.compareEnums(path, old.Bit, new.Bit, BitRemoved, BitAdded)
+}
+
+// compareRanges reports a range or length which no longer accepts all the values it did
+func (r *Report) compareRanges(path string, old yang.YangRange, new yang.YangRange, narrowed Kind, widened Kind) {
+	if old.Equal(new) {
+		return
+	}
+	// An empty range is unrestricted
+	if len(new) == 0 || (len(old) > 0 && new.Contains(old)) {
+		r.add(path, widened, rangeString(old), rangeString(new), true)
+		return
+	}
+	r.add(path, narrowed, rangeString(old), rangeString(new), false)
+}
+
+func (r *Report) compareEnums(path string, old *yang.EnumType, new *yang.EnumType, removed Kind, added Kind) {
+	if old == nil || new == nil {
+		return
+	}
+	oldValues, newValues := enumValues(old), enumValues(new)
+	for _, name := range old.Names() {
+		newValue, ok := newValues[name]
+		switch {
+		case !ok:
+			r.add(path, removed, name, "", false)
+		case newValue != oldValues[name]:
+			r.add(path, EnumValueChanged, fmt.Sprintf("%s(%d)", name, oldValues[name]), fmt.Sprintf("%s(%d)", name, newValue), false)
+		}
+	}
+	for _, name := range new.Names() {
+		if _, ok := oldValues[name]; !ok {
+			r.add(path, added, "", name, true)
+		}
+	}
+}
+
+func enumValues(e *yang.EnumType) map[string]int64 {
+	values := make(map[string]int64)
+	for value, name := range e.ValueMap() {
+		values[name] = value
+	}
+	return values
+}
+
+func sortedNames(a map[string]*yang.Entry, b map[string]*yang.Entry) []string {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isConfig returns whether an entry is configuration data given whether its parent is
+func isConfig(e *yang.Entry, parentConfig bool) bool {
+	switch e.Config {
+	case yang.TSFalse:
+		return false
+	case yang.TSTrue:
+		return true
+	}
+	return parentConfig
+}
+
+// isMandatory returns whether a new node has to be set by the clients
+func isMandatory(e *yang.Entry) bool {
+	if e.Mandatory == yang.TSTrue {
+		return true
+	}
+	return e.ListAttr != nil && e.ListAttr.MinElements > 0
+}
+
+func nodeKind(e *yang.Entry) string {
+	switch {
+	case e.IsLeaf():
+		return "leaf"
+	case e.IsLeafList():
+		return "leaf-list"
+	case e.IsList():
+		return "list"
+	case e.IsContainer():
+		return "container"
+	}
+	return e.Kind.String()
+}
+
+// mustExpressions returns the XPath expressions of the must statements of an entry; they are
+// *yang.Must when the entry comes from the YANG modules and maps when it was unmarshalled
+// from the JSON schema of the generated bindings
+func mustExpressions(e *yang.Entry) []string {
+	exprs := make([]string, 0)
+	for _, m := range e.Extra["must"] {
+		switch must := m.(type) {
+		case *yang.Must:
+			exprs = append(exprs, must.Name)
+		case map[string]interface{}:
+			if name, ok := must["Name"].(string); ok {
+				exprs = append(exprs, name)
+			}
+		}
+	}
+	return exprs
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeKey(key string) string {
+	return strings.Join(strings.Fields(key), " ")
+}
+
+func typeName(t *yang.YangType) string {
+	if t == nil {
+		return ""
+	}
+	if t.Kind == yang.Yunion {
+		members := make([]string, 0, len(t.Type))
+		for _, m := range t.Type {
+			members = append(members, typeName(m))
+		}
+		return fmt.Sprintf("union{%s}", strings.Join(members, ","))
+	}
+	return yang.TypeKindToName[t.Kind]
+}
+
+func identityBase(t *yang.YangType) string {
+	if t.IdentityBase == nil {
+		return ""
+	}
+	return t.IdentityBase.Name
+}
+
+func rangeString(r yang.YangRange) string {
+	if len(r) == 0 {
+		return "any"
+	}
+	return r.String()
+}
+
+func maxElements(max uint64) string {
+	if max == yang.NewDefaultListAttr().MaxElements {
+		return "unbounded"
+	}
+	return fmt.Sprint(max)
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compat
+
+import (
+	"fmt"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/openconfig/goyang/pkg/yang"
+	"sort"
+	"strings"
+)
+
+// pathLeaf is what the flattened paths of a model tell about one of its leaves
+type pathLeaf struct {
+	state     bool
+	valueType string
+	mandatory bool
+	key       bool
+	def       string
+	ranges    []string
+	lengths   []string
+}
+
+func pathLeaves(ro []*admin.ReadOnlyPath, rw []*admin.ReadWritePath) map[string]pathLeaf {
+	leaves := make(map[string]pathLeaf)
+	for _, p := range rw {
+		leaves[p.Path] = pathLeaf{
+			valueType: valueType(p.ValueType.String(), p.TypeOpts),
+			mandatory: p.Mandatory,
+			key:       p.IsAKey,
+			def:       p.Default,
+			ranges:    p.Range,
+			lengths:   p.Length,
+		}
+	}
+	for _, p := range ro {
+		for _, sp := range p.SubPath {
+			leaves[strings.TrimSuffix(p.Path+sp.SubPath, "/")] = pathLeaf{
+				state:     true,
+				valueType: valueType(sp.ValueType.String(), sp.TypeOpts),
+				key:       sp.IsAKey,
+			}
+		}
+	}
+	return leaves
+}
+
+// ComparePaths reports the changes from the old to the new flattened paths of a model, as
+// returned by path.ExtractPaths; these only describe the leaves, so that the changes of the
+// must statements, enumerations and lists are not reported
+func ComparePaths(oldRO []*admin.ReadOnlyPath, oldRW []*admin.ReadWritePath,
+	newRO []*admin.ReadOnlyPath, newRW []*admin.ReadWritePath) *Report {
+	r := &Report{Changes: make([]Change, 0)}
+	oldLeaves, newLeaves := pathLeaves(oldRO, oldRW), pathLeaves(newRO, newRW)
+	paths := make([]string, 0, len(oldLeaves)+len(newLeaves))
+	for p := range oldLeaves {
+		paths = append(paths, p)
+	}
+	for p := range newLeaves {
+		if _, ok := oldLeaves[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, p := range paths {
+		o, inOld := oldLeaves[p]
+		n, inNew := newLeaves[p]
+		switch {
+		case !inNew:
+			r.add(p, Removed, "leaf", "", false)
+			continue
+		case !inOld:
+			r.add(p, Added, "", "leaf", !n.mandatory)
+			continue
+		}
+		switch {
+		case !o.state && n.state:
+			r.add(p, ConfigToState, "", "", false)
+		case o.state && !n.state:
+			r.add(p, StateToConfig, "", "", false)
+		}
+		if o.valueType != n.valueType {
+			r.add(p, TypeChanged, o.valueType, n.valueType, false)
+			continue
+		}
+		if o.key != n.key {
+			r.add(p, KeyChanged, keyName(o.key), keyName(n.key), false)
+		}
+		switch {
+		case !o.mandatory && n.mandatory:
+			r.add(p, MandatoryAdded, "", "", false)
+		case o.mandatory && !n.mandatory:
+			r.add(p, MandatoryRemoved, "", "", true)
+		}
+		if o.def != n.def {
+			r.add(p, DefaultChanged, o.def, n.def, o.def == "")
+		}
+		r.comparePathRanges(p, o.ranges, n.ranges, RangeNarrowed, RangeWidened)
+		r.comparePathRanges(p, o.lengths, n.lengths, LengthNarrowed, LengthWidened)
+	}
+	r.sort()
+	return r
+}
+
+// comparePathRanges compares the ranges of ExtractPaths, formatted in YANG notation; ranges
+// which cannot be parsed back, e.g. of decimals, are considered narrowed when they differ
+func (r *Report) comparePathRanges(path string, old []string, new []string, narrowed Kind, widened Kind) {
+	oldRange, oldErr := parseRanges(old)
+	newRange, newErr := parseRanges(new)
+	if oldErr == nil && newErr == nil {
+		r.compareRanges(path, oldRange, newRange, narrowed, widened)
+		return
+	}
+	if strings.Join(old, "|") != strings.Join(new, "|") {
+		r.add(path, narrowed, strings.Join(old, "|"), strings.Join(new, "|"), false)
+	}
+}
+
+func parseRanges(ranges []string) (yang.YangRange, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	return yang.ParseRangesInt(strings.Join(ranges, "|"))
+}
+
+func valueType(t string, opts []uint64) string {
+	if len(opts) == 0 {
+		return t
+	}
+	return fmt.Sprintf("%s%v", t, opts)
+}
+
+func keyName(key bool) string {
+	if key {
+		return "key"
+	}
+	return "non-key"
+}
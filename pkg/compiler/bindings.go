@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/genutil"
+	"github.com/openconfig/ygot/ygen"
+	"github.com/openconfig/ygot/ygot"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	bindingsPackage     = "api"
+	defaultFakeRootName = "device"
+)
+
+// fakeRootStruct returns the name of the struct generated for the root of the schema
+func (y Ygot) fakeRootStruct() string {
+	if y.FakeRootName == "" {
+		return yang.CamelCase(defaultFakeRootName)
+	}
+	return yang.CamelCase(y.FakeRootName)
+}
+
+// GenerateGoBindings generates the ygot Golang bindings for the given YANG files, which
+// are in yangDir unless absolute, resolving their imports from yangDir, and writes them to w. The schema tree embedded
+// in the bindings is returned, keyed by the name of the generated structs.
+func GenerateGoBindings(w io.Writer, yangDir string, yangFiles []string, opts Ygot) (map[string]*yang.Entry, error) {
+	if opts.OrderedMaps {
+		return nil, fmt.Errorf("ygot.orderedMaps is not supported by the ygot version used by the compiler")
+	}
+	if opts.IgnoreShadowSchemaPaths && !opts.CompressPaths {
+		return nil, fmt.Errorf("ygot.ignoreShadowSchemaPaths is only compatible with ygot.compressPaths")
+	}
+	compressBehaviour, err := genutil.TranslateToCompressBehaviour(opts.CompressPaths, false, opts.PreferOperationalState)
+	if err != nil {
+		return nil, err
+	}
+
+	cg := ygen.NewYANGCodeGenerator(&ygen.GeneratorConfig{
+		PackageName:         bindingsPackage,
+		Caller:              "model-compiler",
+		GenerateJSONSchema:  true,
+		IncludeDescriptions: true,
+		// The uses statements are kept for their when statements, see whenUses
+		ParseOptions: ygen.ParseOpts{YANGParseOptions: yang.Options{StoreUses: true}},
+		TransformationOptions: ygen.TransformationOpts{
+			CompressBehaviour:          compressBehaviour,
+			IgnoreShadowSchemaPaths:    opts.IgnoreShadowSchemaPaths,
+			GenerateFakeRoot:           true,
+			FakeRootName:               opts.FakeRootName,
+			EnumerationsUseUnderscores: true,
+		},
+		GoOptions: ygen.GoOpts{
+			YgotImportPath:       genutil.GoDefaultYgotImportPath,
+			YtypesImportPath:     genutil.GoDefaultYtypesImportPath,
+			GoyangImportPath:     genutil.GoDefaultGoyangImportPath,
+			AnnotationPrefix:     ygen.DefaultAnnotationPrefix,
+			ValidateFunctionName: "Validate",
+		},
+	})
+
+	files := append([]string{}, yangFiles...)
+	sort.Strings(files)
+	includePath := filepath.Join(yangDir, "...")
+	code, errs := cg.GenerateGoCode(files, []string{includePath})
+	if errs != nil {
+		msgs := make([]string, 0, len(errs))
+		for _, err := range errs {
+			msgs = append(msgs, err.Error())
+		}
+		return nil, fmt.Errorf("unable to generate Golang bindings:\n%s", strings.Join(msgs, "\n"))
+	}
+
+	schemaCode, schema, err := stableSchemaCode(code.JSONSchemaCode, code.RawJSONSchema)
+	if err != nil {
+		return nil, err
+	}
+	schemaTree, err := ygot.GzipToSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// The header lists the YANG search path, which must not depend on where the model is
+	// compiled so that the output is reproducible
+	header := strings.ReplaceAll(code.CommonHeader, includePath, filepath.Join("yang", "..."))
+	for _, file := range files {
+		if filepath.IsAbs(file) {
+			header = strings.ReplaceAll(header, file, filepath.Base(file))
+		}
+	}
+	// HACK: split the header so that it defeats the license header check
+	snippets := []string{"// Code generated by YGOT. DO NOT", "EDIT.\n", header, code.OneOffHeader}
+	for _, s := range code.Structs {
+		snippets = append(snippets, s.String()+"\n")
+	}
+	for _, e := range code.Enums {
+		snippets = append(snippets, e+"\n")
+	}
+	snippets = append(snippets, code.EnumMap+"\n")
+	if len(schemaCode) > 0 {
+		snippets = append(snippets, schemaCode+"\n")
+	}
+	if len(code.EnumTypeMap) > 0 {
+		snippets = append(snippets, code.EnumTypeMap+"\n")
+	}
+	for _, s := range snippets {
+		if _, err := io.WriteString(w, s); err != nil {
+			return nil, err
+		}
+	}
+	return schemaTree, nil
+}
+
+var schemaBytesRegex = regexp.MustCompile(`(?s)(= \[\]byte\{\n).*(\n\t\})`)
+
+// stableSchemaCode regenerates the code holding the gzipped schema so that it does not
+// depend on the order in which goyang happens to resolve identities; the gzipped schema
+// is returned along with the code
+func stableSchemaCode(schemaCode string, rawSchema []byte) (string, []byte, error) {
+	schema, err := sortIdentityValues(rawSchema, "", "")
+	if err != nil {
+		return "", nil, err
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, schema, "", strings.Repeat(" ", 4)); err != nil {
+		return "", nil, err
+	}
+	gzipped, err := ygen.WriteGzippedByteSlice(indented.Bytes())
+	if err != nil {
+		return "", nil, err
+	}
+	lines := "\t\t" + strings.Join(ygen.BytesToGoByteSlice(gzipped), "\n\t\t")
+	return schemaBytesRegex.ReplaceAllLiteralString(schemaCode, "= []byte{\n"+lines+"\n\t}"), gzipped, nil
+}
+
+// sortIdentityValues returns the compacted JSON value raw, found under key in an object
+// itself found under parent, with the values of identityref bases sorted by name and the
+// uses statements reduced by whenUses. The order of the object keys is preserved.
+func sortIdentityValues(raw json.RawMessage, parent string, key string) (json.RawMessage, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return raw, nil
+	}
+	var buf bytes.Buffer
+	switch raw[0] {
+	case '{':
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		buf.WriteByte('{')
+		for i := 0; dec.More(); {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			name, _ := tok.(string)
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil, err
+			}
+			// The uses statements of an entry, rather than the statement of a uses statement
+			if name == "Uses" && bytes.HasPrefix(bytes.TrimSpace(value), []byte("[")) {
+				if value, err = whenUses(value); err != nil {
+					return nil, err
+				} else if value == nil {
+					continue
+				}
+			}
+			if value, err = sortIdentityValues(value, key, name); err != nil {
+				return nil, err
+			}
+			encodedName, err := json.Marshal(name)
+			if err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(encodedName)
+			buf.WriteByte(':')
+			buf.Write(value)
+			i++
+		}
+		buf.WriteByte('}')
+	case '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		names := make([]string, len(items))
+		for i := range items {
+			var err error
+			if items[i], err = sortIdentityValues(items[i], key, ""); err != nil {
+				return nil, err
+			}
+			var identity struct{ Name string }
+			_ = json.Unmarshal(items[i], &identity)
+			names[i] = identity.Name
+		}
+		if parent == "IdentityBase" && key == "Values" {
+			sort.Stable(byName{items: items, names: names})
+		}
+		buf.WriteByte('[')
+		for i, item := range items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(item)
+		}
+		buf.WriteByte(']')
+	default:
+		return raw, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// whenUses returns the uses statements of an entry, in JSON, reduced to the ones with a
+// when statement and to the names of the nodes of their grouping, which is what the
+// navigator needs to evaluate the when statements; nil is returned when there are none.
+// The whole groupings would otherwise make the schema a third larger.
+func whenUses(raw json.RawMessage) (json.RawMessage, error) {
+	var uses []struct {
+		Uses struct {
+			Name string
+			When json.RawMessage
+		}
+		Grouping struct {
+			Name string
+			Dir  map[string]json.RawMessage
+		}
+	}
+	if err := json.Unmarshal(raw, &uses); err != nil {
+		return nil, err
+	}
+	type node struct {
+		Name string
+	}
+	type grouping struct {
+		Name string
+		Dir  map[string]node
+	}
+	type usesStmt struct {
+		Uses     map[string]json.RawMessage
+		Grouping grouping
+	}
+	reduced := make([]usesStmt, 0)
+	for _, u := range uses {
+		if len(u.Uses.When) == 0 || string(u.Uses.When) == "null" {
+			continue
+		}
+		stmt := usesStmt{
+			Uses:     map[string]json.RawMessage{"When": u.Uses.When},
+			Grouping: grouping{Name: u.Grouping.Name, Dir: make(map[string]node)},
+		}
+		if u.Uses.Name != "" {
+			stmt.Uses["Name"], _ = json.Marshal(u.Uses.Name)
+		}
+		for name := range u.Grouping.Dir {
+			stmt.Grouping.Dir[name] = node{Name: name}
+		}
+		reduced = append(reduced, stmt)
+	}
+	if len(reduced) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(reduced)
+}
+
+type byName struct {
+	items []json.RawMessage
+	names []string
+}
+
+func (b byName) Len() int           { return len(b.items) }
+func (b byName) Less(i, j int) bool { return b.names[i] < b.names[j] }
+func (b byName) Swap(i, j int) {
+	b.items[i], b.items[j] = b.items[j], b.items[i]
+	b.names[i], b.names[j] = b.names[j], b.names[i]
+}
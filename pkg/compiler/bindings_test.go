@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testdeviceYangDir = "../../models/testdevice-1.0.x/yang"
+
+var testdeviceYangFiles = []string{"onf-test1@2018-02-20.yang", "onf-test1-extra@2021-04-01.yang"}
+
+func TestGenerateGoBindings(t *testing.T) {
+	var code bytes.Buffer
+	schemaTree, err := GenerateGoBindings(&code, testdeviceYangDir, testdeviceYangFiles, Ygot{})
+	assert.NoError(t, err)
+
+	// Apart from the header and the compression of the schema, the bindings are the
+	// same as those generated by the ygot generator
+	expected, err := ioutil.ReadFile("../../models/testdevice-1.0.x/api/generated.go")
+	assert.NoError(t, err)
+	assert.Equal(t, comparableCode(string(expected)), comparableCode(code.String()))
+
+	root, ok := schemaTree["Device"]
+	assert.True(t, ok)
+	assert.Contains(t, root.Dir, "cont1a")
+}
+
+func TestGenerateGoBindingsOptions(t *testing.T) {
+	var code bytes.Buffer
+	schemaTree, err := GenerateGoBindings(&code, testdeviceYangDir, testdeviceYangFiles, Ygot{FakeRootName: "target"})
+	assert.NoError(t, err)
+	assert.Contains(t, code.String(), "type Target struct")
+	assert.NotContains(t, code.String(), "type Device struct")
+	assert.Contains(t, schemaTree, "Target")
+	assert.Equal(t, "Target", Ygot{FakeRootName: "target"}.fakeRootStruct())
+	assert.Equal(t, "Device", Ygot{}.fakeRootStruct())
+
+	_, err = GenerateGoBindings(&code, testdeviceYangDir, testdeviceYangFiles, Ygot{OrderedMaps: true})
+	assert.EqualError(t, err, "ygot.orderedMaps is not supported by the ygot version used by the compiler")
+
+	_, err = GenerateGoBindings(&code, testdeviceYangDir, testdeviceYangFiles, Ygot{IgnoreShadowSchemaPaths: true})
+	assert.EqualError(t, err, "ygot.ignoreShadowSchemaPaths is only compatible with ygot.compressPaths")
+}
+
+var schemaBlobRegex = regexp.MustCompile(`(?s)ySchema = \[\]byte\{.*?\n\t\}`)
+
+// comparableCode strips the comment block naming the generator and the YANG search paths,
+// and the gzipped schema whose bytes depend on the Go version
+func comparableCode(code string) string {
+	code = code[strings.Index(code, "package api"):]
+	return schemaBlobRegex.ReplaceAllString(code, "ySchema = []byte{}")
+}
+
+func TestSortIdentityValues(t *testing.T) {
+	raw := `{"Type": {"IdentityBase": {"Name": "speed", "Values": [{"Name": "speed-10g"}, {"Name": "speed-1g"}]}},` +
+		` "Values": [{"Name": "b"}, {"Name": "a"}]}`
+	sorted, err := sortIdentityValues([]byte(raw), "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Type":{"IdentityBase":{"Name":"speed","Values":[{"Name":"speed-10g"},{"Name":"speed-1g"}]}},`+
+		`"Values":[{"Name":"b"},{"Name":"a"}]}`, string(sorted))
+
+	raw = `{"IdentityBase": {"Values": [{"Name": "speed-1g"}, {"Name": "speed-10g"}, {"Name": "speed-100g"}]}}`
+	sorted, err = sortIdentityValues([]byte(raw), "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"IdentityBase":{"Values":[{"Name":"speed-100g"},{"Name":"speed-10g"},{"Name":"speed-1g"}]}}`, string(sorted))
+}
+
+func TestWhenUses(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bindings-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ex.yang"), []byte(`module ex {
+  namespace "urn:ex"; prefix ex;
+  grouping g1 { leaf l1 { type string; } leaf l2 { type string; } }
+  grouping g2 { leaf l3 { type string; } }
+  container c {
+    leaf mode { type string; }
+    uses g1 { when "mode = 'one'"; }
+    uses g2;
+  }
+}`), 0644))
+
+	var code bytes.Buffer
+	schemaTree, err := GenerateGoBindings(&code, dir, []string{"ex.yang"}, Ygot{})
+	assert.NoError(t, err)
+	// Only the uses statement with a when statement is kept, with the names of its nodes
+	c := schemaTree["Device"].Dir["c"]
+	if assert.Len(t, c.Uses, 1) {
+		assert.Equal(t, "mode = 'one'", c.Uses[0].Uses.When.Name)
+		assert.Equal(t, "g1", c.Uses[0].Grouping.Name)
+		assert.Len(t, c.Uses[0].Grouping.Dir, 2)
+		assert.Contains(t, c.Uses[0].Grouping.Dir, "l1")
+		assert.Contains(t, c.Uses[0].Grouping.Dir, "l2")
+	}
+	assert.Empty(t, schemaTree["Device"].Uses)
+}
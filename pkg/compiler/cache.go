@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// cacheManifestFile records, in the model directory, what each stage generated from which inputs
+const cacheManifestFile = ".model-compiler-cache.json"
+
+// cacheManifest is the content of the cache manifest of a model
+type cacheManifest struct {
+	Stages map[string]cachedStage `json:"stages"`
+}
+
+// cachedStage is the digest of the inputs of a stage and the hashes of the files it generated
+type cachedStage struct {
+	Inputs  string            `json:"inputs"`
+	Outputs map[string]string `json:"outputs"`
+}
+
+// Force makes Compile run all the enabled stages, ignoring the cache manifest of the model
+func (c *ModelCompiler) Force(force bool) {
+	c.force = force
+}
+
+// loadCacheManifest reads the cache manifest of the model at path; a missing or unreadable
+// manifest is an empty one
+func loadCacheManifest(path string) *cacheManifest {
+	manifest := &cacheManifest{Stages: make(map[string]cachedStage)}
+	content, err := ioutil.ReadFile(filepath.Join(path, cacheManifestFile))
+	if err != nil {
+		return manifest
+	}
+	if err := json.Unmarshal(content, manifest); err != nil || manifest.Stages == nil {
+		log.Warnf("Ignoring invalid cache manifest in '%s': %v", path, err)
+		return &cacheManifest{Stages: make(map[string]cachedStage)}
+	}
+	return manifest
+}
+
+func (m *cacheManifest) save(path string) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(path, cacheManifestFile), append(content, '\n'), 0640)
+}
+
+// upToDate returns true if the stage last ran with the given inputs and the files it
+// generated have not changed since
+func (m *cacheManifest) upToDate(name string, inputs string, path string) bool {
+	cached, ok := m.Stages[name]
+	if !ok || cached.Inputs != inputs || len(cached.Outputs) == 0 {
+		return false
+	}
+	for file, hash := range cached.Outputs {
+		if h, err := hashFile(filepath.Join(path, file)); err != nil || h != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// record notes the inputs of a stage which just ran along with the files it generated,
+// relative to path
+func (m *cacheManifest) record(name string, inputs string, path string, files []string) error {
+	outputs := make(map[string]string, len(files))
+	for _, file := range files {
+		hash, err := hashFile(filepath.Join(path, file))
+		if err != nil {
+			return err
+		}
+		outputs[file] = hash
+	}
+	m.Stages[name] = cachedStage{Inputs: inputs, Outputs: outputs}
+	return nil
+}
+
+// stageInputs returns the digest of everything the output of a stage depends on: the
+// compiler itself, the meta-data, VERSION and YANG files of the model at path, the other
+// files the stage reads and the templates the stage applies
+func (c *ModelCompiler) stageInputs(s stage, path string) (string, error) {
+	compiler, err := compilerHash()
+	if err != nil {
+		return "", err
+	}
+	inputs, err := modelInputs(path)
+	if err != nil {
+		return "", err
+	}
+	inputs["compiler"] = compiler
+	for _, file := range s.inputs {
+		hash, err := hashFile(filepath.Join(path, file))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		inputs[file] = hash
+	}
+
+	for _, name := range s.templates {
+		_, content, err := c.readTemplate(name, path)
+		if err != nil {
+			return "", err
+		}
+		inputs["template:"+name] = hashBytes([]byte(content))
+	}
+
+	keys := make([]string, 0, len(inputs))
+	for key := range inputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(h, "%s %s\n", inputs[key], key)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// modelInputs returns the hashes of the meta-data, VERSION and YANG files of the model at
+// path, by path relative to the model
+func modelInputs(path string) (map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(path, "metadata.*"))
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, filepath.Join(path, versionFile))
+	err = filepath.Walk(filepath.Join(path, "yang"), func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	inputs := make(map[string]string)
+	for _, file := range files {
+		hash, err := hashFile(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			return nil, err
+		}
+		inputs[rel] = hash
+	}
+	return inputs, nil
+}
+
+var compilerHashOnce struct {
+	sync.Once
+	hash string
+	err  error
+}
+
+// compilerHash returns the hash of the running compiler executable, so that the outputs of
+// a different compiler are never reused
+func compilerHash() (string, error) {
+	compilerHashOnce.Do(func() {
+		file, err := os.Executable()
+		if err != nil {
+			compilerHashOnce.err = err
+			return
+		}
+		compilerHashOnce.hash, compilerHashOnce.err = hashFile(file)
+	})
+	return compilerHashOnce.hash, compilerHashOnce.err
+}
+
+func hashFile(file string) (string, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return hashBytes(content), nil
+}
+
+func hashBytes(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
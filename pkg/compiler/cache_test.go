@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// cachedStages returns the names of the stages whose outputs were reused by the last compilation
+func cachedStages(c *ModelCompiler) []string {
+	names := make([]string, 0)
+	for _, r := range c.Results() {
+		if r.Cached {
+			names = append(names, r.Stage)
+		}
+	}
+	return names
+}
+
+func TestCompileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+
+	c := NewCompiler()
+	c.SelectStages(nil, []string{StageGoModule})
+	assert.NoError(t, c.Compile(dir))
+	assert.Empty(t, cachedStages(c))
+
+	// Nothing changed
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []string{StageBindings, StageTree, StageMain, StageMakefile, StageDockerfile, StageOpenAPI, StageTests}, cachedStages(c))
+
+	// A generated file is modified, so its stage runs again
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Makefile"), []byte("edited\n"), 0640))
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []string{StageBindings, StageTree, StageMain, StageDockerfile, StageOpenAPI, StageTests}, cachedStages(c))
+
+	// A template is overridden, so the stages applying it run again
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, templatesDir), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, templatesDir, dockerfileTemplate), []byte("FROM scratch\n"), 0640))
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []string{StageBindings, StageTree, StageMain, StageMakefile, StageOpenAPI, StageTests}, cachedStages(c))
+
+	// OpenAPI needs the schema tree, so the bindings are generated again along with it
+	assert.NoError(t, os.Remove(filepath.Join(dir, "openapi.yaml")))
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []string{StageTree, StageMain, StageMakefile, StageDockerfile, StageTests}, cachedStages(c))
+
+	// The test cases changed, so only the tests are generated again
+	casesFile := filepath.Join(dir, testdataDir, testCasesFile)
+	cases, err := ioutil.ReadFile(casesFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(casesFile, append(cases, '\n'), 0640))
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []string{StageBindings, StageTree, StageMain, StageMakefile, StageDockerfile, StageOpenAPI}, cachedStages(c))
+
+	// A YANG file changed
+	yangFile := filepath.Join(dir, "yang", "onf-test1-extra@2021-04-01.yang")
+	content, err := ioutil.ReadFile(yangFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(yangFile, append(content, '\n'), 0640))
+	assert.NoError(t, c.Compile(dir))
+	assert.Empty(t, cachedStages(c))
+
+	c.Force(true)
+	assert.NoError(t, c.Compile(dir))
+	assert.Empty(t, cachedStages(c))
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// testdataDir is the directory of a model holding the configurations its tests validate
+	testdataDir = "testdata"
+	// testCasesFile is the manifest, in testdataDir, of the test cases the compiler
+	// generates tests for
+	testCasesFile = "cases.yaml"
+)
+
+// Expected outcomes of the test cases
+const (
+	// OutcomeValid is the outcome of a configuration matching the schema and its must statements
+	OutcomeValid = "valid"
+	// OutcomeSchemaError is the outcome of a configuration which does not unmarshal or validate
+	// against the schema
+	OutcomeSchemaError = "schema-error"
+	// OutcomeMustError is the outcome of a configuration breaking a must statement
+	OutcomeMustError = "must-error"
+)
+
+// TestCases is the content of the testdata/cases.yaml file of a model
+type TestCases struct {
+	Cases []TestCase `yaml:"cases"`
+}
+
+// TestCase is a configuration of the model, in a JSON file of its testdata directory, along
+// with the expected outcome of its validation
+type TestCase struct {
+	Name string `yaml:"name"`
+	File string `yaml:"file"`
+	// Expect is the outcome, one of valid, schema-error or must-error
+	Expect string `yaml:"expect"`
+	// Error is the message of a must-error, or a part of the message of a schema-error; any
+	// schema error is expected when it is empty
+	Error string `yaml:"error,omitempty"`
+	// PathValues is the number of path values extracted from a valid configuration, which is
+	// not checked when 0
+	PathValues int `yaml:"pathValues,omitempty"`
+}
+
+// loadTestCases reads the test cases of the model at path, returning nil when the model
+// has none
+func loadTestCases(path string) (*TestCases, error) {
+	content, err := ioutil.ReadFile(filepath.Join(path, testdataDir, testCasesFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	testCases := &TestCases{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(testCases); err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", filepath.Join(testdataDir, testCasesFile), err)
+	}
+	return testCases, nil
+}
+
+// validateTestCases checks that the test cases are well formed and that their files are
+// in the testdata directory of the model at path
+func validateTestCases(path string, testCases *TestCases) ValidationErrors {
+	var errs ValidationErrors
+	names := make(map[string]bool)
+	for i, tc := range testCases.Cases {
+		field := fmt.Sprintf("cases[%d]", i)
+		switch {
+		case tc.Name == "":
+			errs.add(field+".name", "name is required")
+		case names[tc.Name]:
+			errs.add(field+".name", "%s is already the name of another case", tc.Name)
+		}
+		names[tc.Name] = true
+
+		if tc.File == "" {
+			errs.add(field+".file", "file is required")
+		} else if _, err := os.Stat(filepath.Join(path, testdataDir, tc.File)); err != nil {
+			errs.add(field+".file", "%s is not a file of %s", tc.File, testdataDir)
+		}
+
+		switch tc.Expect {
+		case OutcomeValid:
+			if tc.Error != "" {
+				errs.add(field+".error", "no error is expected from a valid configuration")
+			}
+		case OutcomeSchemaError:
+		case OutcomeMustError:
+			if tc.Error == "" {
+				errs.add(field+".error", "the message of the must error is required")
+			}
+		case "":
+			errs.add(field+".expect", "expect is required")
+		default:
+			errs.add(field+".expect", "%s is not one of %s, %s or %s", tc.Expect, OutcomeValid, OutcomeSchemaError, OutcomeMustError)
+		}
+		if tc.PathValues != 0 && tc.Expect != OutcomeValid {
+			errs.add(field+".pathValues", "the path values are only extracted from valid configurations")
+		}
+	}
+	return errs
+}
+
+// generateTests generates the test suite of the model from its test cases, if any
+func (c *ModelCompiler) generateTests(path string) error {
+	testCases, err := loadTestCases(path)
+	if err != nil || testCases == nil {
+		return err
+	}
+	if errs := validateTestCases(path, testCases); len(errs) > 0 {
+		file := filepath.Join(path, testdataDir, testCasesFile)
+		content, _ := ioutil.ReadFile(file)
+		for _, fieldErr := range errs {
+			d := Diagnostic{Severity: SeverityError, File: file, Message: fieldErr.Error()}
+			d.Line, d.Column = fieldLocation(content, fieldErr.Field)
+			c.addDiagnostics(StageTests, d)
+		}
+		return errs
+	}
+	c.dictionary.TestCases = testCases.Cases
+
+	testsFile := filepath.Join(path, "api", "cases_test.go")
+	log.Infof("Generating plugin tests '%s'", testsFile)
+	c.createDir(filepath.Join(path, "api"))
+	return c.applyTemplate(testsTemplate, path, testsFile)
+}
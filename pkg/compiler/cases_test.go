@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateTestCases(t *testing.T) {
+	testCases, err := loadTestCases("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+	assert.Equal(t, &TestCases{Cases: []TestCase{
+		{Name: "sample config", File: "sample-testdevice2-config.json", Expect: OutcomeValid, PathValues: 30},
+	}}, testCases)
+	assert.Empty(t, validateTestCases("../../models/testdevice-2.0.x", testCases))
+
+	testCases, err = loadTestCases("../../models/devicesim-1.0.x")
+	assert.NoError(t, err)
+	assert.Nil(t, testCases)
+
+	errs := validateTestCases("../../models/testdevice-2.0.x", &TestCases{Cases: []TestCase{
+		{Name: "a", File: "missing.json", Expect: OutcomeValid, Error: "unexpected"},
+		{Name: "a", File: "sample-testdevice2-config.json", Expect: OutcomeMustError, PathValues: 2},
+		{File: "sample-testdevice2-config.json", Expect: "fails"},
+	}})
+	assert.Equal(t, ValidationErrors{
+		{Field: "cases[0].file", Message: "missing.json is not a file of testdata"},
+		{Field: "cases[0].error", Message: "no error is expected from a valid configuration"},
+		{Field: "cases[1].name", Message: "a is already the name of another case"},
+		{Field: "cases[1].error", Message: "the message of the must error is required"},
+		{Field: "cases[1].pathValues", Message: "the path values are only extracted from valid configurations"},
+		{Field: "cases[2].name", Message: "name is required"},
+		{Field: "cases[2].expect", Message: "fails is not one of valid, schema-error or must-error"},
+	}, errs)
+}
+
+func TestCompileTests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cases-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-2.0.x", dir))
+
+	c := NewCompiler()
+	c.SelectStages([]string{StageTests}, nil)
+	assert.NoError(t, c.Compile(dir))
+	generated, err := ioutil.ReadFile(filepath.Join(dir, "api", "cases_test.go"))
+	assert.NoError(t, err)
+	expected, err := ioutil.ReadFile("../../models/testdevice-2.0.x/api/cases_test.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(generated))
+
+	// Editing the test cases generates the tests again
+	casesFile := filepath.Join(dir, testdataDir, testCasesFile)
+	assert.NoError(t, ioutil.WriteFile(casesFile, []byte("cases:\n  - name: broken\n    file: sample-testdevice2-config.json\n    expect: must-error\n"), 0640))
+	assert.Error(t, c.Compile(dir))
+	assert.Equal(t, []Diagnostic{{
+		Stage:    StageTests,
+		File:     casesFile,
+		Line:     2,
+		Column:   5,
+		Severity: SeverityError,
+		Message:  "cases[0].error: the message of the must error is required",
+	}}, c.Diagnostics())
+
+	assert.NoError(t, ioutil.WriteFile(casesFile, []byte("cases:\n  - name: unknown\n    expected: valid\n"), 0640))
+	assert.Error(t, c.Compile(dir))
+	assert.Contains(t, c.Diagnostics()[0].Message, "field expected not found")
+}
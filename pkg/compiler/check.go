@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/pmezard/go-difflib/difflib"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// checkedArtifacts are the generated files and directories which are expected to be
+// committed along with the model
+var checkedArtifacts = []string{"api", "plugin", "openapi.yaml", "Makefile", "Dockerfile"}
+
+// Check compiles the config model at path in to a temporary directory and writes a
+// unified diff to out for every generated artifact which differs from the committed
+// one. An error is returned if any artifact differs.
+func (c *ModelCompiler) Check(path string, out io.Writer) error {
+	tmpDir, err := ioutil.TempDir("", "model-compiler-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := copyModelInputs(path, tmpDir); err != nil {
+		return err
+	}
+	c.sourcePath = path
+	defer func() { c.sourcePath = "" }()
+	if err := c.Compile(tmpDir); err != nil {
+		return err
+	}
+
+	diffCount := 0
+	for _, artifact := range checkedArtifacts {
+		files, err := generatedFiles(tmpDir, artifact)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			diff, err := diffArtifact(filepath.Join(path, file), filepath.Join(tmpDir, file), file)
+			if err != nil {
+				return err
+			}
+			if diff != "" {
+				diffCount++
+				if _, err := io.WriteString(out, diff); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if diffCount > 0 {
+		return fmt.Errorf("%d generated file(s) differ from the committed ones in '%s'", diffCount, path)
+	}
+	log.Infof("Committed artifacts in '%s' are up to date", path)
+	return nil
+}
+
+// copyModelInputs copies the files the compiler reads from the model at path to dir
+func copyModelInputs(path string, dir string) error {
+	metaDataFiles, err := filepath.Glob(filepath.Join(path, "metadata.*"))
+	if err != nil {
+		return err
+	}
+	for _, file := range append(metaDataFiles, filepath.Join(path, versionFile)) {
+		if err := copyFile(file, filepath.Join(dir, filepath.Base(file))); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := copyDir(filepath.Join(path, "yang"), filepath.Join(dir, "yang")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := copyDir(filepath.Join(path, templatesDir), filepath.Join(dir, templatesDir)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := copyDir(filepath.Join(path, testdataDir), filepath.Join(dir, testdataDir)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	// Templates overriding the compiler ones may be anywhere in the model directory
+	metaData := &MetaData{}
+	if err := LoadMetaData(path, "metadata", metaData); err != nil {
+		return err
+	}
+	for _, file := range metaData.TemplateOverrides {
+		if filepath.IsAbs(file) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, file)), os.ModePerm); err != nil {
+			return err
+		}
+		if err := copyFile(filepath.Join(path, file), filepath.Join(dir, file)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyDir copies the files found directly in src to dst
+func copyDir(src string, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, os.ModePerm); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if err := copyFile(filepath.Join(src, file.Name()), filepath.Join(dst, file.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, content, 0640)
+}
+
+// generatedFiles lists the files generated for an artifact, relative to dir and sorted;
+// nothing is returned when the artifact has not been generated
+func generatedFiles(dir string, artifact string) ([]string, error) {
+	files := make([]string, 0)
+	err := filepath.Walk(filepath.Join(dir, artifact), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			files = append(files, rel)
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+// diffArtifact returns the unified diff between the committed and the generated version
+// of a file, or an empty string if they are identical
+func diffArtifact(committed string, generated string, name string) (string, error) {
+	want, err := ioutil.ReadFile(generated)
+	if err != nil {
+		return "", err
+	}
+	got, err := ioutil.ReadFile(committed)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	if string(got) == string(want) {
+		return "", nil
+	}
+	fromFile := filepath.Join("a", name)
+	if os.IsNotExist(err) {
+		fromFile = "/dev/null"
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(got)),
+		B:        splitLines(string(want)),
+		FromFile: fromFile,
+		ToFile:   filepath.Join("b", name),
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	return diff, nil
+}
+
+// splitLines splits s in to lines, keeping the line endings as expected by difflib
+func splitLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		lines[len(lines)-1] += "\n\\ No newline at end of file\n"
+	}
+	return lines
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffArtifact(t *testing.T) {
+	dir, err := ioutil.TempDir("", "check-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	committed := filepath.Join(dir, "committed")
+	generated := filepath.Join(dir, "generated")
+	assert.NoError(t, ioutil.WriteFile(committed, []byte("one\ntwo\nthree\n"), 0640))
+	assert.NoError(t, ioutil.WriteFile(generated, []byte("one\ntwo\nthree\n"), 0640))
+
+	diff, err := diffArtifact(committed, generated, "Makefile")
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+
+	assert.NoError(t, ioutil.WriteFile(generated, []byte("one\n2\nthree\n"), 0640))
+	diff, err = diffArtifact(committed, generated, "Makefile")
+	assert.NoError(t, err)
+	assert.Equal(t, "--- a/Makefile\n+++ b/Makefile\n@@ -1,3 +1,3 @@\n one\n-two\n+2\n three\n", diff)
+
+	diff, err = diffArtifact(filepath.Join(dir, "missing"), generated, "Dockerfile")
+	assert.NoError(t, err)
+	assert.Equal(t, "--- /dev/null\n+++ b/Dockerfile\n@@ -0,0 +1,3 @@\n+one\n+2\n+three\n", diff)
+}
+
+func TestGeneratedFiles(t *testing.T) {
+	files, err := generatedFiles("../../models/testdevice-1.0.x", "plugin")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"plugin/main.go"}, files)
+
+	files, err = generatedFiles("../../models/testdevice-1.0.x", "missing")
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestCheck(t *testing.T) {
+	models, err := ExpandModelPaths([]string{"../../models/*"})
+	assert.NoError(t, err)
+	for _, model := range models {
+		t.Run(filepath.Base(model), func(t *testing.T) {
+			var diff strings.Builder
+			assert.NoError(t, NewCompiler().Check(model, &diff))
+			assert.Empty(t, diff.String())
+		})
+	}
+}
@@ -6,36 +6,59 @@ package compiler
 
 import (
 	"fmt"
+	"github.com/ghodss/yaml"
+	openapi_gen "github.com/onosproject/config-models/pkg/openapi-gen"
+	configpath "github.com/onosproject/config-models/pkg/path"
 	api "github.com/onosproject/onos-api/go/onos/config/admin"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	_ "github.com/openconfig/gnmi/proto/gnmi" // gnmi
-	_ "github.com/openconfig/goyang/pkg/yang" // yang
-	_ "github.com/openconfig/ygot/genutil"    // genutil
-	_ "github.com/openconfig/ygot/ygen"       // ygen
-	_ "github.com/openconfig/ygot/ygot"       // ygot
-	_ "github.com/openconfig/ygot/ytypes"     // ytypes
-	_ "google.golang.org/protobuf/proto"      // proto
+	"github.com/openconfig/goyang/pkg/yang"
+	_ "github.com/openconfig/ygot/ygot"   // ygot
+	_ "github.com/openconfig/ygot/ytypes" // ytypes
+	_ "google.golang.org/protobuf/proto"  // proto
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 var log = logging.GetLogger("config-model", "compiler")
 
+const openapiLicense = `# SPDX-FileCopyrightText: 2021-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+`
+
 const (
 	versionFile        = "VERSION"
 	mainTemplate       = "main.go.tpl"
 	modelTemplate      = "model.go.tpl"
+	pathsTemplate      = "paths.go.tpl"
 	gomodTemplate      = "go.mod.tpl"
 	makefileTemplate   = "Makefile.tpl"
 	dockerfileTemplate = "Dockerfile.tpl"
-	openapiGenTemplate = "openapi-gen.go.tpl"
-	//gnmiGenTemplate    = "gnmi-gen.go.tpl"
+	gnmiGenTemplate    = "gnmi-gen.go.tpl"
+	docsTemplate       = "README.md.tpl"
+	testsTemplate      = "cases_test.go.tpl"
 )
 
+// configModelsModule is the Go module of this repository, of which the models it holds are
+// sub-modules
+const configModelsModule = "github.com/onosproject/config-models"
+
+// configModelsDir returns the path of the config-models module relative to the model with
+// the given Go package, if the model is one of its sub-modules, e.g. ../.. for
+// github.com/onosproject/config-models/models/testdevice-2.0.x
+func configModelsDir(goPackage string) string {
+	if !strings.HasPrefix(goPackage, configModelsModule+"/") {
+		return ""
+	}
+	depth := strings.Count(strings.TrimPrefix(goPackage, configModelsModule+"/"), "/") + 1
+	return strings.TrimSuffix(strings.Repeat("../", depth), "/")
+}
+
 // NewCompiler creates a new config model compiler
 func NewCompiler() *ModelCompiler {
 	return &ModelCompiler{}
@@ -53,6 +76,11 @@ type Dictionary struct {
 	ReadOnlyPath       []*api.ReadOnlyPath
 	ReadWritePath      []*api.ReadWritePath
 	OpenAPITargetAlias string
+	FakeRoot           string
+	TestCases          []TestCase
+	// ConfigModelsDir is the config-models module, relative to the model directory, for the
+	// models within it, which are built against it rather than its latest release
+	ConfigModelsDir string
 }
 
 // ModelCompiler is a model plugin compiler
@@ -61,10 +89,52 @@ type ModelCompiler struct {
 	metaData      *MetaData
 	modelInfo     *api.ModelInfo
 	dictionary    Dictionary
+	onlyStages    []string
+	skipStages    []string
+	results       []StageResult
+	generated     []string
+	schemaTree    map[string]*yang.Entry
+	searchPaths   []string
+	force         bool
+	metaDataFile  string
+	diagnostics   []Diagnostic
+	// featureModule is the generated module removing the nodes depending on disabled
+	// features, if any, written in featureDir for the time of the compilation
+	featureModule string
+	featureDir    string
+	// modules are the YANG modules read for the time of the compilation
+	modules *yangModuleSet
+	report  *BuildReport
+	// sourcePath is the model directory relative search paths are resolved against, when
+	// it is not the one being compiled
+	sourcePath string
+}
+
+// SetSearchPaths sets the directories searched for the YANG modules a model imports,
+// after the ones listed in the model meta-data
+func (c *ModelCompiler) SetSearchPaths(paths []string) {
+	c.searchPaths = paths
 }
 
-// Compile compiles the config model
+// Compile compiles the config model; the problems found are available from Diagnostics
+// and the outcome of the compilation from Report
 func (c *ModelCompiler) Compile(path string) error {
+	start := time.Now()
+	c.metaData, c.metaDataFile, c.pluginVersion = nil, "", ""
+	c.diagnostics = make([]Diagnostic, 0)
+	c.results = nil
+	c.featureModule, c.featureDir = "", ""
+	c.modules = newYangModuleSet()
+	err := c.compile(path)
+	if c.featureDir != "" {
+		os.RemoveAll(c.featureDir)
+	}
+	c.modules = nil
+	c.report = c.buildReport(path, time.Since(start), err)
+	return err
+}
+
+func (c *ModelCompiler) compile(path string) error {
 	log.Infof("Compiling config model at '%s'", path)
 	var err error
 
@@ -73,12 +143,29 @@ func (c *ModelCompiler) Compile(path string) error {
 	err = c.loadModelMetaData(path)
 	if err != nil {
 		log.Errorf("Unable to read model meta-data: %+v", err)
+		c.diagnose(stageMetaData, err)
 		return err
 	}
 
 	err = c.loadPluginVersion(path)
 	if err != nil {
-		log.Errorf("Unable to load model plugin version; defaulting to %s: %+v", c.pluginVersion, err)
+		c.warn(stageMetaData, filepath.Join(path, versionFile), "unable to load model plugin version; defaulting to %s: %v", c.pluginVersion, err)
+	}
+
+	// Copy the YANG modules the model depends on from the search paths
+	err = c.resolveYangImports(path)
+	if err != nil {
+		log.Errorf("Unable to resolve YANG imports: %+v", err)
+		c.diagnose(stageResolve, err)
+		return err
+	}
+
+	// Check the meta-data against the model files
+	err = c.validateModel(path)
+	if err != nil {
+		log.Errorf("Model meta-data does not match the model files:\n%s", err)
+		c.diagnose(stageValidate, err)
+		return err
 	}
 
 	// Lint YANG files if the model requests lint validation
@@ -90,6 +177,14 @@ func (c *ModelCompiler) Compile(path string) error {
 		}
 	}
 
+	// Remove the nodes depending on disabled features
+	err = c.generateFeatureModule(path)
+	if err != nil {
+		log.Errorf("Unable to select the model features: %+v", err)
+		c.diagnose(stageValidate, err)
+		return err
+	}
+
 	// Create dictionary from metadata and model info
 	c.dictionary = Dictionary{
 		Name:               c.modelInfo.Name,
@@ -97,64 +192,34 @@ func (c *ModelCompiler) Compile(path string) error {
 		PluginVersion:      c.pluginVersion,
 		ArtifactName:       c.metaData.ArtifactName,
 		GoPackage:          c.metaData.GoPackage,
+		ConfigModelsDir:    configModelsDir(c.metaData.GoPackage),
 		ModelData:          c.modelInfo.ModelData,
 		Module:             c.modelInfo.Module,
 		GetStateMode:       c.modelInfo.GetStateMode,
 		ReadOnlyPath:       c.modelInfo.ReadOnlyPath,
 		ReadWritePath:      c.modelInfo.ReadWritePath,
 		OpenAPITargetAlias: c.metaData.OpenAPITargetAlias,
+		FakeRoot:           c.metaData.Ygot.fakeRootStruct(),
 	}
 
-	// Generate Golang bindings for the YANG files
-	err = c.generateGolangBindings(path)
-	if err != nil {
-		log.Errorf("Unable to generate Golang bindings: %+v", err)
-		return err
-	}
-
-	// Generate YANG model tree
-	err = c.generateModelTree(path)
-	if err != nil {
-		log.Errorf("Unable to generate YANG model tree: %+v", err)
-		return err
-	}
-
-	// Generate model plugin artifacts from generic templates
-	err = c.generatePluginArtifacts(path)
-	if err != nil {
-		log.Errorf("Unable to generate model plugin artifacts: %+v", err)
-		return err
-	}
-
-	// Generate OpenAPI
-	err = c.generateOpenApi(path)
+	// Generate the model artifacts
+	err = c.runStages(path)
 	if err != nil {
-		log.Errorf("Unable to generate OpenApi specs: %+v", err)
+		log.Errorf("Unable to generate model artifacts: %+v", err)
 		return err
 	}
 
-	// the gNMI client generator is on hold at the moment,
-	// disabling it for the moment
-	//Generate gNMI Client Generator
-	//err = c.generateGnmiClientGenerator(path)
-	//if err != nil {
-	//	log.Errorf("Unable to generate gNMI Client Generator: %+v", err)
-	//	return err
-	//}
-
-	// Now generate the gNMI client itself
-	//err = c.generateGnmiClient(path)
-	//if err != nil {
-	//	log.Errorf("Unable to generate gNMI Client: %+v", err)
-	//	return err
-	//}
-
 	return nil
 }
 
 func (c *ModelCompiler) loadModelMetaData(path string) error {
 	c.metaData = &MetaData{}
-	if err := LoadMetaData(path, "metadata", c.metaData); err != nil {
+	file, from, err := loadMetaData(path, "metadata", c.metaData)
+	c.metaDataFile = file
+	if from != "" {
+		c.warn(stageMetaData, file, "%s", migrationWarning(file, from))
+	}
+	if err != nil {
 		return err
 	}
 	if err := ValidateMetaData(c.metaData); err != nil {
@@ -181,29 +246,96 @@ func (c *ModelCompiler) loadPluginVersion(path string) error {
 	data, err := ioutil.ReadFile(filepath.Join(path, versionFile))
 	if err != nil {
 		c.pluginVersion = "1.0.0"
+		return err
 	}
 	v := string(data)
 	c.pluginVersion = strings.Split(strings.ReplaceAll(v, "\r\n", "\n"), "\n")[0]
+	return nil
+}
+
+func (c *ModelCompiler) resolveYangImports(path string) error {
+	sourcePath := path
+	if c.sourcePath != "" {
+		sourcePath = c.sourcePath
+	}
+	searchPaths := make([]string, 0, len(c.metaData.SearchPaths)+len(c.searchPaths))
+	for _, dir := range c.metaData.SearchPaths {
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(sourcePath, dir)
+		}
+		searchPaths = append(searchPaths, dir)
+	}
+	existing := make([]string, 0, len(searchPaths)+len(c.searchPaths))
+	for _, dir := range append(searchPaths, c.searchPaths...) {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			c.warn(stageResolve, "", "YANG search path '%s' does not exist", dir)
+			continue
+		}
+		existing = append(existing, dir)
+	}
+	searchPaths = existing
+
+	roots := append(append([]Module{}, c.metaData.Modules...), c.metaData.Deviations...)
+	_, err := ResolveYangImports(filepath.Join(path, "yang"), roots, searchPaths)
 	return err
 }
 
+// generateFeatureModule generates, in a temporary directory, the module deviating the nodes
+// which depend on the features disabled in the meta-data, when there are any
+func (c *ModelCompiler) generateFeatureModule(path string) error {
+	if len(c.metaData.Features) == 0 {
+		return nil
+	}
+	dir, err := ioutil.TempDir("", "model-compiler-features-")
+	if err != nil {
+		return err
+	}
+	c.featureDir = dir
+	c.featureModule, err = writeFeatureModule(filepath.Join(path, "yang"), c.metaData, dir, c.modules)
+	return err
+}
+
+func (c *ModelCompiler) validateModel(path string) error {
+	errs := validateModelFiles(path, c.metaData)
+	// go.mod is only expected to match when it is not about to be regenerated
+	enabled, err := c.enabledStages()
+	if err != nil {
+		return err
+	}
+	if !enabled[StageGoModule] {
+		errs = append(errs, validateGoModule(path, c.metaData)...)
+	}
+	return errs.orNil()
+}
+
 func (c *ModelCompiler) lintModel(path string) error {
 	log.Infof("Linting YANG files")
 
-	args := []string{"--lint", "--lint-ensure-hyphenated-names", "-W", "error", "--ignore-error=XPATH_FUNCTION"}
-
-	// Append the root YANG files to the command-line arguments
-	yangDir := filepath.Join(path, "yang")
-	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
+	errCount := 0
+	diags := lintYang(filepath.Join(path, "yang"), c.rootYangFiles(), c.modules)
+	c.addDiagnostics(stageLint, diags...)
+	for _, d := range diags {
+		if d.Severity == SeverityError {
+			log.Error(d.String())
+			errCount++
+		} else {
+			log.Warn(d.String())
+		}
 	}
+	if errCount > 0 {
+		return fmt.Errorf("found %d error(s) in YANG files", errCount)
+	}
+	return nil
+}
 
-	log.Infof("Executing %s", path, strings.Join(args, " "))
-	cmd := exec.Command("pyang", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+// rootYangFiles returns the YANG files of the modules and deviation modules listed in the
+// meta-data
+func (c *ModelCompiler) rootYangFiles() []string {
+	files := make([]string, 0, len(c.metaData.Modules)+len(c.metaData.Deviations))
+	for _, module := range append(append([]Module{}, c.metaData.Modules...), c.metaData.Deviations...) {
+		files = append(files, module.YangFile)
+	}
+	return files
 }
 
 func (c *ModelCompiler) generateGolangBindings(path string) error {
@@ -213,92 +345,78 @@ func (c *ModelCompiler) generateGolangBindings(path string) error {
 	apiFile := filepath.Join(apiDir, "generated.go")
 	log.Infof("Generating YANG bindings '%s'", apiFile)
 
-	args := []string{
-		fmt.Sprintf("-path=%s/yang", path),
-		fmt.Sprintf("-output_file=%s", apiFile),
-		"-package_name=api",
-		"-generate_fakeroot",
-		"--include_descriptions",
-	}
-
-	// Append all YANG files to the command-line arguments
-	files, err := ioutil.ReadDir(filepath.Join(path, "yang"))
+	// Generate the bindings for all the YANG files
+	yangDir := filepath.Join(path, "yang")
+	files, ignored, err := compiledYangFiles(yangDir, c.metaData)
 	if err != nil {
 		return err
 	}
-	for _, file := range files {
-		args = append(args, file.Name())
+	for _, file := range ignored {
+		c.warn(StageBindings, filepath.Join(yangDir, file), "%s deviates other modules but is not listed in the deviations of the meta-data, it is ignored", file)
+	}
+	if c.featureModule != "" {
+		files = append(files, c.featureModule)
 	}
 
-	log.Infof("Executing: generator %s", path, strings.Join(args, " "))
-	cmd := exec.Command("generator", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	err = cmd.Run()
+	file, err := c.createFile(apiFile)
 	if err != nil {
 		return err
 	}
-
-	return insertHeaderPrefix(apiFile)
-}
-
-func insertHeaderPrefix(file string) error {
-	content, err := ioutil.ReadFile(file)
+	defer file.Close()
+	c.schemaTree, err = GenerateGoBindings(file, yangDir, files, c.metaData.Ygot)
 	if err != nil {
 		return err
 	}
 
-	newContent := []byte("// Code generated by YGOT. DO NOT")
-	newContent = append(newContent, []byte("EDIT.\n")...) // HACK: Defeat the license header check
-	newContent = append(newContent, content...)
-	return ioutil.WriteFile(file, newContent, 0640)
+	// The path lists served by the plugin are extracted now rather than when it starts
+	c.modelInfo.ReadOnlyPath, c.modelInfo.ReadWritePath, err = configpath.Extract(c.schemaTree)
+	if err != nil {
+		return fmt.Errorf("unable to extract the paths of the model: %w", err)
+	}
+	c.dictionary.ReadOnlyPath = c.modelInfo.ReadOnlyPath
+	c.dictionary.ReadWritePath = c.modelInfo.ReadWritePath
+	return nil
 }
 
 func (c *ModelCompiler) generateModelTree(path string) error {
 	treeFile := filepath.Join(path, c.modelInfo.Name+".tree")
 	log.Infof("Generating YANG tree '%s'", treeFile)
 
-	yangDir := filepath.Join(path, "yang")
-	args := []string{"-f", "tree", "--ignore-error=XPATH_FUNCTION", "-p", yangDir, "-o", treeFile}
+	files := c.rootYangFiles()
+	if c.featureModule != "" {
+		files = append(files, c.featureModule)
+	}
+	ms, diags := c.modules.modules(filepath.Join(path, "yang"), files)
+	c.addDiagnostics(StageTree, diags...)
+	for _, d := range diags {
+		log.Error(d.String())
+	}
+	if hasErrors(diags) {
+		return fmt.Errorf("unable to process YANG files")
+	}
 
-	// Append the root YANG files to the command-line arguments
+	names := make([]string, 0, len(c.metaData.Modules))
 	for _, module := range c.metaData.Modules {
-		args = append(args, filepath.Join(yangDir, module.YangFile))
+		names = append(names, module.Name)
 	}
 
-	log.Infof("Executing %s", path, strings.Join(args, " "))
-	cmd := exec.Command("pyang", args...)
-	cmd.Env = os.Environ()
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	return cmd.Run()
+	file, err := c.createFile(treeFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return WriteTree(file, ms, names)
 }
 
-func (c *ModelCompiler) generatePluginArtifacts(path string) error {
-	// Generate main and paths extraction
+// generateMainAndModel generates the plugin main and the model data and paths it serves
+func (c *ModelCompiler) generateMainAndModel(path string) error {
 	if err := c.generateMain(path); err != nil {
 		return err
 	}
 	if err := c.generateModel(path); err != nil {
 		return err
 	}
-
-	// Generate go.mod from template
-	if err := c.generateGoModule(path); err != nil {
-		return err
-	}
-
-	// Generate Makefile from template
-	if err := c.generateMakefile(path); err != nil {
-		return err
-	}
-
-	// Generate Dockerfile from template
-	if err := c.generateDockerfile(path); err != nil {
-		return err
-	}
-	return nil
+	return c.generatePaths(path)
 }
 
 func (c *ModelCompiler) generateMain(path string) error {
@@ -306,7 +424,7 @@ func (c *ModelCompiler) generateMain(path string) error {
 	mainFile := filepath.Join(mainDir, "main.go")
 	log.Infof("Generating plugin main '%s'", mainFile)
 	c.createDir(mainDir)
-	return c.applyTemplate(mainTemplate, c.getTemplatePath(mainTemplate), mainFile)
+	return c.applyTemplate(mainTemplate, path, mainFile)
 }
 
 func (c *ModelCompiler) generateModel(path string) error {
@@ -314,50 +432,83 @@ func (c *ModelCompiler) generateModel(path string) error {
 	modelFile := filepath.Join(modelDir, "model.go")
 	log.Infof("Generating plugin model '%s'", modelFile)
 	c.createDir(modelDir)
-	return c.applyTemplate(modelTemplate, c.getTemplatePath(modelTemplate), modelFile)
+	return c.applyTemplate(modelTemplate, path, modelFile)
+}
+
+func (c *ModelCompiler) generatePaths(path string) error {
+	pathsFile := filepath.Join(path, "api", "paths.go")
+	log.Infof("Generating plugin paths '%s'", pathsFile)
+	return c.applyTemplate(pathsTemplate, path, pathsFile)
 }
 
 func (c *ModelCompiler) generateGoModule(path string) error {
 	gomodFile := filepath.Join(path, "go.mod")
 	log.Infof("Generating plugin Go module '%s'", gomodFile)
-	return c.applyTemplate(gomodTemplate, c.getTemplatePath(gomodTemplate), gomodFile)
+	return c.applyTemplate(gomodTemplate, path, gomodFile)
 }
 
 func (c *ModelCompiler) generateMakefile(path string) error {
 	makefileFile := filepath.Join(path, "Makefile")
 	log.Infof("Generating plugin Makefile '%s'", makefileFile)
-	return c.applyTemplate(makefileTemplate, c.getTemplatePath(makefileTemplate), makefileFile)
+	return c.applyTemplate(makefileTemplate, path, makefileFile)
 }
 
 func (c *ModelCompiler) generateDockerfile(path string) error {
 	dockerfileFile := filepath.Join(path, "Dockerfile")
 	log.Infof("Generating plugin Dockerfile '%s'", dockerfileFile)
-	return c.applyTemplate(dockerfileTemplate, c.getTemplatePath(dockerfileTemplate), dockerfileFile)
+	return c.applyTemplate(dockerfileTemplate, path, dockerfileFile)
 }
 
-// TODO we should be able to run this generated code right after we generate it,
-// so that we can remove a step from `make models-images`
 func (c *ModelCompiler) generateOpenApi(path string) error {
+	openapiFile := filepath.Join(path, "openapi.yaml")
+	log.Infof("Generating plugin OpenApi specs '%s'", openapiFile)
+
+	settings := openapi_gen.ApiGenSettings{
+		ModelType:    c.dictionary.Name,
+		ModelVersion: c.dictionary.Version,
+		Title:        fmt.Sprintf("%s-%s", c.dictionary.Name, c.dictionary.Version),
+		TargetAlias:  c.dictionary.OpenAPITargetAlias,
+	}
+	schema, err := openapi_gen.BuildOpenapiFromEntry(c.schemaTree[c.dictionary.FakeRoot], &settings)
+	if err != nil {
+		return err
+	}
+	specs, err := yaml.Marshal(schema)
+	if err != nil {
+		return err
+	}
+
+	file, err := c.createFile(openapiFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := file.WriteString(openapiLicense); err != nil {
+		return err
+	}
+	_, err = file.Write(specs)
+	return err
+}
+
+func (c *ModelCompiler) generateGnmiClientGenerator(path string) error {
 	// the Schema we need to import is generated at runtime, so we need to generate the tool
-	// to import such schema and generate the OpenApi specs
-	dir := filepath.Join(path, "openapi")
-	openapiGenFile := filepath.Join(dir, "openapi-gen.go")
+	// to import such schema and generate the gNMI client
+	dir := filepath.Join(path, "gnmi-gen")
+	gnmiGen := filepath.Join(dir, "gnmi-gen.go")
 	c.createDir(dir)
 
-	log.Infof("Generating plugin OpenApi Gen file '%s'", openapiGenFile)
-	return c.applyTemplate(openapiGenTemplate, c.getTemplatePath(openapiGenTemplate), openapiGenFile)
+	log.Infof("Generating plugin GnmiGen file '%s'", gnmiGen)
+	return c.applyTemplate(gnmiGenTemplate, path, gnmiGen)
 }
 
-//func (c *ModelCompiler) generateGnmiClientGenerator(path string) error {
-//	// the Schema we need to import is generated at runtime, so we need to generate the tool
-//	// to import such schema and generate the OpenApi specs
-//	dir := filepath.Join(path, "gnmi-gen")
-//	gnmiGen := filepath.Join(dir, "gnmi-gen.go")
-//	c.createDir(dir)
-//
-//	log.Infof("Generating plugin GnmiGen file '%s'", gnmiGen)
-//	return c.applyTemplate(gnmiGenTemplate, c.getTemplatePath(gnmiGenTemplate), gnmiGen)
-//}
+func (c *ModelCompiler) generateDocs(path string) error {
+	dir := filepath.Join(path, "docs")
+	docsFile := filepath.Join(dir, "README.md")
+	c.createDir(dir)
+
+	log.Infof("Generating plugin documentation '%s'", docsFile)
+	return c.applyTemplate(docsTemplate, path, docsFile)
+}
 
 //func (c *ModelCompiler) generateGnmiClient(path string) error {
 //	generatorPath := filepath.Join(path, "gnmi-gen/gnmi-gen.go")
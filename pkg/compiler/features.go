@@ -0,0 +1,373 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// goyang keeps the nodes depending on disabled features, so the compiler generates a module
+// deviating them as not-supported which is compiled along with the model
+
+// featureModuleName returns the name of the module generated to remove the nodes depending
+// on the features the model does not support
+func featureModuleName(metaData *MetaData) string {
+	return metaData.Name + "-disabled-features"
+}
+
+// compiledYangFiles returns the YANG files of yangDir compiled in to the model: all of them
+// but the modules deviating others which are neither listed in the modules nor in the
+// deviations of the meta-data, which are returned apart
+func compiledYangFiles(yangDir string, metaData *MetaData) ([]string, []string, error) {
+	files, err := yangFiles(yangDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	listed := make(map[string]bool)
+	for _, module := range append(append([]Module{}, metaData.Modules...), metaData.Deviations...) {
+		listed[module.Name] = true
+	}
+	compiled := make([]string, 0, len(files))
+	ignored := make([]string, 0)
+	for _, file := range files {
+		header, err := readYangHeader(filepath.Join(yangDir, file))
+		if err == nil && header.deviates && !listed[header.name] {
+			ignored = append(ignored, file)
+			continue
+		}
+		compiled = append(compiled, file)
+	}
+	return compiled, ignored, nil
+}
+
+// writeFeatureModule writes in dir the module removing the nodes which depend on the
+// features disabled in the meta-data, returning its file; no module is needed, and an
+// empty file name is returned, when no features are selected or no node depends on them.
+// The modules are read from the given set, if any.
+func writeFeatureModule(yangDir string, metaData *MetaData, dir string, modules *yangModuleSet) (string, error) {
+	if len(metaData.Features) == 0 {
+		return "", nil
+	}
+	files := make([]string, 0, len(metaData.Modules)+len(metaData.Deviations))
+	for _, module := range append(append([]Module{}, metaData.Modules...), metaData.Deviations...) {
+		files = append(files, module.YangFile)
+	}
+	ms, diags := modules.modules(yangDir, files)
+	if hasErrors(diags) {
+		msgs := make([]string, 0, len(diags))
+		for _, d := range diags {
+			msgs = append(msgs, d.String())
+		}
+		return "", fmt.Errorf("unable to read the YANG modules:\n%s", strings.Join(msgs, "\n"))
+	}
+	source, err := featureDeviations(ms, metaData)
+	if err != nil || source == nil {
+		return "", err
+	}
+	file := filepath.Join(dir, featureModuleName(metaData)+".yang")
+	return file, ioutil.WriteFile(file, source, 0640)
+}
+
+// featureDeviations returns the source of the module deviating, as not-supported, the nodes
+// of the processed modules ms which depend on a feature disabled in the meta-data, or nil
+// when no node does. The features selected for unknown modules, and the unknown features,
+// are returned as ValidationErrors.
+func featureDeviations(ms *yang.Modules, metaData *MetaData) ([]byte, error) {
+	fs := &featureSet{ms: ms, selected: make(map[string]map[string]bool), enabled: make(map[string]bool)}
+	var errs ValidationErrors
+	for i, features := range metaData.Features {
+		m, ok := ms.Modules[features.Module]
+		if !ok {
+			errs.add(fmt.Sprintf("features[%d].module", i), "%s is not a module of the model", features.Module)
+			continue
+		}
+		fs.selected[m.Name] = make(map[string]bool)
+		for j, name := range features.Enabled {
+			if fs.find(m, name) == nil {
+				errs.add(fmt.Sprintf("features[%d].enabled[%d]", i, j), "%s is not a feature of %s", name, m.Name)
+			}
+			fs.selected[m.Name][name] = true
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	disabled := make(map[*yang.Entry]bool)
+	var walk func(e *yang.Entry) error
+	walk = func(e *yang.Entry) error {
+		for _, name := range sortedDir(e) {
+			child := e.Dir[name]
+			ok, err := fs.entryEnabled(child)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				disabled[child] = true
+				continue
+			}
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, m := range sortedModules(ms) {
+		if m.BelongsTo == nil {
+			if err := walk(yang.ToEntry(m)); err != nil {
+				return nil, err
+			}
+		}
+		// The if-feature of an augment applies to all the nodes it adds
+		for _, a := range m.Augment {
+			ok, err := fs.all(a.IfFeature, a)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+			target := yang.ToEntry(m).Find(a.Name)
+			if target == nil {
+				return nil, fmt.Errorf("%s: unable to find the target %s of the augment", yang.Source(a), a.Name)
+			}
+			for name := range yang.ToEntry(a).Dir {
+				if child, ok := target.Dir[name]; ok {
+					disabled[child] = true
+				}
+			}
+		}
+	}
+	if len(disabled) == 0 {
+		return nil, nil
+	}
+	return fs.deviationModule(featureModuleName(metaData), disabled), nil
+}
+
+// featureSet tells which features of the YANG modules of a model are enabled
+type featureSet struct {
+	ms *yang.Modules
+	// selected are the enabled features of the modules listed in the meta-data
+	selected map[string]map[string]bool
+	// enabled caches whether each feature, as module:feature, is enabled
+	enabled map[string]bool
+}
+
+// find returns the feature of module m, or of one of its submodules, with the given name
+func (fs *featureSet) find(m *yang.Module, name string) *yang.Feature {
+	for _, sm := range sortedModules(fs.ms) {
+		if sm != m && (sm.BelongsTo == nil || sm.BelongsTo.Name != m.Name) {
+			continue
+		}
+		for _, f := range sm.Feature {
+			if f.Name == name {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// featureEnabled returns true when the feature is selected, or its module is not listed in
+// the meta-data, and the features it depends on are themselves enabled
+func (fs *featureSet) featureEnabled(m *yang.Module, name string) (bool, error) {
+	key := m.Name + ":" + name
+	if enabled, ok := fs.enabled[key]; ok {
+		return enabled, nil
+	}
+	f := fs.find(m, name)
+	if f == nil {
+		return false, fmt.Errorf("unknown feature %s", key)
+	}
+	if selected, ok := fs.selected[m.Name]; ok && !selected[name] {
+		fs.enabled[key] = false
+		return false, nil
+	}
+	// Guard against features depending on each other
+	fs.enabled[key] = false
+	enabled, err := fs.all(f.IfFeature, f)
+	fs.enabled[key] = enabled
+	return enabled, err
+}
+
+// entryEnabled returns true when all the if-feature statements of the entry are satisfied
+func (fs *featureSet) entryEnabled(e *yang.Entry) (bool, error) {
+	values := make([]*yang.Value, 0)
+	for _, v := range e.Extra["if-feature"] {
+		if value, ok := v.(*yang.Value); ok {
+			values = append(values, value)
+		}
+	}
+	return fs.all(values, e.Node)
+}
+
+// all returns true when all the if-feature expressions, found in the context node, are true
+func (fs *featureSet) all(values []*yang.Value, context yang.Node) (bool, error) {
+	enabled := true
+	for _, v := range values {
+		p := &featureExpr{fs: fs, context: context, tokens: strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(v.Name))}
+		ok, err := p.or()
+		if err == nil && p.pos < len(p.tokens) {
+			err = fmt.Errorf("unexpected %s", p.tokens[p.pos])
+		}
+		if err != nil {
+			return false, fmt.Errorf("%s: invalid if-feature %q: %v", yang.Source(context), v.Name, err)
+		}
+		enabled = enabled && ok
+	}
+	return enabled, nil
+}
+
+// featureExpr evaluates an if-feature expression of YANG 1.1, made of feature names, not,
+// and, or and parentheses
+type featureExpr struct {
+	fs      *featureSet
+	context yang.Node
+	tokens  []string
+	pos     int
+}
+
+func (p *featureExpr) next() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *featureExpr) or() (bool, error) {
+	value, err := p.and()
+	for err == nil && p.next() == "or" {
+		p.pos++
+		var other bool
+		other, err = p.and()
+		value = value || other
+	}
+	return value, err
+}
+
+func (p *featureExpr) and() (bool, error) {
+	value, err := p.factor()
+	for err == nil && p.next() == "and" {
+		p.pos++
+		var other bool
+		other, err = p.factor()
+		value = value && other
+	}
+	return value, err
+}
+
+func (p *featureExpr) factor() (bool, error) {
+	token := p.next()
+	p.pos++
+	switch token {
+	case "":
+		return false, fmt.Errorf("unexpected end")
+	case "not":
+		value, err := p.factor()
+		return !value, err
+	case "(":
+		value, err := p.or()
+		if err == nil && p.next() != ")" {
+			err = fmt.Errorf("missing )")
+		}
+		p.pos++
+		return value, err
+	case ")", "and", "or":
+		return false, fmt.Errorf("unexpected %s", token)
+	}
+	prefix, name := "", token
+	if i := strings.Index(token, ":"); i >= 0 {
+		prefix, name = token[:i], token[i+1:]
+	}
+	m := yang.FindModuleByPrefix(p.context, prefix)
+	if m != nil && m.BelongsTo != nil {
+		m = p.fs.ms.Modules[m.BelongsTo.Name]
+	}
+	if m == nil {
+		return false, fmt.Errorf("unknown prefix %s", prefix)
+	}
+	return p.fs.featureEnabled(m, name)
+}
+
+// deviationModule returns the source of the module deviating the entries as not-supported
+func (fs *featureSet) deviationModule(name string, entries map[*yang.Entry]bool) []byte {
+	namespaces := make(map[string]*yang.Module)
+	for _, m := range fs.ms.Modules {
+		if m.Namespace != nil {
+			namespaces[m.Namespace.Name] = m
+		}
+	}
+	prefixes := make(map[string]string)
+	used := make(map[string]bool)
+	prefix := func(m *yang.Module) string {
+		if p, ok := prefixes[m.Name]; ok {
+			return p
+		}
+		p := m.GetPrefix()
+		for i := 1; used[p]; i++ {
+			p = fmt.Sprintf("%s%d", m.GetPrefix(), i)
+		}
+		prefixes[m.Name], used[p] = p, true
+		return p
+	}
+
+	// The entries are sorted so that the same schema gives the same prefixes
+	sorted := make([]*yang.Entry, 0, len(entries))
+	for e := range entries {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Path() < sorted[j].Path() })
+	paths := make([]string, 0, len(sorted))
+	for _, e := range sorted {
+		root := e
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		parts := make([]string, 0)
+		for n := e; n.Parent != nil; n = n.Parent {
+			owner := fs.ms.Modules[root.Name]
+			if ns := n.Namespace(); ns != nil && namespaces[ns.Name] != nil {
+				owner = namespaces[ns.Name]
+			}
+			parts = append([]string{prefix(owner) + ":" + n.Name}, parts...)
+		}
+		paths = append(paths, "/"+strings.Join(parts, "/"))
+	}
+	imports := make([]string, 0, len(prefixes))
+	for module := range prefixes {
+		imports = append(imports, module)
+	}
+	sort.Strings(imports)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "module %s {\n", name)
+	fmt.Fprintf(&b, "  yang-version 1.1;\n")
+	fmt.Fprintf(&b, "  namespace \"urn:onosproject:config-models:%s\";\n", name)
+	fmt.Fprintf(&b, "  prefix disabled-features;\n\n")
+	for _, module := range imports {
+		fmt.Fprintf(&b, "  import %s { prefix %s; }\n", module, prefixes[module])
+	}
+	fmt.Fprintf(&b, "\n  description \"Generated by the model compiler to remove the nodes depending on disabled features\";\n")
+	for _, path := range paths {
+		fmt.Fprintf(&b, "\n  deviation \"%s\" {\n    deviate not-supported;\n  }\n", path)
+	}
+	fmt.Fprintf(&b, "}\n")
+	return []byte(b.String())
+}
+
+// sortedDir returns the names of the children of the entry in order
+func sortedDir(e *yang.Entry) []string {
+	names := make([]string, 0, len(e.Dir))
+	for name := range e.Dir {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
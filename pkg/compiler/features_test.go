@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const featuresModel = "../../test/models/features"
+
+func TestFeaturesAndDeviations(t *testing.T) {
+	schemaTree, err := ModelSchemaTree(featuresModel)
+	assert.NoError(t, err)
+	system, port := schemaTree["ExFeatures_System"], schemaTree["ExFeatures_System_Port"]
+	if !assert.NotNil(t, system) || !assert.NotNil(t, port) {
+		return
+	}
+
+	// boost depends on turbo which is not enabled, eco on fast not being enabled, label on
+	// slow; counter and audited depend on features of a module whose features are all enabled.
+	// The listed deviation removes descr, the other deviation module is not applied.
+	assert.Equal(t, []string{"counter", "mode", "name", "port", "speed"}, sortedDir(system))
+	assert.Equal(t, []string{"audited", "id", "mtu"}, sortedDir(port))
+	assert.Equal(t, []string{"a", "b"}, sortedDir(system.Dir["mode"]))
+	assert.Empty(t, system.Dir["mode"].Dir["a"].Dir)
+	assert.Equal(t, yang.Yuint8, port.Dir["mtu"].Type.Kind)
+}
+
+func TestFeatureModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "features-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	yangDir := filepath.Join(featuresModel, "yang")
+	metaData := &MetaData{}
+	assert.NoError(t, LoadMetaData(featuresModel, "metadata", metaData))
+
+	file, err := writeFeatureModule(yangDir, metaData, dir, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "features-disabled-features.yang"), file)
+	source, err := ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, `module features-disabled-features {
+  yang-version 1.1;
+  namespace "urn:onosproject:config-models:features-disabled-features";
+  prefix disabled-features;
+
+  import ex-features { prefix exf; }
+  import ex-features-ext { prefix exfe; }
+
+  description "Generated by the model compiler to remove the nodes depending on disabled features";
+
+  deviation "/exf:system/exf:boost" {
+    deviate not-supported;
+  }
+
+  deviation "/exf:system/exf:eco" {
+    deviate not-supported;
+  }
+
+  deviation "/exf:system/exf:mode/exf:a/exf:a" {
+    deviate not-supported;
+  }
+
+  deviation "/exf:system/exf:port/exfe:label" {
+    deviate not-supported;
+  }
+}
+`, string(source))
+
+	// Without a selection all the features are enabled
+	file, err = writeFeatureModule(yangDir, &MetaData{Name: "features", Modules: metaData.Modules}, dir, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", file)
+
+	// Enabling every feature but fast only removes the nodes depending on it
+	metaData.Features[0].Enabled = []string{"slow", "turbo"}
+	file, err = writeFeatureModule(yangDir, metaData, dir, nil)
+	assert.NoError(t, err)
+	source, err = ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), `deviation "/exf:system/exf:boost"`)
+	assert.NotContains(t, string(source), `exf:eco`)
+	assert.NotContains(t, string(source), `exfe:label`)
+
+	// Disabling the features of the augmenting module removes the augmented nodes
+	metaData.Features = append(metaData.Features, Features{Module: "ex-features-ext"})
+	file, err = writeFeatureModule(yangDir, metaData, dir, nil)
+	assert.NoError(t, err)
+	source, err = ioutil.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Contains(t, string(source), `deviation "/exf:system/exfe:counter"`)
+	assert.Contains(t, string(source), `deviation "/exf:system/exf:port/exfe:audited"`)
+
+	metaData.Features = []Features{{Module: "ex-features", Enabled: []string{"fast", "warp"}}, {Module: "ex-missing"}}
+	_, err = writeFeatureModule(yangDir, metaData, dir, nil)
+	assert.Equal(t, ValidationErrors{
+		{Field: "features[0].enabled[1]", Message: "warp is not a feature of ex-features"},
+		{Field: "features[1].module", Message: "ex-missing is not a module of the model"},
+	}, err)
+}
+
+func TestFeatureExpressions(t *testing.T) {
+	ms := yang.NewModules()
+	assert.NoError(t, ms.Parse(`module ex {
+  namespace "urn:ex";
+  prefix ex;
+  feature a;
+  feature b;
+  feature c;
+}`, "ex.yang"))
+	assert.Empty(t, ms.Process())
+	m := ms.Modules["ex"]
+	fs := &featureSet{ms: ms, selected: map[string]map[string]bool{"ex": {"a": true, "c": true}}, enabled: make(map[string]bool)}
+	for expr, expected := range map[string]bool{
+		"a":                   true,
+		"ex:b":                false,
+		"not b":               true,
+		"a and b":             false,
+		"a or b":              true,
+		"b or a and c":        true,
+		"(b or a) and not c":  false,
+		"not (b or not a)":    true,
+		"a and (b or (c))":    true,
+		"not not b or not a ": false,
+	} {
+		ok, err := fs.all([]*yang.Value{{Name: expr}}, m)
+		assert.NoError(t, err, expr)
+		assert.Equal(t, expected, ok, expr)
+	}
+	for _, expr := range []string{"a and", "(a", "a b", "x:a", "d", "or a"} {
+		_, err := fs.all([]*yang.Value{{Name: expr}}, m)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCompileFeatures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "features-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs(featuresModel, dir))
+
+	c := NewCompiler()
+	c.SelectStages(nil, []string{StageGoModule})
+	assert.NoError(t, c.Compile(dir))
+	assert.Equal(t, []Diagnostic{{
+		Stage:    StageBindings,
+		File:     filepath.Join(dir, "yang", "ex-other-deviations.yang"),
+		Severity: SeverityWarning,
+		Message:  "ex-other-deviations.yang deviates other modules but is not listed in the deviations of the meta-data, it is ignored",
+	}}, c.Diagnostics())
+	tree, err := ioutil.ReadFile(filepath.Join(dir, "features.tree"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(tree), "speed")
+	assert.Contains(t, string(tree), "counter")
+	assert.NotContains(t, string(tree), "boost")
+	assert.NotContains(t, string(tree), "descr")
+	openapi, err := ioutil.ReadFile(filepath.Join(dir, "openapi.yaml"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(openapi), "counter:")
+	assert.NotContains(t, string(openapi), "boost:")
+	assert.NotContains(t, string(openapi), "descr:")
+	paths, err := ioutil.ReadFile(filepath.Join(dir, "api", "paths.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(paths), `"/system/counter"`)
+	assert.NotContains(t, string(paths), `"/system/boost"`)
+	assert.NotContains(t, string(paths), `/descr"`)
+	_, err = os.Stat(c.featureDir)
+	assert.True(t, os.IsNotExist(err))
+}
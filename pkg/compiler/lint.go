@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/SeanCondon/xpath"
+	"github.com/openconfig/goyang/pkg/yang"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Severity is the severity of a diagnostic
+type Severity int
+
+const (
+	// SeverityError is a problem that prevents the model from being compiled
+	SeverityError Severity = iota
+	// SeverityWarning is a problem that does not prevent the model from being compiled
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText writes the severity by name, e.g. in the JSON build report
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText reads a severity written with MarshalText
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "error":
+		*s = SeverityError
+	case "warning":
+		*s = SeverityWarning
+	default:
+		return fmt.Errorf("unknown severity %s", text)
+	}
+	return nil
+}
+
+const (
+	ruleSyntax             = "syntax"
+	ruleUnresolvedImport   = "unresolved-import"
+	ruleHyphenatedName     = "hyphenated-name"
+	ruleMissingDescription = "missing-description"
+	ruleDuplicateIdentity  = "duplicate-identity"
+	ruleXPathSyntax        = "xpath-syntax"
+	ruleXPathFunction      = "xpath-function"
+)
+
+// Diagnostic is a problem found while compiling a model, most often in one of its YANG files
+type Diagnostic struct {
+	// Stage is the compiler stage which found the problem, if any
+	Stage    string   `json:"stage,omitempty"`
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule,omitempty"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	location := d.File
+	if d.Line > 0 {
+		location = fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+	}
+	msg := fmt.Sprintf("%s: %s", d.Severity, d.Message)
+	if d.Rule != "" {
+		msg = fmt.Sprintf("%s [%s]", msg, d.Rule)
+	}
+	if location == "" {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", location, msg)
+}
+
+func newDiagnostic(stmt *yang.Statement, severity Severity, rule string, format string, args ...interface{}) Diagnostic {
+	d := Diagnostic{Severity: severity, Rule: rule, Message: fmt.Sprintf(format, args...)}
+	if stmt != nil {
+		d.File, d.Line, d.Column = statementLocation(stmt)
+	}
+	return d
+}
+
+// statementLocation splits the location of a statement, which is either
+// "file:line:col", "line line:col", "file" or "unknown"
+func statementLocation(stmt *yang.Statement) (string, int, int) {
+	location := stmt.Location()
+	parts := strings.Split(location, ":")
+	if len(parts) >= 3 {
+		line, _ := strconv.Atoi(parts[len(parts)-2])
+		col, _ := strconv.Atoi(parts[len(parts)-1])
+		return strings.Join(parts[:len(parts)-2], ":"), line, col
+	} else if location != "unknown" {
+		return location, 0, 0
+	}
+	return "", 0, 0
+}
+
+// hasErrors returns true if any of the diagnostics is an error
+func hasErrors(diags []Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// statements whose identifier must be hyphenated; identities are left out as
+// they are upper case by convention in OpenConfig
+var hyphenatedKeywords = map[string]bool{
+	"module": true, "submodule": true, "container": true, "leaf": true, "leaf-list": true,
+	"list": true, "choice": true, "case": true, "grouping": true, "typedef": true,
+	"rpc": true, "action": true, "notification": true, "anydata": true, "anyxml": true,
+}
+
+// statements which are expected to carry a description
+var describedKeywords = map[string]bool{
+	"module": true, "submodule": true, "container": true, "leaf": true, "leaf-list": true,
+	"list": true, "choice": true, "grouping": true, "typedef": true, "identity": true,
+	"feature": true, "extension": true, "rpc": true, "action": true, "notification": true,
+}
+
+var notHyphenatedRegex = regexp.MustCompile(`[A-Z_]`)
+
+// LintYang checks the given root YANG files in yangDir for syntax errors, unresolved
+// imports, identifiers which are not hyphenated, missing descriptions, duplicate
+// identities and invalid XPath in must and when statements. Imported modules are
+// only checked for errors which prevent them from being processed. The diagnostics
+// are ordered by file and line.
+func LintYang(yangDir string, files []string) []Diagnostic {
+	return lintYang(yangDir, files, nil)
+}
+
+// lintYang lints the YANG files as per LintYang, reading the modules from the given set, if any
+func lintYang(yangDir string, files []string, modules *yangModuleSet) []Diagnostic {
+	ms, diags := modules.modules(yangDir, files)
+	if hasErrors(diags) {
+		return diags
+	}
+
+	rootFiles := make(map[string]bool)
+	for _, file := range files {
+		rootFiles[filepath.Join(yangDir, file)] = true
+	}
+	for _, m := range sortedModules(ms) {
+		if file, _, _ := statementLocation(m.Statement()); !rootFiles[file] {
+			continue
+		}
+		identities := make(map[string]*yang.Statement)
+		walkStatements(m.Statement(), func(stmt *yang.Statement) {
+			diags = append(diags, lintStatement(stmt)...)
+			if stmt.Keyword == "identity" {
+				if prev, ok := identities[stmt.Argument]; ok {
+					diags = append(diags, newDiagnostic(stmt, SeverityError, ruleDuplicateIdentity,
+						"identity %q is already defined at %s", stmt.Argument, prev.Location()))
+				} else {
+					identities[stmt.Argument] = stmt
+				}
+			}
+		})
+	}
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].File != diags[j].File {
+			return diags[i].File < diags[j].File
+		}
+		return diags[i].Line < diags[j].Line
+	})
+	return diags
+}
+
+// lintStatement applies the per statement lint rules
+func lintStatement(stmt *yang.Statement) []Diagnostic {
+	diags := make([]Diagnostic, 0)
+	if hyphenatedKeywords[stmt.Keyword] && notHyphenatedRegex.MatchString(stmt.Argument) {
+		diags = append(diags, newDiagnostic(stmt, SeverityError, ruleHyphenatedName,
+			"%s name %q should be hyphenated (no upper case letters or underscores)", stmt.Keyword, stmt.Argument))
+	}
+	if describedKeywords[stmt.Keyword] && !hasSubStatement(stmt, "description") {
+		diags = append(diags, newDiagnostic(stmt, SeverityWarning, ruleMissingDescription,
+			"%s %q has no description", stmt.Keyword, stmt.Argument))
+	}
+	if stmt.Keyword == "must" || stmt.Keyword == "when" {
+		if _, err := xpath.Compile(stmt.Argument); err != nil {
+			// The XPath library does not know all the YANG specific functions
+			if strings.Contains(err.Error(), "not yet support this function") {
+				diags = append(diags, newDiagnostic(stmt, SeverityWarning, ruleXPathFunction,
+					"%s expression %q uses an unsupported function: %v", stmt.Keyword, stmt.Argument, err))
+			} else {
+				diags = append(diags, newDiagnostic(stmt, SeverityError, ruleXPathSyntax,
+					"%s expression %q is not valid XPath: %v", stmt.Keyword, stmt.Argument, err))
+			}
+		}
+	}
+	return diags
+}
+
+// walkStatements calls fn on stmt and all its sub-statements, depth first
+func walkStatements(stmt *yang.Statement, fn func(stmt *yang.Statement)) {
+	fn(stmt)
+	for _, s := range stmt.SubStatements() {
+		walkStatements(s, fn)
+	}
+}
+
+func hasSubStatement(stmt *yang.Statement, keyword string) bool {
+	for _, s := range stmt.SubStatements() {
+		if s.Keyword == keyword {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+const lintYangDir = "../../test/yang/lint"
+
+func TestLintYang(t *testing.T) {
+	diags := LintYang(lintYangDir, []string{"lint-test.yang"})
+
+	rules := make(map[string]Diagnostic)
+	for _, d := range diags {
+		rules[d.Rule] = d
+	}
+	assert.Len(t, diags, 4)
+
+	assert.Equal(t, SeverityError, rules[ruleDuplicateIdentity].Severity)
+	assert.Equal(t, 15, rules[ruleDuplicateIdentity].Line)
+
+	assert.Equal(t, SeverityError, rules[ruleHyphenatedName].Severity)
+	assert.Equal(t, 22, rules[ruleHyphenatedName].Line)
+	assert.Equal(t, "../../test/yang/lint/lint-test.yang:22:5: error: leaf name \"leafCamelCase\" should be hyphenated (no upper case letters or underscores) [hyphenated-name]",
+		rules[ruleHyphenatedName].String())
+
+	assert.Equal(t, SeverityWarning, rules[ruleMissingDescription].Severity)
+	assert.Equal(t, 27, rules[ruleMissingDescription].Line)
+
+	assert.Equal(t, SeverityError, rules[ruleXPathSyntax].Severity)
+	assert.Equal(t, 34, rules[ruleXPathSyntax].Line)
+}
+
+func TestLintYangUnresolvedImport(t *testing.T) {
+	diags := LintYang(lintYangDir, []string{"lint-missing-import.yang"})
+	assert.Len(t, diags, 1)
+	assert.Equal(t, ruleUnresolvedImport, diags[0].Rule)
+	assert.Equal(t, SeverityError, diags[0].Severity)
+	assert.Equal(t, 9, diags[0].Line)
+	assert.True(t, hasErrors(diags))
+}
@@ -5,21 +5,74 @@
 package compiler
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/spf13/viper"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
+// MetaDataAPIVersion is the version of the meta-data format understood by the compiler;
+// meta-data files without an apiVersion are in the original, v0, format
+const MetaDataAPIVersion = "v1"
+
 // MetaData plugin meta-data
 type MetaData struct {
+	APIVersion         string   `mapstructure:"apiVersion" yaml:"apiVersion"`
 	Name               string   `mapstructure:"name" yaml:"name"`
 	Version            string   `mapstructure:"version" yaml:"version"`
 	Modules            []Module `mapstructure:"modules" yaml:"modules"`
 	GetStateMode       uint32   `mapstructure:"getStateMode" yaml:"getStateMode"`
 	LintModel          bool     `mapstructure:"lintModel" yaml:"lintModel"`
-	GenOpenAPI         bool     `mapstructure:"genOpenAPI" yaml:"genOpenAPI"`
 	OpenAPITargetAlias string   `mapstructure:"openAPITargetAlias" yaml:"openAPITargetAlias"`
 	GoPackage          string   `mapstructure:"goPackage" yaml:"goPackage"`
 	ArtifactName       string   `mapstructure:"artifactName" yaml:"artifactName"`
+	Ygot               Ygot     `mapstructure:"ygot" yaml:"ygot"`
+	// TemplateOverrides maps the name of a compiler template, e.g. Dockerfile.tpl, to
+	// the file, relative to the model directory, to be used instead
+	TemplateOverrides map[string]string `mapstructure:"templateOverrides" yaml:"templateOverrides"`
+	// SearchPaths are the directories, relative to the model directory, searched in order
+	// for the YANG modules imported by the model which are not in its yang directory
+	SearchPaths []string `mapstructure:"searchPaths" yaml:"searchPaths"`
+	// Stages enables or disables compiler stages, e.g. docs, by name
+	Stages map[string]bool `mapstructure:"stages" yaml:"stages"`
+	// Deviations are the YANG modules, e.g. shipped by the device vendor, deviating the
+	// modules of the model; the deviation modules found in the yang directory but not
+	// listed here are not applied
+	Deviations []Module `mapstructure:"deviations" yaml:"deviations"`
+	// Features selects the features supported by the model, by module; all the features
+	// of the modules which are not listed are supported
+	Features []Features `mapstructure:"features" yaml:"features"`
+}
+
+// templateOverride returns the file overriding the named template, if any; viper
+// lower cases the keys so the name is matched regardless of case
+func (m *MetaData) templateOverride(name string) (string, bool) {
+	for template, file := range m.TemplateOverrides {
+		if strings.EqualFold(template, name) {
+			return file, true
+		}
+	}
+	return "", false
+}
+
+// Ygot options for the generation of the Golang bindings
+type Ygot struct {
+	CompressPaths           bool   `mapstructure:"compressPaths" yaml:"compressPaths"`
+	IgnoreShadowSchemaPaths bool   `mapstructure:"ignoreShadowSchemaPaths" yaml:"ignoreShadowSchemaPaths"`
+	FakeRootName            string `mapstructure:"fakeRootName" yaml:"fakeRootName"`
+	OrderedMaps             bool   `mapstructure:"orderedMaps" yaml:"orderedMaps"`
+	PreferOperationalState  bool   `mapstructure:"preferOperationalState" yaml:"preferOperationalState"`
+}
+
+// Features are the features of a YANG module which are supported; the nodes depending on
+// the other features of the module are removed from the model
+type Features struct {
+	Module  string   `mapstructure:"module" yaml:"module"`
+	Enabled []string `mapstructure:"enabled" yaml:"enabled"`
 }
 
 type Module struct {
@@ -31,32 +84,259 @@ type Module struct {
 
 // LoadMetaData loads the metadata.yaml file
 func LoadMetaData(path string, configFile string, metaData *MetaData) error {
-	viper.SetConfigType("yaml")
-	viper.SetConfigName(configFile)
-	viper.AddConfigPath(path)
+	file, from, err := loadMetaData(path, configFile, metaData)
+	if from != "" {
+		log.Warn(migrationWarning(file, from))
+	}
+	return err
+}
 
-	if err := viper.ReadInConfig(); err != nil {
-		return err
+// loadMetaData loads the meta-data file, returning its name and, when it had to be
+// migrated on the fly, the format it is in
+func loadMetaData(path string, configFile string, metaData *MetaData) (string, string, error) {
+	// Template names contain dots, which must not be taken for nested keys
+	v := viper.NewWithOptions(viper.KeyDelimiter("::"))
+	v.SetConfigType("yaml")
+	v.SetConfigName(configFile)
+	v.AddConfigPath(path)
+
+	if err := v.ReadInConfig(); err != nil {
+		return "", "", err
+	}
+	file, from := v.ConfigFileUsed(), ""
+	if version := v.GetString("apiVersion"); version != MetaDataAPIVersion {
+		// Older meta-data is upgraded on the fly, unknown versions are reported by validation
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return file, "", err
+		}
+		migrated, changed, err := migrateMetaData(content)
+		if err != nil {
+			return file, "", fmt.Errorf("unable to migrate %s: %w", file, err)
+		}
+		if changed {
+			from = metaDataVersion(version)
+			if err := v.ReadConfig(bytes.NewReader(migrated)); err != nil {
+				return file, from, err
+			}
+		}
 	}
-	return viper.Unmarshal(metaData)
+	// Unknown keys are errors so that misspelt or obsolete attributes are not silently ignored
+	return file, from, v.UnmarshalExact(metaData)
 }
 
-// ValidateMetaData checks that required attributes are set
+func migrationWarning(file string, from string) string {
+	return fmt.Sprintf("%s is in the %s meta-data format; run 'model-compiler migrate-metadata' to upgrade it to %s",
+		file, from, MetaDataAPIVersion)
+}
+
+// Values of getStateMode, see the ModelInfo of the onos-config admin API
+const (
+	GetStateNone = iota
+	GetStateOpState
+	GetStateExplicitRoPaths
+	GetStateExplicitRoPathsExpandWildcards
+)
+
+var (
+	// versionRegex matches a semantic version, or a version range such as 1.0.x
+	versionRegex  = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*|x)(\.(0|[1-9]\d*|x))?$`)
+	semverRegex   = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+	revisionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	importRegex   = regexp.MustCompile(`^[a-z0-9.-]+\.[a-z]+(/[A-Za-z0-9._~+-]+)+$`)
+	aliasRegex    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+	goModuleRegex = regexp.MustCompile(`(?m)^module\s+"?([^\s"]+)"?\s*$`)
+)
+
+// FieldError is a problem with the value of a meta-data attribute, designated by its YAML key
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors are all the problems found in the meta-data of a model
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *ValidationErrors) add(field string, format string, args ...interface{}) {
+	*e = append(*e, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// orNil returns the errors as an error, or nil if there are none
+func (e ValidationErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ValidateMetaData checks that required attributes are set and well formed; all the
+// problems found are returned as ValidationErrors
 func ValidateMetaData(metaData *MetaData) error {
+	return validateMetaData(metaData).orNil()
+}
+
+// ValidateModel checks the meta-data of the model at path against the files of the
+// model: the YANG files of the modules, the VERSION file and the go.mod file
+func ValidateModel(path string, metaData *MetaData) error {
+	errs := append(validateMetaData(metaData), validateModelFiles(path, metaData)...)
+	return append(errs, validateGoModule(path, metaData)...).orNil()
+}
+
+func validateMetaData(metaData *MetaData) ValidationErrors {
+	var errs ValidationErrors
+	if metaData.APIVersion == "" {
+		errs.add("apiVersion", "is mandatory")
+	} else if metaData.APIVersion != MetaDataAPIVersion {
+		errs.add("apiVersion", "unknown version %s (expected %s)", metaData.APIVersion, MetaDataAPIVersion)
+	}
 	if metaData.Name == "" {
-		return fmt.Errorf("name is mandatory")
+		errs.add("name", "is mandatory")
 	}
 	if metaData.Version == "" {
-		return fmt.Errorf("version is mandatory")
+		errs.add("version", "is mandatory")
+	} else if !versionRegex.MatchString(metaData.Version) {
+		errs.add("version", "%s is not a semantic version such as 1.0.0 or 1.0.x", metaData.Version)
 	}
 	if metaData.ArtifactName == "" {
-		return fmt.Errorf("artifactName is mandatory")
+		errs.add("artifactName", "is mandatory")
 	}
 	if metaData.GoPackage == "" {
-		return fmt.Errorf("goPackage is mandatory")
+		errs.add("goPackage", "is mandatory")
+	} else if !importRegex.MatchString(metaData.GoPackage) {
+		errs.add("goPackage", "%s is not a valid Go import path", metaData.GoPackage)
+	}
+	if metaData.GetStateMode > GetStateExplicitRoPathsExpandWildcards {
+		errs.add("getStateMode", "unknown mode %d (expected %d to %d)", metaData.GetStateMode, GetStateNone, GetStateExplicitRoPathsExpandWildcards)
+	}
+	if metaData.OpenAPITargetAlias != "" && !aliasRegex.MatchString(metaData.OpenAPITargetAlias) {
+		errs.add("openAPITargetAlias", "%s is not a valid path parameter name", metaData.OpenAPITargetAlias)
+	}
+	if len(metaData.Modules) == 0 {
+		errs.add("modules", "no modules are listed")
+	}
+	errs = append(errs, validateModules("modules", metaData.Modules)...)
+	errs = append(errs, validateModules("deviations", metaData.Deviations)...)
+	selected := make(map[string]bool)
+	for i, features := range metaData.Features {
+		field := fmt.Sprintf("features[%d].module", i)
+		if features.Module == "" {
+			errs.add(field, "is mandatory")
+		} else if selected[features.Module] {
+			errs.add(field, "the features of %s are already selected", features.Module)
+		}
+		selected[features.Module] = true
+	}
+	names := templateNames()
+	for template := range metaData.TemplateOverrides {
+		if !containsFold(names, template) {
+			errs.add("templateOverrides."+template, "unknown template (expected one of %s)", strings.Join(names, ", "))
+		}
+	}
+	stageNames := StageNames()
+	for stage := range metaData.Stages {
+		if !containsFold(stageNames, stage) {
+			errs.add("stages."+stage, "unknown stage (expected one of %s)", strings.Join(stageNames, ", "))
+		}
+	}
+	return errs
+}
+
+// validateModules checks the modules listed under the given meta-data attribute
+func validateModules(attr string, modules []Module) ValidationErrors {
+	var errs ValidationErrors
+	for i, module := range modules {
+		field := fmt.Sprintf("%s[%d]", attr, i)
+		if module.Name == "" {
+			errs.add(field+".name", "is mandatory")
+		}
+		if module.Revision == "" {
+			errs.add(field+".revision", "is mandatory")
+		} else if !revisionRegex.MatchString(module.Revision) {
+			errs.add(field+".revision", "%s is not a YANG revision date", module.Revision)
+		}
+		if module.YangFile == "" {
+			errs.add(field+".file", "is mandatory")
+		}
+	}
+	return errs
+}
+
+// validateModelFiles checks that the meta-data matches the YANG and VERSION files of the
+// model at path
+func validateModelFiles(path string, metaData *MetaData) ValidationErrors {
+	errs := validateModuleFiles(path, "modules", metaData.Modules)
+	errs = append(errs, validateModuleFiles(path, "deviations", metaData.Deviations)...)
+
+	if data, err := ioutil.ReadFile(filepath.Join(path, versionFile)); err == nil {
+		version := strings.TrimSpace(string(data))
+		if !semverRegex.MatchString(version) {
+			errs.add(versionFile, "%s is not a semantic version", version)
+		}
+	}
+	return errs
+}
+
+// validateModuleFiles checks that the modules listed under the given meta-data attribute
+// match their YANG files, deviation modules having to deviate some module
+func validateModuleFiles(path string, attr string, modules []Module) ValidationErrors {
+	var errs ValidationErrors
+	for i, module := range modules {
+		field := fmt.Sprintf("%s[%d]", attr, i)
+		if module.YangFile == "" {
+			continue
+		}
+		header, err := readYangHeader(filepath.Join(path, "yang", module.YangFile))
+		if os.IsNotExist(err) {
+			errs.add(field+".file", "%s is not found in yang", module.YangFile)
+			continue
+		} else if err != nil {
+			errs.add(field+".file", "%v", err)
+			continue
+		}
+		if header.name != module.Name {
+			errs.add(field+".name", "%s does not match the module %s of %s", module.Name, header.name, module.YangFile)
+		}
+		if header.revision != module.Revision {
+			errs.add(field+".revision", "%s does not match the latest revision %s of %s", module.Revision, header.revision, module.YangFile)
+		}
+		if attr == "deviations" && !header.deviates {
+			errs.add(field+".file", "%s does not deviate any module", module.YangFile)
+		}
 	}
-	if metaData.Modules == nil || len(metaData.Modules) == 0 {
-		return fmt.Errorf("no modules are listed")
+	return errs
+}
+
+// validateGoModule checks that the meta-data matches the go.mod file of the model at path,
+// if any
+func validateGoModule(path string, metaData *MetaData) ValidationErrors {
+	var errs ValidationErrors
+	if data, err := ioutil.ReadFile(filepath.Join(path, "go.mod")); err == nil && metaData.GoPackage != "" {
+		if m := goModuleRegex.FindSubmatch(data); m == nil {
+			errs.add("goPackage", "go.mod does not declare a module")
+		} else if string(m[1]) != metaData.GoPackage {
+			errs.add("goPackage", "%s does not match the module %s of go.mod", metaData.GoPackage, m[1])
+		}
+	}
+	return errs
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
 	}
-	return nil
+	return false
 }
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+type jsonSchema map[string]interface{}
+
+// metaDataSchemaKeywords are the JSON Schema keywords, beyond the types derived from the
+// MetaData struct, of the meta-data attributes designated by their dot separated YAML keys;
+// the elements of arrays share the key of the array
+var metaDataSchemaKeywords = map[string]jsonSchema{
+	"": {
+		"required": []string{"apiVersion", "name", "version", "artifactName", "goPackage", "modules"},
+	},
+	"apiVersion": {
+		"description": "Version of the meta-data format",
+		"enum":        []string{MetaDataAPIVersion},
+	},
+	"name": {
+		"description": "Name of the model",
+	},
+	"version": {
+		"description": "Version of the model, e.g. 1.0.0 or 1.0.x",
+		"pattern":     versionRegex.String(),
+	},
+	"artifactName": {
+		"description": "Name of the model plugin artifacts",
+	},
+	"goPackage": {
+		"description": "Go import path of the model plugin, matching its go.mod",
+		"pattern":     importRegex.String(),
+	},
+	"getStateMode": {
+		"description": "How onos-config retrieves the state of the devices, from 0 (never) to 3",
+		"enum":        []int{GetStateNone, GetStateOpState, GetStateExplicitRoPaths, GetStateExplicitRoPathsExpandWildcards},
+	},
+	"lintModel": {
+		"description": "Whether the YANG files are linted before compiling them",
+	},
+	"openAPITargetAlias": {
+		"description": "Name of the path parameter designating the target in the OpenAPI specification",
+		"pattern":     aliasRegex.String(),
+	},
+	"modules": {
+		"description": "Root YANG modules of the model",
+		"minItems":    1,
+	},
+	"modules.": {
+		"required": []string{"name", "revision", "file"},
+	},
+	"modules.name": {
+		"description": "Name of the YANG module",
+	},
+	"modules.revision": {
+		"description": "Latest revision of the YANG module",
+		"pattern":     revisionRegex.String(),
+	},
+	"modules.organization": {
+		"description": "Organization of the YANG module",
+	},
+	"modules.file": {
+		"description": "YANG file of the module, relative to the yang directory",
+	},
+	"deviations": {
+		"description": "YANG modules deviating the modules of the model",
+	},
+	"deviations.": {
+		"required": []string{"name", "revision", "file"},
+	},
+	"deviations.name": {
+		"description": "Name of the deviation module",
+	},
+	"deviations.revision": {
+		"description": "Latest revision of the deviation module",
+		"pattern":     revisionRegex.String(),
+	},
+	"deviations.organization": {
+		"description": "Organization of the deviation module",
+	},
+	"deviations.file": {
+		"description": "YANG file of the deviation module, relative to the yang directory",
+	},
+	"features": {
+		"description": "Features supported by the model, by module; all the features of the modules not listed are supported",
+	},
+	"features.": {
+		"required": []string{"module"},
+	},
+	"features.module": {
+		"description": "Name of the YANG module defining the features",
+	},
+	"features.enabled": {
+		"description": "Features of the module which are supported",
+	},
+	"ygot": {
+		"description": "Options of the generation of the Golang bindings",
+	},
+	"templateOverrides": {
+		"description":   "Files, relative to the model directory, used instead of the compiler templates",
+		"propertyNames": jsonSchema{"enum": templateNames()},
+	},
+	"searchPaths": {
+		"description": "Directories, relative to the model directory, searched for the imported YANG modules",
+	},
+	"stages": {
+		"description":   "Compiler stages enabled or disabled by name",
+		"propertyNames": jsonSchema{"enum": StageNames()},
+	},
+}
+
+// MetaDataJSONSchema returns the JSON Schema of the current meta-data format, to be used
+// by editors to complete and check metadata.yaml files
+func MetaDataJSONSchema() ([]byte, error) {
+	schema := jsonSchemaOf(reflect.TypeOf(MetaData{}), "")
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = "config model meta-data " + MetaDataAPIVersion
+	content, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+func jsonSchemaOf(t reflect.Type, key string) jsonSchema {
+	schema := jsonSchema{}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return jsonSchemaOf(t.Elem(), key)
+	case reflect.String:
+		schema["type"] = "string"
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+	case reflect.Slice:
+		schema["type"] = "array"
+		schema["items"] = jsonSchemaOf(t.Elem(), key+".")
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = jsonSchemaOf(t.Elem(), key+".")
+	case reflect.Struct:
+		schema["type"] = "object"
+		schema["additionalProperties"] = false
+		properties := jsonSchema{}
+		prefix := strings.TrimSuffix(key, ".")
+		if prefix != "" {
+			prefix += "."
+		}
+		for i := 0; i < t.NumField(); i++ {
+			name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			properties[name] = jsonSchemaOf(t.Field(i).Type, prefix+name)
+		}
+		schema["properties"] = properties
+	}
+	for keyword, value := range metaDataSchemaKeywords[key] {
+		schema[keyword] = value
+	}
+	return schema
+}
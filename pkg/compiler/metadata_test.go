@@ -16,6 +16,7 @@ func TestLoadMetaData(t *testing.T) {
 	if err := LoadMetaData(path, "valid", md); err != nil {
 		t.Fatal(err)
 	}
+	assert.Equal(t, map[string]string{"dockerfile.tpl": "build/Dockerfile.tpl"}, md.TemplateOverrides)
 
 	err := LoadMetaData(path, "not-existing", md)
 	assert.Error(t, err)
@@ -25,5 +26,85 @@ func TestValidateMetaData(t *testing.T) {
 	missingName := &MetaData{Name: ""}
 	err := ValidateMetaData(missingName)
 	assert.Error(t, err)
-	assert.Equal(t, "name is mandatory", err.Error())
+	assert.Equal(t, "apiVersion: is mandatory\n"+
+		"name: is mandatory\n"+
+		"version: is mandatory\n"+
+		"artifactName: is mandatory\n"+
+		"goPackage: is mandatory\n"+
+		"modules: no modules are listed", err.Error())
+
+	invalid := &MetaData{
+		APIVersion:         "v2",
+		Name:               "test",
+		Version:            "1.0",
+		ArtifactName:       "test",
+		GoPackage:          "config-models/test",
+		GetStateMode:       4,
+		OpenAPITargetAlias: "target id",
+		Modules:            []Module{{Name: "test", Revision: "20220101"}},
+	}
+	err = ValidateMetaData(invalid)
+	assert.Error(t, err)
+	errs, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	fields := make([]string, 0, len(errs))
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"apiVersion", "goPackage", "getStateMode", "openAPITargetAlias", "modules[0].revision", "modules[0].file"}, fields)
+
+	// A misspelled stage is not ignored
+	misspelled := *invalid
+	misspelled.APIVersion, misspelled.GoPackage = MetaDataAPIVersion, "github.com/onosproject/config-models/models/test"
+	misspelled.GetStateMode, misspelled.OpenAPITargetAlias = 0, ""
+	misspelled.Modules = []Module{{Name: "test", Revision: "2022-01-01", YangFile: "test.yang"}}
+	misspelled.Stages = map[string]bool{"doc": true, "Docs": false}
+	err = ValidateMetaData(&misspelled)
+	assert.EqualError(t, err, "stages.doc: unknown stage (expected one of bindings, tree, main, gomod, makefile, dockerfile, openapi, gnmi-client, docs, tests)")
+
+	invalid.Version = "1.0.z"
+	err = ValidateMetaData(invalid)
+	assert.Contains(t, err.Error(), "version: 1.0.z is not a semantic version")
+}
+
+func TestValidateModel(t *testing.T) {
+	models, err := ExpandModelPaths([]string{"../../models/*"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, models)
+	for _, model := range models {
+		md := &MetaData{}
+		assert.NoError(t, LoadMetaData(model, "metadata", md))
+		assert.NoError(t, ValidateModel(model, md), model)
+	}
+
+	md := &MetaData{}
+	model := "../../models/testdevice-2.0.x"
+	assert.NoError(t, LoadMetaData(model, "metadata", md))
+	md.GoPackage = "github.com/onosproject/config-models/models/testdevice"
+	md.Modules[0].Name = "onf-test2"
+	md.Modules[0].Revision = "2019-01-01"
+	md.Modules[1].YangFile = "missing.yang"
+	err = ValidateModel(model, md)
+	assert.EqualError(t, err, "modules[0].name: onf-test2 does not match the module onf-test1 of onf-test1@2019-06-10.yang\n"+
+		"modules[0].revision: 2019-01-01 does not match the latest revision 2019-06-10 of onf-test1@2019-06-10.yang\n"+
+		"modules[1].file: missing.yang is not found in yang\n"+
+		"goPackage: github.com/onosproject/config-models/models/testdevice does not match the module github.com/onosproject/config-models/models/testdevice-2.0.x of go.mod")
+}
+
+func TestValidateMetaDataTemplateOverrides(t *testing.T) {
+	md := &MetaData{
+		APIVersion:        MetaDataAPIVersion,
+		Name:              "test",
+		Version:           "1.0.0",
+		ArtifactName:      "test",
+		GoPackage:         "github.com/onosproject/config-models/models/test",
+		Modules:           []Module{{Name: "test", Revision: "2022-01-01", YangFile: "test@2022-01-01.yang"}},
+		TemplateOverrides: map[string]string{"dockerfile.tpl": "build/Dockerfile.tpl"},
+	}
+	assert.NoError(t, ValidateMetaData(md))
+
+	md.TemplateOverrides["Dockerfile"] = "build/Dockerfile.tpl"
+	err := ValidateMetaData(md)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "templateOverrides.Dockerfile: unknown template")
 }
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// metaDataMigration upgrades a meta-data document from one format version to the next
+type metaDataMigration struct {
+	from    string
+	to      string
+	migrate func(root *yaml.Node) error
+}
+
+// metaDataMigrations are applied in order, each one to the output of the previous one
+var metaDataMigrations = []metaDataMigration{
+	{from: "v0", to: "v1", migrate: migrateV0ToV1},
+}
+
+// metaDataVersion returns the format version of meta-data with the given apiVersion
+func metaDataVersion(apiVersion string) string {
+	if apiVersion == "" {
+		return "v0"
+	}
+	return apiVersion
+}
+
+// MigrateMetaData upgrades the metadata.yaml file of the model at path to the current
+// format, keeping its comments and the order of its attributes; false is returned when the
+// file is already up to date
+func MigrateMetaData(path string) (bool, error) {
+	file := filepath.Join(path, "metadata.yaml")
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		file = filepath.Join(path, "metadata.yml")
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return false, err
+	}
+	migrated, changed, err := migrateMetaData(content)
+	if err != nil {
+		return false, fmt.Errorf("unable to migrate %s: %w", file, err)
+	}
+	if !changed {
+		log.Infof("%s is up to date", file)
+		return false, nil
+	}
+	if err := ioutil.WriteFile(file, migrated, 0640); err != nil {
+		return false, err
+	}
+	log.Infof("Migrated %s to %s", file, MetaDataAPIVersion)
+	return true, nil
+}
+
+// migrateMetaData upgrades a meta-data document to the current format; documents with an
+// unknown apiVersion are returned unchanged
+func migrateMetaData(content []byte) ([]byte, bool, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(content, doc); err != nil {
+		return nil, false, err
+	}
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, false, fmt.Errorf("meta-data is not a YAML mapping")
+	}
+	root := doc.Content[0]
+
+	from := ""
+	if _, value := mappingEntry(root, "apiVersion"); value != nil {
+		from = value.Value
+	}
+	version := metaDataVersion(from)
+	for _, m := range metaDataMigrations {
+		if m.from != version {
+			continue
+		}
+		if err := m.migrate(root); err != nil {
+			return nil, false, fmt.Errorf("%s to %s: %w", m.from, m.to, err)
+		}
+		version = m.to
+	}
+	if version == metaDataVersion(from) {
+		return content, false, nil
+	}
+	setAPIVersion(root, version)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, false, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), true, nil
+}
+
+// migrateV0ToV1 replaces genOpenAPI by the openapi stage
+func migrateV0ToV1(root *yaml.Node) error {
+	key, value := mappingEntry(root, "genOpenAPI")
+	if value == nil {
+		return nil
+	}
+	if value.Kind != yaml.ScalarNode || value.Tag != "!!bool" {
+		return fmt.Errorf("genOpenAPI: %s is not a boolean", value.Value)
+	}
+	removeMappingEntry(root, key)
+
+	_, stages := mappingEntry(root, "stages")
+	if stages == nil {
+		stages = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "stages"}, stages)
+	}
+	if _, openapi := mappingEntry(stages, StageOpenAPI); openapi == nil {
+		stages.Content = append(stages.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: StageOpenAPI}, value)
+	}
+	return nil
+}
+
+// setAPIVersion sets the apiVersion of a meta-data document, as its first attribute if
+// it is missing
+func setAPIVersion(root *yaml.Node, version string) {
+	if _, value := mappingEntry(root, "apiVersion"); value != nil {
+		value.Value = version
+		return
+	}
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "apiVersion"}
+	if len(root.Content) > 0 {
+		// The comments heading the document, e.g. the license, must stay at the top
+		key.HeadComment = root.Content[0].HeadComment
+		root.Content[0].HeadComment = ""
+	}
+	value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: version}
+	root.Content = append([]*yaml.Node{key, value}, root.Content...)
+}
+
+// mappingEntry returns the key and value nodes of an entry of a mapping node, or nils
+func mappingEntry(mapping *yaml.Node, name string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == name {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+func removeMappingEntry(mapping *yaml.Node, key *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i] == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
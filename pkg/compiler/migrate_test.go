@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrateMetaData(t *testing.T) {
+	content, err := ioutil.ReadFile("../../test/metadata/v0.yaml")
+	assert.NoError(t, err)
+
+	migrated, changed, err := migrateMetaData(content)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, `# SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+apiVersion: v1
+name: test
+version: 1.0.0
+artifactName: test-1.0.x
+goPackage: github.com/onosproject/config-models/models/test
+modules:
+  - name: openconfig-interfaces
+    organization: OpenConfig working group
+    revision: 2017-07-14
+    file: openconfig-interfaces@2017-07-14.yang
+stages:
+  docs: true
+  openapi: false
+`, string(migrated))
+
+	_, changed, err = migrateMetaData(migrated)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	dir, err := ioutil.TempDir("", "migrate-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "metadata.yaml"), content, 0640))
+	changed, err = MigrateMetaData(dir)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	written, err := ioutil.ReadFile(filepath.Join(dir, "metadata.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(migrated), string(written))
+}
+
+func TestLoadMetaDataVersions(t *testing.T) {
+	// v0 meta-data is migrated when loaded
+	md := &MetaData{}
+	assert.NoError(t, LoadMetaData("../../test/metadata", "v0", md))
+	assert.Equal(t, MetaDataAPIVersion, md.APIVersion)
+	assert.Equal(t, map[string]bool{"docs": true, "openapi": false}, md.Stages)
+
+	dir, err := ioutil.TempDir("", "load-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Obsolete attributes are not ignored
+	content := "apiVersion: v1\nname: test\ngenOpenAPI: false\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "metadata.yaml"), []byte(content), 0640))
+	err = LoadMetaData(dir, "metadata", &MetaData{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid keys: genopenapi")
+
+	content = "apiVersion: v2\nname: test\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "metadata.yaml"), []byte(content), 0640))
+	md = &MetaData{}
+	assert.NoError(t, LoadMetaData(dir, "metadata", md))
+	assert.Contains(t, ValidateMetaData(md).Error(), "apiVersion: unknown version v2 (expected v1)")
+}
+
+func TestMetaDataJSONSchema(t *testing.T) {
+	schema, err := MetaDataJSONSchema()
+	assert.NoError(t, err)
+	committed, err := ioutil.ReadFile("../../schemas/metadata.schema.json")
+	assert.NoError(t, err)
+	assert.Equal(t, string(committed), string(schema), "run make metadata-schema")
+}
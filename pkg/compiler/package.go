@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+const (
+	// BundleAPIVersion is the version of the format of the model bundles
+	BundleAPIVersion = "v1"
+	// bundleManifestFile is the name of the manifest in a model bundle
+	bundleManifestFile = "manifest.json"
+)
+
+// BundleManifest describes a model bundle: the model it packages and the checksums of its
+// files, so that it can be verified and loaded without a registry
+type BundleManifest struct {
+	APIVersion    string `json:"apiVersion"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	PluginVersion string `json:"pluginVersion"`
+	ArtifactName  string `json:"artifactName"`
+	GoPackage     string `json:"goPackage"`
+	GetStateMode  uint32 `json:"getStateMode"`
+	// Modules are the YANG modules of the model, as served by api.ModelData()
+	Modules []BundleModule `json:"modules"`
+	// Plugin is the path of the plugin binary in the bundle, built for Platform
+	Plugin   string `json:"plugin"`
+	Platform string `json:"platform"`
+	// Files are all the files of the bundle but the manifest, sorted by path
+	Files []BundleFile `json:"files"`
+}
+
+// BundleModule is a YANG module of a bundled model
+type BundleModule struct {
+	Name         string `json:"name"`
+	Organization string `json:"organization,omitempty"`
+	Version      string `json:"version"`
+}
+
+// BundleFile is a file of a model bundle along with its size and SHA-256 hash
+type BundleFile struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// PackageOptions are the options of PackageModel
+type PackageOptions struct {
+	// Output is the bundle file, by default <artifactName>-<pluginVersion>.tar.gz
+	Output string
+	// Binary is a plugin binary already built from the model, which is otherwise built with
+	// go build for GOOS and GOARCH, by default those of the compiler
+	Binary string
+	GOOS   string
+	GOARCH string
+}
+
+// bundleEntry is a file to be written to a bundle
+type bundleEntry struct {
+	path    string
+	content []byte
+	mode    int64
+}
+
+// PackageModel packages the compiled model at path in to a gzipped tar bundle holding the
+// plugin binary, the OpenAPI specification, the YANG tree and sources, the meta-data and
+// VERSION files and a manifest; the file of the bundle is returned
+func PackageModel(modelPath string, opts PackageOptions) (string, error) {
+	metaData := &MetaData{}
+	metaDataFile, _, err := loadMetaData(modelPath, "metadata", metaData)
+	if err != nil {
+		return "", err
+	}
+	if err := ValidateMetaData(metaData); err != nil {
+		return "", err
+	}
+	version, err := ioutil.ReadFile(filepath.Join(modelPath, versionFile))
+	if err != nil {
+		return "", err
+	}
+	pluginVersion := strings.TrimSpace(string(version))
+
+	if opts.GOOS == "" {
+		opts.GOOS = runtime.GOOS
+	}
+	if opts.GOARCH == "" {
+		opts.GOARCH = runtime.GOARCH
+	}
+	if opts.Output == "" {
+		opts.Output = fmt.Sprintf("%s-%s.tar.gz", metaData.ArtifactName, pluginVersion)
+	}
+
+	binary := opts.Binary
+	if binary == "" {
+		tmpDir, err := ioutil.TempDir("", "model-compiler-")
+		if err != nil {
+			return "", err
+		}
+		defer os.RemoveAll(tmpDir)
+		binary = filepath.Join(tmpDir, metaData.ArtifactName)
+		if err := buildPlugin(modelPath, binary, opts.GOOS, opts.GOARCH); err != nil {
+			return "", err
+		}
+	}
+
+	plugin := path.Join("bin", metaData.ArtifactName)
+	entries := make([]bundleEntry, 0)
+	add := func(file string, name string, mode int64) error {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("%s is missing, the model must be compiled before it is packaged", file)
+			}
+			return err
+		}
+		entries = append(entries, bundleEntry{path: name, content: content, mode: mode})
+		return nil
+	}
+	if err := add(binary, plugin, 0755); err != nil {
+		return "", err
+	}
+	if err := add(metaDataFile, filepath.Base(metaDataFile), 0644); err != nil {
+		return "", err
+	}
+	for _, file := range []string{versionFile, "openapi.yaml", metaData.Name + ".tree"} {
+		if err := add(filepath.Join(modelPath, file), file, 0644); err != nil {
+			return "", err
+		}
+	}
+	yangDir := filepath.Join(modelPath, "yang")
+	err = filepath.Walk(yangDir, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(modelPath, file)
+		if err != nil {
+			return err
+		}
+		return add(file, filepath.ToSlash(rel), 0644)
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].path < entries[j].path })
+
+	manifest := &BundleManifest{
+		APIVersion:    BundleAPIVersion,
+		Name:          metaData.Name,
+		Version:       metaData.Version,
+		PluginVersion: pluginVersion,
+		ArtifactName:  metaData.ArtifactName,
+		GoPackage:     metaData.GoPackage,
+		GetStateMode:  metaData.GetStateMode,
+		Plugin:        plugin,
+		Platform:      opts.GOOS + "/" + opts.GOARCH,
+		Modules:       make([]BundleModule, 0, len(metaData.Modules)),
+		Files:         make([]BundleFile, 0, len(entries)),
+	}
+	for _, module := range metaData.Modules {
+		manifest.Modules = append(manifest.Modules, BundleModule{
+			Name:         module.Name,
+			Organization: module.Organization,
+			Version:      module.Revision,
+		})
+	}
+	for _, e := range entries {
+		manifest.Files = append(manifest.Files, BundleFile{Path: e.path, Size: int64(len(e.content)), SHA256: hashBytes(e.content)})
+	}
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	entries = append([]bundleEntry{{path: bundleManifestFile, content: append(content, '\n'), mode: 0644}}, entries...)
+
+	if err := writeBundle(opts.Output, fmt.Sprintf("%s-%s", metaData.ArtifactName, pluginVersion), entries); err != nil {
+		return "", err
+	}
+	log.Infof("Packaged '%s' in to '%s'", modelPath, opts.Output)
+	return opts.Output, nil
+}
+
+// buildPlugin builds the plugin of the model at path in to binary for the given platform
+func buildPlugin(modelPath string, binary string, goos string, goarch string) error {
+	log.Infof("Building plugin '%s' for %s/%s", binary, goos, goarch)
+	cmd := exec.Command("go", "build", "-o", binary, "./plugin")
+	cmd.Dir = modelPath
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=0", "GOOS="+goos, "GOARCH="+goarch)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("unable to build the plugin of '%s': %v\n%s", modelPath, err, strings.TrimSpace(output.String()))
+	}
+	return nil
+}
+
+// writeBundle writes the entries in to a gzipped tar file under the prefix directory; the
+// entries carry no time nor owner so that the same inputs give the same bundle
+func writeBundle(file string, prefix string, entries []bundleEntry) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		header := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     path.Join(prefix, e.path),
+			Mode:     e.mode,
+			Size:     int64(len(e.content)),
+			Format:   tar.FormatPAX,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(e.content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// VerifyBundle reads a model bundle and checks every file listed in its manifest against
+// its checksum, returning the manifest
+func VerifyBundle(file string) (*BundleManifest, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a model bundle: %w", file, err)
+	}
+	tr := tar.NewReader(gz)
+
+	var manifest *BundleManifest
+	hashes := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s is not a model bundle: %w", file, err)
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		// Strip the directory named after the artifact
+		name := header.Name
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		if name == bundleManifestFile {
+			manifest = &BundleManifest{}
+			if err := json.Unmarshal(content, manifest); err != nil {
+				return nil, fmt.Errorf("invalid manifest in %s: %w", file, err)
+			}
+			continue
+		}
+		hashes[name] = hashBytes(content)
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%s has no %s", file, bundleManifestFile)
+	}
+	if manifest.APIVersion != BundleAPIVersion {
+		return nil, fmt.Errorf("%s is in the unknown bundle format %s (expected %s)", file, manifest.APIVersion, BundleAPIVersion)
+	}
+
+	errs := make([]string, 0)
+	listed := make(map[string]bool)
+	for _, bf := range manifest.Files {
+		listed[bf.Path] = true
+		hash, ok := hashes[bf.Path]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Sprintf("%s is missing", bf.Path))
+		case hash != bf.SHA256:
+			errs = append(errs, fmt.Sprintf("%s does not match its checksum", bf.Path))
+		}
+	}
+	for name := range hashes {
+		if !listed[name] {
+			errs = append(errs, fmt.Sprintf("%s is not listed in the manifest", name))
+		}
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return manifest, fmt.Errorf("invalid bundle %s:\n%s", file, strings.Join(errs, "\n"))
+	}
+	return manifest, nil
+}
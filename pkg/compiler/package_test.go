@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPackageModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "package-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	binary := filepath.Join(dir, "plugin")
+	assert.NoError(t, ioutil.WriteFile(binary, []byte("#!/bin/sh\n"), 0755))
+
+	opts := PackageOptions{Output: filepath.Join(dir, "bundle.tar.gz"), Binary: binary, GOOS: "linux", GOARCH: "amd64"}
+	bundle, err := PackageModel("../../models/testdevice-1.0.x", opts)
+	assert.NoError(t, err)
+	assert.Equal(t, opts.Output, bundle)
+
+	manifest, err := VerifyBundle(bundle)
+	assert.NoError(t, err)
+	assert.Equal(t, "testdevice", manifest.Name)
+	assert.Equal(t, "1.0.x", manifest.Version)
+	assert.Equal(t, "testdevice-1.0.x", manifest.ArtifactName)
+	assert.Equal(t, "bin/testdevice-1.0.x", manifest.Plugin)
+	assert.Equal(t, "linux/amd64", manifest.Platform)
+	assert.Equal(t, []BundleModule{
+		{Name: "onf-test1", Organization: "Open Networking Foundation", Version: "2018-02-20"},
+		{Name: "onf-test1-extra", Organization: "Open Networking Foundation", Version: "2021-04-01"},
+	}, manifest.Modules)
+	paths := make([]string, 0)
+	for _, f := range manifest.Files {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{
+		"VERSION",
+		"bin/testdevice-1.0.x",
+		"metadata.yaml",
+		"openapi.yaml",
+		"testdevice.tree",
+		"yang/onf-test1-extra@2021-04-01.yang",
+		"yang/onf-test1@2018-02-20.yang",
+	}, paths)
+
+	// The same model gives the same bundle
+	first, err := ioutil.ReadFile(bundle)
+	assert.NoError(t, err)
+	_, err = PackageModel("../../models/testdevice-1.0.x", opts)
+	assert.NoError(t, err)
+	second, err := ioutil.ReadFile(bundle)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	// Tampering with a file is detected
+	tampered := filepath.Join(dir, "tampered.tar.gz")
+	assert.NoError(t, rewriteBundle(bundle, tampered, "testdevice-1.0.x-"+manifest.PluginVersion+"/openapi.yaml", []byte("openapi: 3.0.0\n")))
+	_, err = VerifyBundle(tampered)
+	assert.EqualError(t, err, "invalid bundle "+tampered+":\nopenapi.yaml does not match its checksum")
+
+	// Only compiled models can be packaged
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "model"), os.ModePerm))
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", filepath.Join(dir, "model")))
+	_, err = PackageModel(filepath.Join(dir, "model"), opts)
+	assert.EqualError(t, err, filepath.Join(dir, "model", "openapi.yaml")+" is missing, the model must be compiled before it is packaged")
+}
+
+// rewriteBundle copies a bundle, replacing the content of one of its files
+func rewriteBundle(src string, dst string, name string, content []byte) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gzOut := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gzOut)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if header.Name == name {
+			data = content
+			header.Size = int64(len(content))
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gzOut.Close(); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, out.Bytes(), 0640)
+}
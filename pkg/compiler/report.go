@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Steps of the compilation which come before the stages, as reported in the diagnostics
+const (
+	stageMetaData = "metadata"
+	stageResolve  = "resolve"
+	stageValidate = "validate"
+	stageLint     = "lint"
+)
+
+// reportedTools are the modules whose version is listed in the build reports, as they shape
+// the generated code
+var reportedTools = []string{
+	"github.com/openconfig/goyang",
+	"github.com/openconfig/ygot",
+	"github.com/openconfig/gnmi",
+	"github.com/onosproject/onos-api/go",
+}
+
+// BuildReport is the machine readable outcome of the compilation of a model
+type BuildReport struct {
+	Path          string `json:"path"`
+	Name          string `json:"name,omitempty"`
+	Version       string `json:"version,omitempty"`
+	PluginVersion string `json:"pluginVersion,omitempty"`
+	Success       bool   `json:"success"`
+	DurationMs    int64  `json:"durationMs"`
+	// Inputs are the SHA-256 hashes of the files of the model read by the compiler
+	Inputs      map[string]string `json:"inputs"`
+	Stages      []StageReport     `json:"stages"`
+	Diagnostics []Diagnostic      `json:"diagnostics"`
+}
+
+// StageReport is what a stage did during a compilation
+type StageReport struct {
+	Stage string `json:"stage"`
+	// Status is one of run, cached, skipped or failed
+	Status     string   `json:"status"`
+	DurationMs int64    `json:"durationMs"`
+	Outputs    []string `json:"outputs,omitempty"`
+}
+
+// Report returns the build report of the last compilation, or nil if there was none
+func (c *ModelCompiler) Report() *BuildReport {
+	return c.report
+}
+
+// Diagnostics returns the errors and warnings found during the last compilation
+func (c *ModelCompiler) Diagnostics() []Diagnostic {
+	return c.diagnostics
+}
+
+// ToolVersions returns the versions of Go and of the modules the compiler was built with
+// which shape the generated code
+func ToolVersions() map[string]string {
+	tools := map[string]string{"go": runtime.Version()}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return tools
+	}
+	tools["model-compiler"] = info.Main.Version
+	for _, dep := range info.Deps {
+		for _, tool := range reportedTools {
+			if dep.Path != tool {
+				continue
+			}
+			if dep.Replace != nil {
+				dep = dep.Replace
+			}
+			tools[tool] = dep.Version
+		}
+	}
+	return tools
+}
+
+// WriteBuildReports writes the build reports of a set of models to out as a JSON document,
+// along with the versions of the tools they were built with
+func WriteBuildReports(out io.Writer, reports []*BuildReport) error {
+	content, err := json.MarshalIndent(struct {
+		Tools  map[string]string `json:"tools"`
+		Models []*BuildReport    `json:"models"`
+	}{ToolVersions(), reports}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(content, '\n'))
+	return err
+}
+
+// buildReport gathers the outcome of the compilation of the model at path
+func (c *ModelCompiler) buildReport(path string, duration time.Duration, err error) *BuildReport {
+	if err != nil && !hasErrors(c.diagnostics) {
+		c.diagnose("", err)
+	}
+	report := &BuildReport{
+		Path:          path,
+		PluginVersion: c.pluginVersion,
+		Success:       err == nil,
+		DurationMs:    duration.Milliseconds(),
+		Stages:        make([]StageReport, 0, len(c.results)),
+		Diagnostics:   c.diagnostics,
+	}
+	if c.metaData != nil {
+		report.Name, report.Version = c.metaData.Name, c.metaData.Version
+	}
+	inputs, inputsErr := modelInputs(path)
+	if inputsErr != nil {
+		log.Warnf("Unable to list the inputs of '%s': %v", path, inputsErr)
+	}
+	report.Inputs = inputs
+
+	for _, r := range c.results {
+		status := "run"
+		switch {
+		case r.Skipped:
+			status = "skipped"
+		case r.Cached:
+			status = "cached"
+		case r.Failed:
+			status = "failed"
+		}
+		report.Stages = append(report.Stages, StageReport{
+			Stage:      r.Stage,
+			Status:     status,
+			DurationMs: r.Duration.Milliseconds(),
+			Outputs:    r.Files,
+		})
+	}
+
+	// When checking, the model is compiled in a temporary copy which is not worth reporting
+	if c.sourcePath != "" {
+		report.Path = c.sourcePath
+		for i, d := range report.Diagnostics {
+			if rel, err := filepath.Rel(path, d.File); err == nil && d.File != "" && !strings.HasPrefix(rel, "..") {
+				report.Diagnostics[i].File = filepath.Join(c.sourcePath, rel)
+			}
+		}
+	}
+	return report
+}
+
+// addDiagnostics records diagnostics found by the given stage
+func (c *ModelCompiler) addDiagnostics(stage string, diags ...Diagnostic) {
+	for _, d := range diags {
+		d.Stage = stage
+		c.diagnostics = append(c.diagnostics, d)
+	}
+}
+
+// warn records and logs a warning about file, if any
+func (c *ModelCompiler) warn(stage string, file string, format string, args ...interface{}) {
+	d := Diagnostic{Severity: SeverityWarning, File: file, Message: fmt.Sprintf(format, args...)}
+	log.Warn(d.String())
+	c.addDiagnostics(stage, d)
+}
+
+// diagnose records the error which made a stage fail as diagnostics: meta-data errors are
+// located in the meta-data file, and errors listing YANG problems, which are prefixed
+// with their location, give a diagnostic each
+func (c *ModelCompiler) diagnose(stage string, err error) {
+	var fieldErrs ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		var content []byte
+		if c.metaDataFile != "" {
+			content, _ = ioutil.ReadFile(c.metaDataFile)
+		}
+		for _, fieldErr := range fieldErrs {
+			d := Diagnostic{Severity: SeverityError, File: c.metaDataFile, Message: fieldErr.Error()}
+			d.Line, d.Column = fieldLocation(content, fieldErr.Field)
+			c.addDiagnostics(stage, d)
+		}
+		return
+	}
+
+	located := make([]Diagnostic, 0)
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if locationRegex.MatchString(line) {
+			d := errorDiagnostic(errors.New(line))
+			d.Rule = ""
+			located = append(located, d)
+		}
+	}
+	if len(located) == 0 {
+		located = append(located, Diagnostic{Severity: SeverityError, Message: err.Error()})
+	}
+	c.addDiagnostics(stage, located...)
+}
+
+// hasStageErrors returns true if an error diagnostic was recorded for the stage
+func (c *ModelCompiler) hasStageErrors(stage string) bool {
+	for _, d := range c.diagnostics {
+		if d.Stage == stage && d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldLocation returns the line and column in a YAML document of the attribute designated
+// by field, such as modules[1].revision, or of its closest ancestor found in the document
+func fieldLocation(content []byte, field string) (int, int) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return 0, 0
+	}
+	node := doc.Content[0]
+	line, column := 0, 0
+	rest := field
+	for rest != "" {
+		if strings.HasPrefix(rest, "[") {
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				break
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil || node.Kind != yaml.SequenceNode || i >= len(node.Content) {
+				break
+			}
+			node = node.Content[i]
+			line, column = node.Line, node.Column
+			rest = strings.TrimPrefix(rest[end+1:], ".")
+			continue
+		}
+		if node.Kind != yaml.MappingNode {
+			break
+		}
+		// Keys may contain dots, such as the template names, so the longest match wins; the
+		// keys of the fields are lower case when they come from maps
+		var key, value *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k := node.Content[i].Value
+			if len(k) > len(rest) || !strings.EqualFold(rest[:len(k)], k) {
+				continue
+			}
+			if len(k) < len(rest) && rest[len(k)] != '.' && rest[len(k)] != '[' {
+				continue
+			}
+			if key == nil || len(k) > len(key.Value) {
+				key, value = node.Content[i], node.Content[i+1]
+			}
+		}
+		if key == nil {
+			break
+		}
+		line, column = key.Line, key.Column
+		node = value
+		rest = strings.TrimPrefix(rest[len(key.Value):], ".")
+	}
+	return line, column
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFieldLocation(t *testing.T) {
+	content := []byte(`name: test
+modules:
+  - name: a
+    revision: 2022-01-01
+  - name: b
+    revision: today
+templateOverrides:
+  main.go.tpl: templates/main.go.tpl
+stages:
+  gnmi-client: true
+`)
+	for field, expected := range map[string][2]int{
+		"name":                          {1, 1},
+		"modules[1]":                    {5, 5},
+		"modules[1].revision":           {6, 5},
+		"modules[1].file":               {5, 5},
+		"templateOverrides.main.go.tpl": {8, 3},
+		"stages.GNMI-client":            {10, 3},
+		"version":                       {0, 0},
+	} {
+		line, column := fieldLocation(content, field)
+		assert.Equal(t, expected, [2]int{line, column}, field)
+	}
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "report-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+	metaDataFile := filepath.Join(dir, "metadata.yaml")
+	metaData, err := ioutil.ReadFile(metaDataFile)
+	assert.NoError(t, err)
+
+	c := NewCompiler()
+	c.SelectStages(nil, []string{StageGoModule})
+	assert.NoError(t, c.Compile(dir))
+	report := c.Report()
+	assert.True(t, report.Success)
+	assert.Equal(t, "testdevice", report.Name)
+	assert.Contains(t, report.Inputs, "metadata.yaml")
+	assert.Contains(t, report.Inputs, filepath.Join("yang", "onf-test1@2018-02-20.yang"))
+	assert.Equal(t, StageReport{Stage: StageGoModule, Status: "skipped"}, report.Stages[3])
+	assert.Equal(t, "run", report.Stages[0].Status)
+	assert.Equal(t, []string{filepath.Join("api", "generated.go")}, report.Stages[0].Outputs)
+	assert.Empty(t, report.Diagnostics)
+
+	// Meta-data errors point at the offending attribute
+	assert.NoError(t, ioutil.WriteFile(metaDataFile, bytes.Replace(metaData, []byte("revision: 2021-04-01"), []byte("revision: today"), 1), 0640))
+	assert.Error(t, c.Compile(dir))
+	assert.False(t, c.Report().Success)
+	assert.Equal(t, []Diagnostic{{
+		Stage:    stageMetaData,
+		File:     metaDataFile,
+		Line:     17,
+		Column:   5,
+		Severity: SeverityError,
+		Message:  "modules[1].revision: today is not a YANG revision date",
+	}}, c.Diagnostics())
+
+	// YANG errors are reported by the stage which found them, at their location
+	assert.NoError(t, ioutil.WriteFile(metaDataFile, metaData, 0640))
+	yangFile := filepath.Join(dir, "yang", "onf-test1-extra@2021-04-01.yang")
+	yang, err := ioutil.ReadFile(yangFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(yangFile, bytes.Replace(yang, []byte("prefix t1e;"), []byte("prefix t1e;\n    leaf broken { type t1:missing; }"), 1), 0640))
+	assert.Error(t, c.Compile(dir))
+	diags := c.Diagnostics()
+	assert.NotEmpty(t, diags)
+	for _, d := range diags {
+		assert.Equal(t, StageBindings, d.Stage)
+		assert.Equal(t, SeverityError, d.Severity)
+		assert.Equal(t, yangFile, d.File)
+		assert.Equal(t, 4, d.Line)
+	}
+	assert.Equal(t, StageReport{Stage: StageBindings, Status: "failed", DurationMs: c.Report().Stages[0].DurationMs}, c.Report().Stages[0])
+
+	var out bytes.Buffer
+	assert.NoError(t, WriteBuildReports(&out, []*BuildReport{c.Report()}))
+	decoded := struct {
+		Tools  map[string]string `json:"tools"`
+		Models []*BuildReport    `json:"models"`
+	}{}
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Contains(t, decoded.Tools, "go")
+	assert.Equal(t, diags, decoded.Models[0].Diagnostics)
+	assert.True(t, strings.Contains(out.String(), `"severity": "error"`))
+}
+
+func TestCheckDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "report-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+	assert.NoError(t, os.Remove(filepath.Join(dir, versionFile)))
+
+	// Diagnostics point at the model rather than at the temporary copy it is compiled in
+	c := NewCompiler()
+	c.SelectStages([]string{StageTree}, nil)
+	assert.NoError(t, c.Check(dir, ioutil.Discard))
+	assert.Equal(t, dir, c.Report().Path)
+	assert.Len(t, c.Diagnostics(), 1)
+	assert.Equal(t, SeverityWarning, c.Diagnostics()[0].Severity)
+	assert.Equal(t, filepath.Join(dir, versionFile), c.Diagnostics()[0].File)
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// yangHeader is what is needed from a YANG file to resolve the modules it depends on
+type yangHeader struct {
+	file     string
+	name     string
+	revision string
+	imports  []yangDependency
+	// deviates is set when the module has deviation statements
+	deviates bool
+}
+
+// yangDependency is an import or include of a module, optionally at a given revision
+type yangDependency struct {
+	keyword  string
+	name     string
+	revision string
+	// line and column of the statement in the file
+	line   int
+	column int
+}
+
+func (d yangDependency) String() string {
+	if d.revision != "" {
+		return fmt.Sprintf("%s@%s", d.name, d.revision)
+	}
+	return d.name
+}
+
+// yangLibrary indexes the YANG files found in a list of directories, searched in order
+type yangLibrary struct {
+	dirs    []string
+	modules map[string][]*yangHeader
+}
+
+// newYangLibrary indexes the YANG files found in dirs and their sub-directories
+func newYangLibrary(dirs ...string) (*yangLibrary, error) {
+	lib := &yangLibrary{dirs: dirs, modules: make(map[string][]*yangHeader)}
+	for _, dir := range dirs {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			log.Warnf("YANG search path '%s' does not exist", dir)
+			continue
+		}
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".yang" {
+				return nil
+			}
+			header, err := readYangHeader(path)
+			if err != nil {
+				return err
+			}
+			lib.modules[header.name] = append(lib.modules[header.name], header)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lib, nil
+}
+
+// readYangHeader parses a YANG file for its name, latest revision, imports and includes
+func readYangHeader(file string) (*yangHeader, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	stmts, err := parseYang(content, file)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 || (stmts[0].Keyword != "module" && stmts[0].Keyword != "submodule") {
+		return nil, fmt.Errorf("%s does not contain a YANG module or submodule", file)
+	}
+
+	header := &yangHeader{file: file, name: stmts[0].Argument}
+	for _, stmt := range stmts[0].SubStatements() {
+		switch stmt.Keyword {
+		case "revision":
+			if stmt.Argument > header.revision {
+				header.revision = stmt.Argument
+			}
+		case "import", "include":
+			dep := yangDependency{keyword: stmt.Keyword, name: stmt.Argument}
+			// The statements may be shared with a file of the same content, only the
+			// position in the file is theirs
+			_, dep.line, dep.column = statementLocation(stmt)
+			for _, s := range stmt.SubStatements() {
+				if s.Keyword == "revision-date" {
+					dep.revision = s.Argument
+				}
+			}
+			header.imports = append(header.imports, dep)
+		case "deviation":
+			header.deviates = true
+		}
+	}
+	return header, nil
+}
+
+// maxParsedYang is the number of parsed YANG files kept by parseYang, enough for the
+// search paths of a workspace
+const maxParsedYang = 512
+
+// parsedYang caches the parsed YANG files by content, so that the headers read to resolve
+// the imports of the models of a workspace, e.g. from a common search path, are only parsed
+// once; the modules compiled by a model are still read and processed by each compilation, as
+// per yangModuleSet. The least recently used files are evicted, e.g. the former contents of
+// the files edited in watch mode
+var parsedYang = struct {
+	sync.Mutex
+	statements map[[sha256.Size]byte]*list.Element
+	lru        *list.List
+}{statements: make(map[[sha256.Size]byte]*list.Element), lru: list.New()}
+
+type parsedYangFile struct {
+	sum        [sha256.Size]byte
+	statements []*yang.Statement
+}
+
+// parseYang parses the content of a YANG file, reusing the statements of any file
+// previously parsed with the same content; the statements must not be modified
+func parseYang(content []byte, file string) ([]*yang.Statement, error) {
+	sum := sha256.Sum256(content)
+	parsedYang.Lock()
+	if e, ok := parsedYang.statements[sum]; ok {
+		parsedYang.lru.MoveToFront(e)
+		parsedYang.Unlock()
+		return e.Value.(*parsedYangFile).statements, nil
+	}
+	parsedYang.Unlock()
+
+	stmts, err := yang.Parse(string(content), file)
+	if err != nil {
+		return nil, err
+	}
+	parsedYang.Lock()
+	defer parsedYang.Unlock()
+	if _, ok := parsedYang.statements[sum]; !ok {
+		parsedYang.statements[sum] = parsedYang.lru.PushFront(&parsedYangFile{sum: sum, statements: stmts})
+		for parsedYang.lru.Len() > maxParsedYang {
+			oldest := parsedYang.lru.Back()
+			parsedYang.lru.Remove(oldest)
+			delete(parsedYang.statements, oldest.Value.(*parsedYangFile).sum)
+		}
+	}
+	return stmts, nil
+}
+
+// find returns the file providing the module at the given revision, or at its latest
+// revision when no revision is given; the first directory of the library providing the
+// module wins
+func (l *yangLibrary) find(name string, revision string) (*yangHeader, error) {
+	candidates := l.modules[name]
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("module %s is not found in %s", name, strings.Join(l.dirs, ", "))
+	}
+	var found *yangHeader
+	for _, dir := range l.dirs {
+		for _, header := range candidates {
+			if !isInDir(header.file, dir) {
+				continue
+			}
+			if revision != "" && header.revision == revision {
+				return header, nil
+			}
+			if revision == "" && (found == nil || header.revision > found.revision) {
+				found = header
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+
+	revisions := make([]string, 0, len(candidates))
+	for _, header := range candidates {
+		revisions = append(revisions, header.revision)
+	}
+	sort.Strings(revisions)
+	return nil, fmt.Errorf("revision %s of module %s is not found, the available revisions are %s",
+		revision, name, strings.Join(revisions, ", "))
+}
+
+// add records that a copy of the module described by header is in file; the copy is
+// found first by further look ups
+func (l *yangLibrary) add(header *yangHeader, file string) *yangHeader {
+	copy := *header
+	copy.file = file
+	l.modules[header.name] = append([]*yangHeader{&copy}, l.modules[header.name]...)
+	return &copy
+}
+
+func isInDir(file string, dir string) bool {
+	rel, err := filepath.Rel(dir, file)
+	return err == nil && !strings.HasPrefix(rel, "..")
+}
+
+// ResolveYangImports makes sure that the root modules of a model and every module they
+// import or include, directly or not, are available in yangDir. Missing modules are
+// copied from the first of the search paths providing them, honouring the revision-date
+// of the imports; the names of the copied files are returned.
+func ResolveYangImports(yangDir string, roots []Module, searchPaths []string) ([]string, error) {
+	if err := os.MkdirAll(yangDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	lib, err := newYangLibrary(append([]string{yangDir}, searchPaths...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := make([]string, 0)
+	visited := make(map[string]bool)
+	pending := make([]*yangHeader, 0, len(roots))
+	for _, root := range roots {
+		file := filepath.Join(yangDir, root.YangFile)
+		if _, err := os.Stat(file); err == nil {
+			header, err := readYangHeader(file)
+			if err != nil {
+				return nil, err
+			}
+			pending = append(pending, header)
+			continue
+		}
+		found, err := lib.find(root.Name, root.Revision)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve root module %s: %v", root.Name, err)
+		}
+		if err := copyFile(found.file, file); err != nil {
+			return nil, err
+		}
+		log.Infof("Copied %s from '%s'", root.YangFile, found.file)
+		copied = append(copied, root.YangFile)
+		pending = append(pending, lib.add(found, file))
+	}
+
+	errs := make([]string, 0)
+	for len(pending) > 0 {
+		header := pending[0]
+		pending = pending[1:]
+		if visited[header.file] {
+			continue
+		}
+		visited[header.file] = true
+
+		for _, dep := range header.imports {
+			found, err := lib.find(dep.name, dep.revision)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s:%d:%d: unable to resolve %s of %s: %v",
+					header.file, dep.line, dep.column, dep.keyword, dep, err))
+				continue
+			}
+			if !isInDir(found.file, yangDir) {
+				file, err := copyToYangDir(found, yangDir)
+				if err != nil {
+					return nil, err
+				}
+				log.Infof("Copied %s from '%s'", filepath.Base(file), found.file)
+				copied = append(copied, filepath.Base(file))
+				found = lib.add(found, file)
+			}
+			pending = append(pending, found)
+		}
+	}
+	sort.Strings(copied)
+	if len(errs) > 0 {
+		return copied, fmt.Errorf("unresolved YANG dependencies:\n%s", strings.Join(errs, "\n"))
+	}
+	return copied, nil
+}
+
+// copyToYangDir copies a module in to yangDir, naming it after its revision if its
+// name is already taken
+func copyToYangDir(header *yangHeader, yangDir string) (string, error) {
+	file := filepath.Join(yangDir, filepath.Base(header.file))
+	if _, err := os.Stat(file); err == nil {
+		file = filepath.Join(yangDir, fmt.Sprintf("%s@%s.yang", header.name, header.revision))
+	}
+	return file, copyFile(header.file, file)
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const libraryDir = "../../test/yang/library"
+
+func writeRootModule(t *testing.T, yangDir string, typesImport string) {
+	assert.NoError(t, os.MkdirAll(yangDir, os.ModePerm))
+	root := `module ex-root {
+  namespace "urn:example:root";
+  prefix exr;
+
+  import ex-base { prefix exb; }
+  ` + typesImport + `
+
+  revision 2022-01-01;
+
+  container root {
+    leaf name { type ext:name; }
+  }
+}
+`
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(yangDir, "ex-root.yang"), []byte(root), 0640))
+}
+
+func TestResolveYangImports(t *testing.T) {
+	dir, err := ioutil.TempDir("", "resolve-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	roots := []Module{{Name: "ex-root", Revision: "2022-01-01", YangFile: "ex-root.yang"}}
+
+	// The root module pins an old revision of the types, which is then also used by ex-base
+	// as the model directory comes first
+	yangDir := filepath.Join(dir, "pinned")
+	writeRootModule(t, yangDir, `import ex-types { prefix ext; revision-date 2020-01-01; }`)
+	copied, err := ResolveYangImports(yangDir, roots, []string{libraryDir})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ex-base-sub.yang", "ex-base.yang", "ex-types@2020-01-01.yang"}, copied)
+
+	// Nothing is copied once the dependencies are in the model
+	copied, err = ResolveYangImports(yangDir, roots, []string{libraryDir})
+	assert.NoError(t, err)
+	assert.Empty(t, copied)
+
+	yangDir = filepath.Join(dir, "mismatch")
+	writeRootModule(t, yangDir, `import ex-types { prefix ext; revision-date 2019-01-01; }`)
+	_, err = ResolveYangImports(yangDir, roots, []string{libraryDir})
+	assert.EqualError(t, err, "unresolved YANG dependencies:\n"+
+		filepath.Join(yangDir, "ex-root.yang")+":6:3: unable to resolve import of ex-types@2019-01-01: revision 2019-01-01 of module ex-types is not found, the available revisions are 2020-01-01, 2021-01-01")
+
+	yangDir = filepath.Join(dir, "missing")
+	writeRootModule(t, yangDir, `import ex-missing { prefix ext; }`)
+	_, err = ResolveYangImports(yangDir, roots, []string{libraryDir})
+	assert.EqualError(t, err, "unresolved YANG dependencies:\n"+
+		filepath.Join(yangDir, "ex-root.yang")+":6:3: unable to resolve import of ex-missing: module ex-missing is not found in "+yangDir+", "+libraryDir)
+
+	// Root modules may come from the library too
+	yangDir = filepath.Join(dir, "library")
+	copied, err = ResolveYangImports(yangDir, []Module{{Name: "ex-base", Revision: "2021-06-01", YangFile: "ex-base.yang"}}, []string{libraryDir})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ex-base-sub.yang", "ex-base.yang", "ex-types@2021-01-01.yang"}, copied)
+}
+
+func TestParseYang(t *testing.T) {
+	module := func(n int) []byte {
+		return []byte(fmt.Sprintf("module ex-%d { namespace \"urn:ex:%d\"; prefix ex; }", n, n))
+	}
+	first, err := parseYang(module(0), "ex-0.yang")
+	assert.NoError(t, err)
+	again, err := parseYang(module(0), "copy/ex-0.yang")
+	assert.NoError(t, err)
+	assert.Same(t, first[0], again[0])
+
+	// The least recently used files are evicted
+	for n := 1; n <= maxParsedYang; n++ {
+		_, err := parseYang(module(n), fmt.Sprintf("ex-%d.yang", n))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, maxParsedYang, parsedYang.lru.Len())
+	again, err = parseYang(module(0), "ex-0.yang")
+	assert.NoError(t, err)
+	assert.NotSame(t, first[0], again[0])
+	assert.Equal(t, maxParsedYang, len(parsedYang.statements))
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	defaultModelVersion  = "1.0.x"
+	defaultPluginVersion = "1.0.0-dev"
+	defaultGoPackageBase = "github.com/onosproject/config-models/models"
+)
+
+// InitOptions are the attributes of a new model which cannot be discovered from its YANG files
+type InitOptions struct {
+	// Name of the model, defaults to the name of the model directory
+	Name string
+	// Version of the model, defaults to 1.0.x
+	Version string
+	// GoPackage of the model plugin, defaults to a package in config-models named after the artifact
+	GoPackage string
+	// PluginVersion written to the VERSION file, defaults to 1.0.0-dev
+	PluginVersion string
+}
+
+var metaDataTemplate = template.Must(template.New("metadata.yaml").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+}).Parse(`# SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+apiVersion: {{ .APIVersion }}
+name: {{ .Name }}
+version: {{ .Version }}
+artifactName: {{ .ArtifactName }}
+goPackage: {{ .GoPackage }}
+modules:
+{{- range .Modules }}
+  - name: {{ .Name }}
+    organization: {{ quote .Organization }}
+    revision: {{ .Revision }}
+    file: {{ .YangFile }}
+{{- end }}
+`))
+
+// InitModel creates a new model in modelDir from the YANG files found in yangDir: the YANG
+// files are copied in to the yang directory of the model, and the meta-data and VERSION
+// files are written, listing the root modules discovered from the YANG files. The root
+// modules are the ones defining data nodes, which are not just types or groupings.
+func InitModel(yangDir string, modelDir string, opts InitOptions) (*MetaData, error) {
+	if _, err := os.Stat(filepath.Join(modelDir, "metadata.yaml")); err == nil {
+		return nil, fmt.Errorf("%s already contains a model", modelDir)
+	}
+
+	files, err := yangFiles(yangDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no YANG files found in %s", yangDir)
+	}
+	ms, diags := readYangModules(yangDir, files)
+	if hasErrors(diags) {
+		msgs := make([]string, 0, len(diags))
+		for _, d := range diags {
+			msgs = append(msgs, d.String())
+		}
+		return nil, fmt.Errorf("unable to process YANG files:\n%s", strings.Join(msgs, "\n"))
+	}
+
+	metaData := &MetaData{
+		APIVersion: MetaDataAPIVersion,
+		Name:       opts.Name,
+		Version:    opts.Version,
+	}
+	if metaData.Name == "" {
+		abs, err := filepath.Abs(modelDir)
+		if err != nil {
+			return nil, err
+		}
+		metaData.Name = filepath.Base(abs)
+	}
+	if metaData.Version == "" {
+		metaData.Version = defaultModelVersion
+	}
+	metaData.ArtifactName = fmt.Sprintf("%s-%s", metaData.Name, metaData.Version)
+	metaData.GoPackage = opts.GoPackage
+	if metaData.GoPackage == "" {
+		metaData.GoPackage = fmt.Sprintf("%s/%s", defaultGoPackageBase, metaData.ArtifactName)
+	}
+	for _, m := range sortedModules(ms) {
+		if m.Kind() != "module" || !definesData(ms, m) {
+			continue
+		}
+		file, _, _ := statementLocation(m.Statement())
+		organization := ""
+		if m.Organization != nil {
+			organization = strings.Join(strings.Fields(m.Organization.Name), " ")
+		}
+		metaData.Modules = append(metaData.Modules, Module{
+			Name:         m.Name,
+			Revision:     m.Current(),
+			Organization: organization,
+			YangFile:     filepath.Base(file),
+		})
+	}
+	sort.Slice(metaData.Modules, func(i, j int) bool {
+		return metaData.Modules[i].Name < metaData.Modules[j].Name
+	})
+	if err := ValidateMetaData(metaData); err != nil {
+		return nil, err
+	}
+
+	modelYangDir := filepath.Join(modelDir, "yang")
+	if !sameDir(yangDir, modelYangDir) {
+		if err := copyDir(yangDir, modelYangDir); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.Create(filepath.Join(modelDir, "metadata.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if err := metaDataTemplate.Execute(file, metaData); err != nil {
+		return nil, err
+	}
+
+	pluginVersion := opts.PluginVersion
+	if pluginVersion == "" {
+		pluginVersion = defaultPluginVersion
+	}
+	if err := ioutil.WriteFile(filepath.Join(modelDir, versionFile), []byte(pluginVersion+"\n"), 0640); err != nil {
+		return nil, err
+	}
+	log.Infof("Created model %s in '%s' with modules %s", metaData.ArtifactName, modelDir, moduleNames(metaData))
+	return metaData, nil
+}
+
+// yangFiles lists the YANG files in dir
+func yangFiles(dir string) ([]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if !file.IsDir() && filepath.Ext(file.Name()) == ".yang" {
+			names = append(names, file.Name())
+		}
+	}
+	return names, nil
+}
+
+// definesData returns true if the module, or one of the submodules it includes,
+// defines or augments data nodes
+func definesData(ms *yang.Modules, m *yang.Module) bool {
+	if len(m.Container)+len(m.List)+len(m.Leaf)+len(m.LeafList)+len(m.Choice)+
+		len(m.Anydata)+len(m.Anyxml)+len(m.Uses)+len(m.Augment) > 0 {
+		return true
+	}
+	for _, i := range m.Include {
+		if sub := ms.FindModule(i); sub != nil && definesData(ms, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameDir(a string, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
+
+func moduleNames(metaData *MetaData) string {
+	names := make([]string, 0, len(metaData.Modules))
+	for _, m := range metaData.Modules {
+		names = append(names, m.Name)
+	}
+	return strings.Join(names, ", ")
+}
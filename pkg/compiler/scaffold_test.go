@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "init-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	modelDir := filepath.Join(dir, "testdevice")
+
+	metaData, err := InitModel("../../models/testdevice-2.0.x/yang", modelDir, InitOptions{Version: "2.0.x"})
+	assert.NoError(t, err)
+	assert.Equal(t, "testdevice-2.0.x", metaData.ArtifactName)
+
+	// The identities module only defines types so it is not a root module
+	loaded := &MetaData{}
+	assert.NoError(t, LoadMetaData(modelDir, "metadata", loaded))
+	assert.NoError(t, ValidateMetaData(loaded))
+	assert.Equal(t, "testdevice", loaded.Name)
+	assert.Equal(t, "github.com/onosproject/config-models/models/testdevice-2.0.x", loaded.GoPackage)
+	assert.Equal(t, []Module{
+		{Name: "onf-test1", Revision: "2019-06-10", Organization: "Open Networking Foundation.", YangFile: "onf-test1@2019-06-10.yang"},
+		{Name: "onf-test1-augmented", Revision: "2020-02-29", Organization: "Open Networking Foundation.", YangFile: "onf-test1-augmented@2020-02-29.yang"},
+	}, loaded.Modules)
+
+	version, err := ioutil.ReadFile(filepath.Join(modelDir, versionFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0-dev\n", string(version))
+
+	files, err := yangFiles(filepath.Join(modelDir, "yang"))
+	assert.NoError(t, err)
+	assert.Len(t, files, 3)
+
+	_, err = InitModel("../../models/testdevice-2.0.x/yang", modelDir, InitOptions{})
+	assert.EqualError(t, err, modelDir+" already contains a model")
+
+	_, err = InitModel("../../test/yang/lint", filepath.Join(dir, "broken"), InitOptions{})
+	assert.Error(t, err)
+}
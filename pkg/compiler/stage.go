@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Names of the compiler stages
+const (
+	StageBindings   = "bindings"
+	StageTree       = "tree"
+	StageMain       = "main"
+	StageGoModule   = "gomod"
+	StageMakefile   = "makefile"
+	StageDockerfile = "dockerfile"
+	StageOpenAPI    = "openapi"
+	StageGnmiClient = "gnmi-client"
+	StageDocs       = "docs"
+	StageTests      = "tests"
+)
+
+// stage is a step of the compilation generating some of the model artifacts
+type stage struct {
+	name      string
+	dependsOn []string
+	// disabled stages only run when requested in the meta-data or on the command line
+	disabled bool
+	// templates are the templates the stage applies, which are among its inputs
+	templates []string
+	// inputs are the files of the model, besides its meta-data, VERSION and YANG files,
+	// the stage reads
+	inputs   []string
+	generate func(c *ModelCompiler, path string) error
+}
+
+// stages are listed in the order in which they run when they do not depend on each other
+var stages = []stage{
+	{name: StageBindings, generate: (*ModelCompiler).generateGolangBindings},
+	{name: StageTree, generate: (*ModelCompiler).generateModelTree},
+	{name: StageMain, dependsOn: []string{StageBindings}, templates: []string{mainTemplate, modelTemplate, pathsTemplate}, generate: (*ModelCompiler).generateMainAndModel},
+	{name: StageGoModule, templates: []string{gomodTemplate}, generate: (*ModelCompiler).generateGoModule},
+	{name: StageMakefile, templates: []string{makefileTemplate}, generate: (*ModelCompiler).generateMakefile},
+	{name: StageDockerfile, templates: []string{dockerfileTemplate}, generate: (*ModelCompiler).generateDockerfile},
+	{name: StageOpenAPI, dependsOn: []string{StageBindings}, generate: (*ModelCompiler).generateOpenApi},
+	// the gNMI client generator is on hold at the moment, so it only runs on request
+	{name: StageGnmiClient, dependsOn: []string{StageBindings}, disabled: true, templates: []string{gnmiGenTemplate}, generate: (*ModelCompiler).generateGnmiClientGenerator},
+	{name: StageDocs, dependsOn: []string{StageTree}, disabled: true, templates: []string{docsTemplate}, generate: (*ModelCompiler).generateDocs},
+	{name: StageTests, templates: []string{testsTemplate}, inputs: []string{filepath.Join(testdataDir, testCasesFile)}, generate: (*ModelCompiler).generateTests},
+}
+
+// StageNames returns the names of all the compiler stages
+func StageNames() []string {
+	names := make([]string, 0, len(stages))
+	for _, s := range stages {
+		names = append(names, s.name)
+	}
+	return names
+}
+
+func findStage(name string) (stage, bool) {
+	for _, s := range stages {
+		if s.name == name {
+			return s, true
+		}
+	}
+	return stage{}, false
+}
+
+// StageResult describes what a stage produced
+type StageResult struct {
+	Stage   string
+	Skipped bool
+	// Cached is set when the files generated by a previous compilation were reused
+	Cached   bool
+	Failed   bool
+	Files    []string
+	Duration time.Duration
+}
+
+// SelectStages restricts the stages run by Compile; when only is not empty just the listed
+// stages and the ones they depend on are run, ignoring the meta-data, and the stages in
+// skip are never run
+func (c *ModelCompiler) SelectStages(only []string, skip []string) {
+	c.onlyStages = only
+	c.skipStages = skip
+}
+
+// enabledStages returns which stages are to be run based on their defaults, the meta-data
+// and the stages selected with SelectStages
+func (c *ModelCompiler) enabledStages() (map[string]bool, error) {
+	for _, name := range append(append([]string{}, c.onlyStages...), c.skipStages...) {
+		if _, ok := findStage(name); !ok {
+			return nil, fmt.Errorf("unknown stage %s (expected one of %s)", name, strings.Join(StageNames(), ", "))
+		}
+	}
+
+	enabled := make(map[string]bool)
+	if len(c.onlyStages) > 0 {
+		var include func(name string)
+		include = func(name string) {
+			enabled[name] = true
+			s, _ := findStage(name)
+			for _, dep := range s.dependsOn {
+				include(dep)
+			}
+		}
+		for _, name := range c.onlyStages {
+			include(name)
+		}
+	} else {
+		for _, s := range stages {
+			enabled[s.name] = !s.disabled
+		}
+		for name, enable := range c.metaData.Stages {
+			// ValidateMetaData reports them too, but the meta-data may not have been validated
+			if _, ok := findStage(strings.ToLower(name)); !ok {
+				return nil, fmt.Errorf("stages.%s: unknown stage (expected one of %s)", name, strings.Join(StageNames(), ", "))
+			}
+			enabled[strings.ToLower(name)] = enable
+		}
+	}
+	for _, name := range c.skipStages {
+		enabled[name] = false
+	}
+
+	for _, s := range stages {
+		if !enabled[s.name] {
+			continue
+		}
+		for _, dep := range s.dependsOn {
+			if !enabled[dep] {
+				return nil, fmt.Errorf("stage %s depends on stage %s which is disabled", s.name, dep)
+			}
+		}
+	}
+	return enabled, nil
+}
+
+// orderedStages sorts the stages so that each one comes after the stages it depends on
+func orderedStages() []stage {
+	ordered := make([]stage, 0, len(stages))
+	visited := make(map[string]bool)
+	var visit func(s stage)
+	visit = func(s stage) {
+		if visited[s.name] {
+			return
+		}
+		visited[s.name] = true
+		for _, dep := range s.dependsOn {
+			d, _ := findStage(dep)
+			visit(d)
+		}
+		ordered = append(ordered, s)
+	}
+	for _, s := range stages {
+		visit(s)
+	}
+	return ordered
+}
+
+// runStages runs the enabled stages in dependency order and records what each produced;
+// stages whose inputs and outputs did not change since the last compilation are not run
+// again, unless forced
+func (c *ModelCompiler) runStages(path string) error {
+	enabled, err := c.enabledStages()
+	if err != nil {
+		return err
+	}
+
+	manifest := loadCacheManifest(path)
+	inputs := make(map[string]string)
+	run := make(map[string]bool)
+	var require func(s stage)
+	require = func(s stage) {
+		run[s.name] = true
+		// The stages depended upon may hold state the stage needs, such as the schema tree
+		for _, dep := range s.dependsOn {
+			d, _ := findStage(dep)
+			require(d)
+		}
+	}
+	for _, s := range orderedStages() {
+		if !enabled[s.name] {
+			continue
+		}
+		if inputs[s.name], err = c.stageInputs(s, path); err != nil {
+			return err
+		}
+		if c.force || !manifest.upToDate(s.name, inputs[s.name], path) {
+			require(s)
+		}
+	}
+
+	c.results = make([]StageResult, 0, len(stages))
+	for _, s := range orderedStages() {
+		if !enabled[s.name] {
+			c.results = append(c.results, StageResult{Stage: s.name, Skipped: true})
+			continue
+		}
+		if !run[s.name] {
+			files := make([]string, 0, len(manifest.Stages[s.name].Outputs))
+			for file := range manifest.Stages[s.name].Outputs {
+				files = append(files, file)
+			}
+			sort.Strings(files)
+			c.results = append(c.results, StageResult{Stage: s.name, Cached: true, Files: files})
+			continue
+		}
+		c.generated = make([]string, 0)
+		start := time.Now()
+		if err := s.generate(c, path); err != nil {
+			c.results = append(c.results, StageResult{Stage: s.name, Failed: true, Duration: time.Since(start)})
+			if !c.hasStageErrors(s.name) {
+				c.diagnose(s.name, err)
+			}
+			return fmt.Errorf("stage %s failed: %w", s.name, err)
+		}
+		files := make([]string, 0, len(c.generated))
+		for _, file := range c.generated {
+			if rel, err := filepath.Rel(path, file); err == nil {
+				file = rel
+			}
+			files = append(files, file)
+		}
+		sort.Strings(files)
+		c.results = append(c.results, StageResult{Stage: s.name, Files: files, Duration: time.Since(start)})
+		if err := manifest.record(s.name, inputs[s.name], path, files); err != nil {
+			return err
+		}
+	}
+	if err := manifest.save(path); err != nil {
+		return err
+	}
+
+	log.Infof("Compilation summary for '%s':", path)
+	for _, r := range c.results {
+		if r.Skipped {
+			log.Infof("  %-12s skipped", r.Stage)
+		} else if r.Cached {
+			log.Infof("  %-12s reused %s", r.Stage, strings.Join(r.Files, ", "))
+		} else {
+			log.Infof("  %-12s %s", r.Stage, strings.Join(r.Files, ", "))
+		}
+	}
+	return nil
+}
+
+// Results returns what each stage produced during the last compilation
+func (c *ModelCompiler) Results() []StageResult {
+	return c.results
+}
+
+// createFile creates a file generated by the running stage
+func (c *ModelCompiler) createFile(file string) (*os.File, error) {
+	f, err := os.Create(file)
+	if err == nil {
+		c.generated = append(c.generated, file)
+	}
+	return f, err
+}
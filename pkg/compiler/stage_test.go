@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnabledStages(t *testing.T) {
+	tests := []struct {
+		name     string
+		metaData MetaData
+		only     []string
+		skip     []string
+		enabled  []string
+		err      string
+	}{
+		{
+			name:    "defaults",
+			enabled: []string{StageBindings, StageTree, StageMain, StageGoModule, StageMakefile, StageDockerfile, StageOpenAPI, StageTests},
+		},
+		{
+			name:     "meta-data",
+			metaData: MetaData{Stages: map[string]bool{"docs": true, "makefile": false, "openapi": false}},
+			enabled:  []string{StageBindings, StageTree, StageMain, StageGoModule, StageDockerfile, StageDocs, StageTests},
+		},
+		{
+			name:     "only with dependencies",
+			metaData: MetaData{Stages: map[string]bool{"tree": false}},
+			only:     []string{StageDocs, StageOpenAPI},
+			enabled:  []string{StageBindings, StageTree, StageOpenAPI, StageDocs},
+		},
+		{
+			name:    "skip",
+			skip:    []string{StageTree, StageOpenAPI},
+			enabled: []string{StageBindings, StageMain, StageGoModule, StageMakefile, StageDockerfile, StageTests},
+		},
+		{
+			name: "skip dependency",
+			skip: []string{StageBindings},
+			err:  "stage main depends on stage bindings which is disabled",
+		},
+		{
+			name:     "unknown meta-data stage",
+			metaData: MetaData{Stages: map[string]bool{"doc": true}},
+			err:      "stages.doc: unknown stage (expected one of bindings, tree, main, gomod, makefile, dockerfile, openapi, gnmi-client, docs, tests)",
+		},
+		{
+			name: "unknown stage",
+			only: []string{"unknown"},
+			err:  "unknown stage unknown (expected one of bindings, tree, main, gomod, makefile, dockerfile, openapi, gnmi-client, docs, tests)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ModelCompiler{metaData: &tt.metaData}
+			c.SelectStages(tt.only, tt.skip)
+			enabled, err := c.enabledStages()
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			names := make([]string, 0)
+			for _, s := range orderedStages() {
+				if enabled[s.name] {
+					names = append(names, s.name)
+				}
+			}
+			assert.Equal(t, tt.enabled, names)
+		})
+	}
+}
+
+func TestOrderedStages(t *testing.T) {
+	position := make(map[string]int)
+	for i, s := range orderedStages() {
+		position[s.name] = i
+	}
+	assert.Len(t, position, len(stages))
+	for _, s := range stages {
+		for _, dep := range s.dependsOn {
+			assert.Less(t, position[dep], position[s.name], "%s must run after %s", s.name, dep)
+		}
+	}
+}
+
+func TestCompileResults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stage-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+
+	c := NewCompiler()
+	c.SelectStages([]string{StageDocs}, nil)
+	assert.NoError(t, c.Compile(dir))
+
+	results := c.Results()
+	assert.Len(t, results, len(stages))
+	for _, r := range results {
+		switch r.Stage {
+		case StageTree:
+			assert.Equal(t, []string{"testdevice.tree"}, r.Files)
+		case StageDocs:
+			assert.Equal(t, []string{"docs/README.md"}, r.Files)
+		default:
+			assert.True(t, r.Skipped, r.Stage)
+		}
+	}
+}
+
+func TestCompilePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stage-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+
+	c := NewCompiler()
+	c.SelectStages([]string{StageMain}, nil)
+	assert.NoError(t, c.Compile(dir))
+
+	// The path lists are extracted along with the bindings and generated with the main
+	assert.Len(t, c.dictionary.ReadOnlyPath, 2)
+	assert.Equal(t, "/cont1a/cont2a/leaf2c", c.dictionary.ReadOnlyPath[0].Path)
+	assert.Len(t, c.dictionary.ReadWritePath, 21)
+	assert.Equal(t, c.dictionary.ReadWritePath, c.modelInfo.ReadWritePath)
+	generated, err := ioutil.ReadFile(filepath.Join(dir, "api", "paths.go"))
+	assert.NoError(t, err)
+	expected, err := ioutil.ReadFile("../../models/testdevice-1.0.x/api/paths.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(generated))
+}
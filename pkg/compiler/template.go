@@ -6,19 +6,45 @@ package compiler
 
 import (
 	"fmt"
+	"github.com/onosproject/config-models/templates"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
-func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
+// templatesDir is the directory of a model in which its own versions of the templates can be placed
+const templatesDir = "templates"
+
+func (c *ModelCompiler) applyTemplate(name, path, outPath string) error {
 	var funcs template.FuncMap = map[string]interface{}{
 		"quote": func(value interface{}) string {
 			return fmt.Sprintf("\"%s\"", value)
 		},
+		"goquote": func(value interface{}) string {
+			return strconv.Quote(fmt.Sprint(value))
+		},
+		"golist": func(value interface{}) string {
+			items := make([]string, 0)
+			switch list := value.(type) {
+			case []string:
+				for _, item := range list {
+					items = append(items, strconv.Quote(item))
+				}
+			case []uint64:
+				for _, item := range list {
+					items = append(items, strconv.FormatUint(item, 10))
+				}
+			}
+			if len(items) == 0 {
+				return "nil"
+			}
+			return fmt.Sprintf("%T{%s}", value, strings.Join(items, ", "))
+		},
 		"replace": func(search, replace string, value interface{}) string {
 			return strings.ReplaceAll(fmt.Sprint(value), search, replace)
 		},
@@ -33,14 +59,18 @@ func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
 		},
 	}
 
+	content, err := c.loadTemplate(name, path)
+	if err != nil {
+		return err
+	}
 	tpl, err := template.New(name).
 		Funcs(funcs).
-		ParseFiles(tplPath)
+		Parse(content)
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Create(outPath)
+	file, err := c.createFile(outPath)
 	if err != nil {
 		return err
 	}
@@ -49,8 +79,47 @@ func (c *ModelCompiler) applyTemplate(name, tplPath, outPath string) error {
 	return tpl.Execute(file, c.dictionary)
 }
 
-func (c *ModelCompiler) getTemplatePath(name string) string {
-	return filepath.Join("templates", name)
+// loadTemplate returns the template with the given name for the model at path; a template
+// listed in the meta-data templateOverrides takes precedence over one found in the model
+// templates directory, which takes precedence over the one embedded in the compiler
+func (c *ModelCompiler) loadTemplate(name, path string) (string, error) {
+	file, content, err := c.readTemplate(name, path)
+	if err == nil && file != "" {
+		log.Infof("Using template '%s' for %s", file, name)
+	}
+	return content, err
+}
+
+// readTemplate returns the file and content of the template with the given name for the
+// model at path, the file being empty for the templates embedded in the compiler
+func (c *ModelCompiler) readTemplate(name, path string) (string, string, error) {
+	if file, ok := c.metaData.templateOverride(name); ok {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(path, file)
+		}
+		content, err := ioutil.ReadFile(file)
+		return file, string(content), err
+	}
+
+	file := filepath.Join(path, templatesDir, name)
+	if content, err := ioutil.ReadFile(file); err == nil {
+		return file, string(content), nil
+	} else if !os.IsNotExist(err) {
+		return "", "", err
+	}
+
+	content, err := templates.Templates.ReadFile(name)
+	return "", string(content), err
+}
+
+// templateNames returns the names of the templates embedded in the compiler
+func templateNames() []string {
+	names := make([]string, 0)
+	entries, _ := templates.Templates.ReadDir(".")
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
 }
 
 func (c *ModelCompiler) createDir(dir string) {
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	c := &ModelCompiler{metaData: &MetaData{}}
+
+	// The embedded template is used by default
+	content, err := c.loadTemplate(dockerfileTemplate, dir)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(content, "FROM "))
+
+	// A template in the model templates directory replaces the embedded one
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, templatesDir), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, templatesDir, dockerfileTemplate), []byte("overlay"), 0640))
+	content, err = c.loadTemplate(dockerfileTemplate, dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "overlay", content)
+
+	// A template listed in the meta-data replaces both
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "custom.tpl"), []byte("override"), 0640))
+	c.metaData.TemplateOverrides = map[string]string{"dockerfile.tpl": "custom.tpl"}
+	content, err = c.loadTemplate(dockerfileTemplate, dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "override", content)
+
+	c.metaData.TemplateOverrides = map[string]string{"Dockerfile.tpl": "missing.tpl"}
+	_, err = c.loadTemplate(dockerfileTemplate, dir)
+	assert.Error(t, err)
+}
+
+func TestGoModuleTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "template-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The models of this repository are built against it
+	c := &ModelCompiler{metaData: &MetaData{}}
+	c.dictionary = Dictionary{
+		GoPackage:       "github.com/onosproject/config-models/models/testdevice-2.0.x",
+		ConfigModelsDir: configModelsDir("github.com/onosproject/config-models/models/testdevice-2.0.x"),
+	}
+	assert.NoError(t, c.generateGoModule(dir))
+	content, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(string(content), ")\n\nreplace github.com/onosproject/config-models => ../..\n"))
+
+	// The other models are built against its release
+	c.dictionary = Dictionary{
+		GoPackage:       "github.com/example/models/device",
+		ConfigModelsDir: configModelsDir("github.com/example/models/device"),
+	}
+	assert.NoError(t, c.generateGoModule(dir))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+	assert.NoError(t, err)
+	assert.False(t, strings.Contains(string(content), "replace"))
+	assert.Equal(t, "../../..", configModelsDir("github.com/onosproject/config-models/models/vendor/device"))
+}
@@ -0,0 +1,428 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io"
+	"sort"
+	"strings"
+)
+
+// statements which define nodes in the schema tree
+var dataKeywords = map[string]bool{
+	"container": true, "leaf": true, "leaf-list": true, "list": true, "choice": true,
+	"case": true, "anydata": true, "anyxml": true,
+}
+
+// treeMode is the part of the tree being rendered, which determines the node flags
+type treeMode int
+
+const (
+	treeModeData treeMode = iota
+	treeModeRPC
+	treeModeInput
+	treeModeOutput
+	treeModeNotification
+)
+
+// treeWriter renders YANG modules in the same tree format as `pyang -f tree`
+type treeWriter struct {
+	w   io.Writer
+	err error
+}
+
+// WriteTree writes the tree of the named modules, in the given order, to w. Augments
+// are shown in place when they target one of the named modules, otherwise in a
+// separate section of the augmenting module.
+func WriteTree(w io.Writer, ms *yang.Modules, modules []string) error {
+	tw := &treeWriter{w: w}
+	targets := make(map[string]bool)
+	roots := make([]*yang.Module, 0, len(modules))
+	for _, name := range modules {
+		m, err := findYangModule(ms, name)
+		if err != nil {
+			return err
+		}
+		roots = append(roots, m)
+		targets[m.Name] = true
+		if m.BelongsTo != nil {
+			targets[m.BelongsTo.Name] = true
+		}
+	}
+
+	printed := false
+	for _, m := range roots {
+		if printed {
+			tw.printf("\n")
+		}
+		printed = tw.writeModule(m, targets)
+	}
+	return tw.err
+}
+
+// writeModule writes the tree of a single module, returning false if there was nothing to write
+func (tw *treeWriter) writeModule(m *yang.Module, targets map[string]bool) bool {
+	e := yang.ToEntry(m)
+	printed := false
+	header := func() {
+		if !printed {
+			if m.BelongsTo != nil {
+				tw.printf("%s: %s (belongs-to %s)\n", m.Kind(), m.Name, m.BelongsTo.Name)
+			} else {
+				tw.printf("%s: %s\n", m.Kind(), m.Name)
+			}
+			printed = true
+		}
+	}
+
+	data := make([]*yang.Entry, 0)
+	rpcs := make([]*yang.Entry, 0)
+	notifications := make([]*yang.Entry, 0)
+	for _, child := range orderedChildren(e, true) {
+		switch {
+		case child.RPC != nil:
+			rpcs = append(rpcs, child)
+		case child.Kind == yang.NotificationEntry:
+			notifications = append(notifications, child)
+		default:
+			data = append(data, child)
+		}
+	}
+	if len(data) > 0 {
+		header()
+		tw.writeChildren(data, e, "", 0, treeModeData)
+	}
+
+	delimited := false
+	for _, augment := range m.Augment {
+		target := augmentTargetModule(m, augment.Name)
+		if target == "" || targets[target] {
+			continue
+		}
+		augmentEntry := findAugmentEntry(e, augment)
+		if augmentEntry == nil {
+			continue
+		}
+		if !delimited {
+			tw.printf("\n")
+			delimited = true
+		}
+		header()
+		tw.printf("  augment %s:\n", augment.Name)
+		tw.writeChildren(orderedChildren(augmentEntry, false), e, "  ", 0, treeModeData)
+	}
+
+	if len(rpcs) > 0 {
+		header()
+		tw.printf("\n  rpcs:\n")
+		tw.writeChildren(rpcs, e, "  ", 0, treeModeRPC)
+	}
+	if len(notifications) > 0 {
+		header()
+		tw.printf("\n  notifications:\n")
+		tw.writeChildren(notifications, e, "  ", 0, treeModeNotification)
+	}
+	return printed
+}
+
+func (tw *treeWriter) writeChildren(children []*yang.Entry, module *yang.Entry, prefix string, width int, mode treeMode) {
+	if width == 0 {
+		width = nameWidth(children, module)
+	}
+	for i, child := range children {
+		childPrefix := prefix + "  |"
+		if i == len(children)-1 {
+			childPrefix = prefix + "   "
+		}
+		tw.writeNode(child, module, childPrefix, width, mode)
+	}
+}
+
+func (tw *treeWriter) writeNode(e *yang.Entry, module *yang.Entry, prefix string, width int, mode treeMode) {
+	line := fmt.Sprintf("%s%s--", prefix[:len(prefix)-1], statusOf(e))
+	name := displayName(e, module)
+	flags := flagsOf(e, mode)
+
+	switch {
+	case e.RPC != nil || e.Kind == yang.NotificationEntry:
+		line += flags + " " + name
+	case e.IsList():
+		line += fmt.Sprintf("%s %s* [%s]", flags, name, strings.Join(strings.Fields(e.Key), " "))
+	case e.IsChoice():
+		if choice, ok := e.Node.(*yang.Choice); ok && choice.Mandatory != nil && choice.Mandatory.Name == "true" {
+			line += fmt.Sprintf("%s (%s)", flags, name)
+		} else {
+			line += fmt.Sprintf("%s (%s)?", flags, name)
+		}
+	case e.IsCase():
+		line += fmt.Sprintf(":(%s)", name)
+	case e.IsDir():
+		if container, ok := e.Node.(*yang.Container); ok && container.Presence != nil {
+			name += "!"
+		}
+		line += flags + " " + name
+	default:
+		if e.IsLeafList() {
+			name += "*"
+		} else if !isKey(e) && e.Mandatory != yang.TSTrue {
+			name += "?"
+		}
+		if typeName := typeNameOf(e); typeName != "" {
+			line += fmt.Sprintf("%s %-*s   %s", flags, width+1, name, typeName)
+		} else {
+			line += flags + " " + name
+		}
+	}
+	if features := ifFeaturesOf(e); len(features) > 0 {
+		line += fmt.Sprintf(" {%s}?", strings.Join(features, ","))
+	}
+	tw.printf("%s\n", line)
+
+	switch {
+	case e.RPC != nil:
+		io := make([]*yang.Entry, 0, 2)
+		if e.RPC.Input != nil && len(e.RPC.Input.Dir) > 0 {
+			io = append(io, e.RPC.Input)
+		}
+		if e.RPC.Output != nil && len(e.RPC.Output.Dir) > 0 {
+			io = append(io, e.RPC.Output)
+		}
+		for i, child := range io {
+			childPrefix := prefix + "  |"
+			if i == len(io)-1 {
+				childPrefix = prefix + "   "
+			}
+			childMode := treeModeInput
+			if child.Kind == yang.OutputEntry {
+				childMode = treeModeOutput
+			}
+			tw.writeNode(child, module, childPrefix, 0, childMode)
+		}
+	case e.IsChoice() || e.IsCase():
+		tw.writeChildren(orderedChildren(e, false), module, prefix, width-3, mode)
+	case e.IsDir():
+		tw.writeChildren(orderedChildren(e, false), module, prefix, 0, mode)
+	}
+}
+
+func (tw *treeWriter) printf(format string, args ...interface{}) {
+	if tw.err == nil {
+		_, tw.err = fmt.Fprintf(tw.w, format, args...)
+	}
+}
+
+// nameWidth is the width of the widest name amongst siblings, used to align the types
+func nameWidth(children []*yang.Entry, module *yang.Entry) int {
+	width := 0
+	for _, child := range children {
+		var w int
+		if child.IsChoice() || child.IsCase() {
+			w = 3 + nameWidth(orderedChildren(child, false), module)
+		} else {
+			w = len(displayName(child, module))
+		}
+		if w > width {
+			width = w
+		}
+	}
+	return width
+}
+
+// displayName is the name of the entry, prefixed when it comes from another module
+func displayName(e *yang.Entry, module *yang.Entry) string {
+	if e.Prefix != nil && e.Namespace().Name != module.Namespace().Name {
+		return e.Prefix.Name + ":" + e.Name
+	}
+	return e.Name
+}
+
+func flagsOf(e *yang.Entry, mode treeMode) string {
+	switch {
+	case e.RPC != nil:
+		return "-x"
+	case e.Kind == yang.NotificationEntry:
+		return "-n"
+	case mode == treeModeInput || e.Kind == yang.InputEntry:
+		return "-w"
+	case mode == treeModeOutput || mode == treeModeNotification || e.Kind == yang.OutputEntry:
+		return "ro"
+	case e.ReadOnly():
+		return "ro"
+	default:
+		return "rw"
+	}
+}
+
+func statusOf(e *yang.Entry) string {
+	if e.Node != nil {
+		for _, s := range e.Node.Statement().SubStatements() {
+			if s.Keyword == "status" {
+				switch s.Argument {
+				case "deprecated":
+					return "x"
+				case "obsolete":
+					return "o"
+				}
+			}
+		}
+	}
+	return "+"
+}
+
+func ifFeaturesOf(e *yang.Entry) []string {
+	features := make([]string, 0)
+	if e.Node != nil {
+		for _, s := range e.Node.Statement().SubStatements() {
+			if s.Keyword == "if-feature" {
+				features = append(features, s.Argument)
+			}
+		}
+	}
+	return features
+}
+
+func isKey(e *yang.Entry) bool {
+	if e.Parent == nil || !e.Parent.IsList() {
+		return false
+	}
+	for _, k := range strings.Fields(e.Parent.Key) {
+		if k == e.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeNameOf returns the type as written in the YANG file, with leafref paths made compact
+// by only keeping the prefixes where the path changes module
+func typeNameOf(e *yang.Entry) string {
+	var t *yang.Type
+	switch n := e.Node.(type) {
+	case *yang.Leaf:
+		t = n.Type
+	case *yang.LeafList:
+		t = n.Type
+	case *yang.AnyData:
+		return "<anydata>"
+	case *yang.AnyXML:
+		return "<anyxml>"
+	}
+	if t == nil {
+		return ""
+	}
+	if t.Name != "leafref" || t.Path == nil {
+		return t.Name
+	}
+	current := ""
+	if e.Prefix != nil {
+		current = e.Prefix.Name
+	}
+	parts := strings.Split(t.Path.Name, "/")
+	for i, part := range parts {
+		idx := strings.Index(part, ":")
+		if idx < 0 {
+			continue
+		}
+		if part[:idx] == current {
+			parts[i] = part[idx+1:]
+		} else {
+			current = part[:idx]
+		}
+	}
+	return "-> " + strings.Join(parts, "/")
+}
+
+// orderedChildren returns the children of e in the order they are defined in YANG, with
+// groupings expanded in place of their uses. Children augmented from other places follow,
+// in the order of their definition, unless moduleLevel is set, in which case only the
+// children defined in the module itself are returned.
+func orderedChildren(e *yang.Entry, moduleLevel bool) []*yang.Entry {
+	children := make([]*yang.Entry, 0, len(e.Dir))
+	added := make(map[string]bool)
+	if e.Node != nil {
+		for _, name := range definitionOrder(e.Node) {
+			if child, ok := e.Dir[name]; ok && !added[name] {
+				children = append(children, child)
+				added[name] = true
+			}
+		}
+	}
+	if moduleLevel {
+		return children
+	}
+
+	others := make([]*yang.Entry, 0)
+	for name, child := range e.Dir {
+		if !added[name] {
+			others = append(others, child)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		fi, li, ci := entryLocation(others[i])
+		fj, lj, cj := entryLocation(others[j])
+		if fi != fj {
+			return fi < fj
+		}
+		if li != lj {
+			return li < lj
+		}
+		if ci != cj {
+			return ci < cj
+		}
+		return others[i].Name < others[j].Name
+	})
+	return append(children, others...)
+}
+
+// definitionOrder returns the names of the schema nodes defined in n, in order
+func definitionOrder(n yang.Node) []string {
+	names := make([]string, 0)
+	for _, s := range n.Statement().SubStatements() {
+		switch {
+		case dataKeywords[s.Keyword] || s.Keyword == "rpc" || s.Keyword == "notification":
+			names = append(names, s.Argument)
+		case s.Keyword == "uses":
+			if g := yang.FindGrouping(n, s.Argument, map[string]bool{}); g != nil {
+				names = append(names, definitionOrder(g)...)
+			}
+		}
+	}
+	return names
+}
+
+func entryLocation(e *yang.Entry) (string, int, int) {
+	if e.Node == nil {
+		return "", 0, 0
+	}
+	return statementLocation(e.Node.Statement())
+}
+
+// augmentTargetModule returns the name of the module targeted by an augment path
+func augmentTargetModule(m *yang.Module, path string) string {
+	first := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+	idx := strings.Index(first, ":")
+	if idx < 0 {
+		return ""
+	}
+	if target := yang.FindModuleByPrefix(m, first[:idx]); target != nil {
+		if target.BelongsTo != nil {
+			return target.BelongsTo.Name
+		}
+		return target.Name
+	}
+	return ""
+}
+
+// findAugmentEntry returns the entry built from an augment statement of the module
+func findAugmentEntry(e *yang.Entry, augment *yang.Augment) *yang.Entry {
+	for _, a := range e.Augments {
+		if a.Node == augment {
+			return a
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+func TestWriteTree(t *testing.T) {
+	tests := []struct {
+		model   string
+		modules []string
+		files   []string
+	}{
+		{
+			model:   "testdevice-1.0.x",
+			modules: []string{"onf-test1", "onf-test1-extra"},
+			files:   []string{"onf-test1@2018-02-20.yang", "onf-test1-extra@2021-04-01.yang"},
+		},
+		{
+			model:   "testdevice-2.0.x",
+			modules: []string{"onf-test1", "onf-test1-augmented"},
+			files:   []string{"onf-test1@2019-06-10.yang", "onf-test1-augmented@2020-02-29.yang"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			ms, diags := readYangModules("../../models/"+tt.model+"/yang", tt.files)
+			assert.False(t, hasErrors(diags), "%v", diags)
+
+			var tree bytes.Buffer
+			assert.NoError(t, WriteTree(&tree, ms, tt.modules))
+
+			expected, err := ioutil.ReadFile("../../models/" + tt.model + "/testdevice.tree")
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), tree.String())
+		})
+	}
+}
+
+func TestYangModuleSet(t *testing.T) {
+	yangDir := "../../models/testdevice-2.0.x/yang"
+	files := []string{"onf-test1@2019-06-10.yang", "onf-test1-augmented@2020-02-29.yang"}
+
+	// The modules linted are the ones the tree is written from
+	modules := newYangModuleSet()
+	assert.NotEmpty(t, lintYang(yangDir, files, modules))
+	ms, diags := modules.modules(yangDir, files)
+	assert.Empty(t, diags)
+	again, _ := modules.modules(yangDir, files)
+	assert.Same(t, ms, again)
+	other, _ := modules.modules(yangDir, files[:1])
+	assert.NotSame(t, ms, other)
+
+	var tree bytes.Buffer
+	assert.NoError(t, WriteTree(&tree, ms, []string{"onf-test1", "onf-test1-augmented"}))
+	expected, err := ioutil.ReadFile("../../models/testdevice-2.0.x/testdevice.tree")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), tree.String())
+
+	// Without a set the modules are read every time
+	var none *yangModuleSet
+	first, _ := none.modules(yangDir, files)
+	second, _ := none.modules(yangDir, files)
+	assert.NotSame(t, first, second)
+}
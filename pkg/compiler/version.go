@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/onosproject/config-models/pkg/compat"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bump is the part of the version of a model which must be increased for a change
+type Bump int
+
+// Bumps, from the least to the most significant
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+func (b Bump) String() string {
+	switch b {
+	case BumpNone:
+		return "none"
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	}
+	return fmt.Sprintf("Bump(%d)", int(b))
+}
+
+// SchemaSnapshot is the flattened schema of a model along with its plugin version, as saved
+// at a release to check the version of the following changes against
+type SchemaSnapshot struct {
+	Version   string                 `json:"version"`
+	ReadOnly  []*admin.ReadOnlyPath  `json:"readOnly"`
+	ReadWrite []*admin.ReadWritePath `json:"readWrite"`
+}
+
+// TakeSchemaSnapshot compiles the schema of the model at modelPath in memory and returns it
+// along with the version of the model plugin
+func TakeSchemaSnapshot(modelPath string) (*SchemaSnapshot, error) {
+	schemaTree, err := ModelSchemaTree(modelPath)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := flattenSchema(schemaTree)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := ioutil.ReadFile(filepath.Join(modelPath, versionFile))
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Version = strings.TrimSpace(string(version))
+	return snapshot, nil
+}
+
+// ModelSchemaTree compiles the schema of the model at modelPath in memory, without writing
+// any file, and returns its schema tree rooted at the fake root of the Golang bindings
+func ModelSchemaTree(modelPath string) (map[string]*yang.Entry, error) {
+	metaData := &MetaData{}
+	if err := LoadMetaData(modelPath, "metadata", metaData); err != nil {
+		return nil, err
+	}
+	if err := ValidateMetaData(metaData); err != nil {
+		return nil, err
+	}
+	yangDir := filepath.Join(modelPath, "yang")
+	files, _, err := compiledYangFiles(yangDir, metaData)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := ioutil.TempDir("", "model-compiler-features-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	featureModule, err := writeFeatureModule(yangDir, metaData, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	if featureModule != "" {
+		files = append(files, featureModule)
+	}
+	return GenerateGoBindings(ioutil.Discard, yangDir, files, metaData.Ygot)
+}
+
+// flattenSchema extracts the sorted read-only and read-write paths of a schema tree
+func flattenSchema(schemaTree map[string]*yang.Entry) (snapshot *SchemaSnapshot, err error) {
+	defer func() {
+		// ExtractPaths panics on the types it does not support
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to extract the schema paths: %v", r)
+		}
+	}()
+	ro, rw := path.ExtractPaths(schemaTree)
+	sort.Slice(ro, func(i, j int) bool { return ro[i].Path < ro[j].Path })
+	for _, p := range ro {
+		sort.Slice(p.SubPath, func(i, j int) bool { return p.SubPath[i].SubPath < p.SubPath[j].SubPath })
+	}
+	sort.Slice(rw, func(i, j int) bool { return rw[i].Path < rw[j].Path })
+	return &SchemaSnapshot{ReadOnly: ro, ReadWrite: rw}, nil
+}
+
+// LoadSchemaSnapshot reads a schema snapshot saved with SaveSchemaSnapshot
+func LoadSchemaSnapshot(file string) (*SchemaSnapshot, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &SchemaSnapshot{}
+	if err := json.Unmarshal(content, snapshot); err != nil {
+		return nil, fmt.Errorf("unable to read schema snapshot %s: %w", file, err)
+	}
+	return snapshot, nil
+}
+
+// SaveSchemaSnapshot writes a schema snapshot to file
+func SaveSchemaSnapshot(snapshot *SchemaSnapshot, file string) error {
+	content, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(content, '\n'), 0640)
+}
+
+// GitSchemaSnapshot takes the schema snapshot of the model at modelPath as it is at the
+// given git ref
+func GitSchemaSnapshot(modelPath string, ref string) (*SchemaSnapshot, error) {
+	tmpDir, err := ioutil.TempDir("", "model-compiler-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cmd := exec.Command("git", "archive", "--format=tar", ref, ".")
+	cmd.Dir = modelPath
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	archive, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read '%s' at %s: %s", modelPath, ref, strings.TrimSpace(stderr.String()))
+	}
+	if err := extractTar(bytes.NewReader(archive), tmpDir); err != nil {
+		return nil, err
+	}
+	return TakeSchemaSnapshot(tmpDir)
+}
+
+// extractTar extracts the regular files of a tar archive in to dir
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		file := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !isInDir(file, dir) {
+			return fmt.Errorf("invalid path %s in archive", header.Name)
+		}
+		if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+			return err
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, content, 0640); err != nil {
+			return err
+		}
+	}
+}
+
+// ClassifySchemaChange returns the version bump required by the changes from the old to the
+// new schema, as reported by compat.ComparePaths, along with the reasons for it: the changes
+// which break the clients, e.g. removing or retyping a path, or adding a mandatory leaf, are
+// major; adding optional paths is minor; and any other change, e.g. of a description, is a
+// patch
+func ClassifySchemaChange(old *SchemaSnapshot, new *SchemaSnapshot) (Bump, []string) {
+	bump := BumpNone
+	reasons := make([]string, 0)
+	change := func(b Bump, reason string) {
+		if b > bump {
+			bump = b
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", b, reason))
+	}
+
+	report := compat.ComparePaths(old.ReadOnly, old.ReadWrite, new.ReadOnly, new.ReadWrite)
+	for _, c := range report.Changes {
+		switch {
+		case !c.Compatible:
+			change(BumpMajor, c.String())
+		case c.Kind == compat.Added:
+			change(BumpMinor, c.String())
+		default:
+			change(BumpPatch, c.String())
+		}
+	}
+
+	if bump == BumpNone {
+		oldJSON, _ := json.Marshal(&SchemaSnapshot{ReadOnly: old.ReadOnly, ReadWrite: old.ReadWrite})
+		newJSON, _ := json.Marshal(&SchemaSnapshot{ReadOnly: new.ReadOnly, ReadWrite: new.ReadWrite})
+		if !bytes.Equal(oldJSON, newJSON) {
+			change(BumpPatch, "descriptions, units or constraints changed")
+		}
+	}
+	return bump, reasons
+}
+
+// CheckVersionBump returns an error unless newVersion is greater than oldVersion by at least
+// the given bump; pre-release suffixes such as -dev are ignored. As usual for semantic
+// versions, before 1.0.0 a minor bump is enough for breaking changes.
+func CheckVersionBump(oldVersion string, newVersion string, bump Bump) error {
+	if bump == BumpNone {
+		return nil
+	}
+	o, err := parseVersion(oldVersion)
+	if err != nil {
+		return err
+	}
+	n, err := parseVersion(newVersion)
+	if err != nil {
+		return err
+	}
+	if bump == BumpMajor && o[0] == 0 && n[0] == 0 {
+		bump = BumpMinor
+	}
+
+	var actual Bump
+	switch {
+	case n[0] > o[0]:
+		actual = BumpMajor
+	case n[0] == o[0] && n[1] > o[1]:
+		actual = BumpMinor
+	case n[0] == o[0] && n[1] == o[1] && n[2] > o[2]:
+		actual = BumpPatch
+	default:
+		actual = BumpNone
+	}
+	if actual < bump {
+		return fmt.Errorf("version %s requires at least a %s bump from %s", newVersion, bump, oldVersion)
+	}
+	return nil
+}
+
+func parseVersion(version string) ([3]int, error) {
+	var parsed [3]int
+	if !semverRegex.MatchString(version) {
+		return parsed, fmt.Errorf("%s is not a semantic version", version)
+	}
+	core := strings.TrimPrefix(version, "v")
+	core = strings.SplitN(strings.SplitN(core, "+", 2)[0], "-", 2)[0]
+	for i, part := range strings.Split(core, ".") {
+		parsed[i], _ = strconv.Atoi(part)
+	}
+	return parsed, nil
+}
+
+// CheckModelVersion classifies the changes of the schema of the model at modelPath since
+// the previous snapshot, writing the reasons to out, and returns an error if the VERSION of
+// the model does not reflect them
+func CheckModelVersion(modelPath string, previous *SchemaSnapshot, out io.Writer) error {
+	current, err := TakeSchemaSnapshot(modelPath)
+	if err != nil {
+		return err
+	}
+	bump, reasons := ClassifySchemaChange(previous, current)
+	summary := fmt.Sprintf("%s schema change", bump)
+	if bump == BumpNone {
+		summary = "no schema change"
+	}
+	if _, err := fmt.Fprintf(out, "%s: %s since %s\n", modelPath, summary, previous.Version); err != nil {
+		return err
+	}
+	for _, reason := range reasons {
+		if _, err := fmt.Fprintf(out, "  %s\n", reason); err != nil {
+			return err
+		}
+	}
+	return CheckVersionBump(previous.Version, current.Version, bump)
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"github.com/onosproject/config-models/pkg/compat"
+	"github.com/onosproject/config-models/pkg/path"
+	"github.com/onosproject/onos-api/go/onos/config/admin"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClassifySchemaChange(t *testing.T) {
+	old := &SchemaSnapshot{
+		ReadWrite: []*admin.ReadWritePath{
+			{Path: "/a/name", ValueType: configapi.ValueType_STRING, Description: "name"},
+			{Path: "/a/size", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}},
+		},
+		ReadOnly: []*admin.ReadOnlyPath{
+			{Path: "/a/state", SubPath: []*admin.ReadOnlySubPath{{SubPath: "/counter", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{64}}}},
+		},
+	}
+
+	bump, reasons := ClassifySchemaChange(old, old)
+	assert.Equal(t, BumpNone, bump)
+	assert.Empty(t, reasons)
+
+	patched := &SchemaSnapshot{
+		ReadWrite: []*admin.ReadWritePath{
+			{Path: "/a/name", ValueType: configapi.ValueType_STRING, Description: "name of a"},
+			old.ReadWrite[1],
+		},
+		ReadOnly: old.ReadOnly,
+	}
+	bump, _ = ClassifySchemaChange(old, patched)
+	assert.Equal(t, BumpPatch, bump)
+
+	extended := &SchemaSnapshot{
+		ReadWrite: append([]*admin.ReadWritePath{{Path: "/a/color", ValueType: configapi.ValueType_STRING}}, old.ReadWrite...),
+		ReadOnly:  old.ReadOnly,
+	}
+	bump, reasons = ClassifySchemaChange(old, extended)
+	assert.Equal(t, BumpMinor, bump)
+	assert.Equal(t, []string{"minor: /a/color added: leaf"}, reasons)
+
+	broken := &SchemaSnapshot{
+		ReadWrite: []*admin.ReadWritePath{
+			{Path: "/a/owner", ValueType: configapi.ValueType_STRING, Mandatory: true},
+			{Path: "/a/size", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{32}},
+		},
+		ReadOnly: []*admin.ReadOnlyPath{
+			{Path: "/a", SubPath: []*admin.ReadOnlySubPath{{SubPath: "/name", ValueType: configapi.ValueType_STRING}}},
+		},
+	}
+	bump, reasons = ClassifySchemaChange(old, broken)
+	assert.Equal(t, BumpMajor, bump)
+	assert.Equal(t, []string{
+		"major: /a/name config-to-state",
+		"major: /a/owner added: leaf",
+		"major: /a/size type-changed: UINT[16] -> UINT[32]",
+		"major: /a/state/counter removed: leaf",
+	}, reasons)
+
+	// Narrowing a range breaks the clients, widening it does not
+	ranged := func(r string) *SchemaSnapshot {
+		return &SchemaSnapshot{
+			ReadWrite: []*admin.ReadWritePath{{Path: "/a/size", ValueType: configapi.ValueType_UINT, TypeOpts: []uint64{16}, Range: []string{r}}},
+		}
+	}
+	bump, reasons = ClassifySchemaChange(ranged("1..100"), ranged("1..10"))
+	assert.Equal(t, BumpMajor, bump)
+	assert.Equal(t, []string{"major: /a/size range-narrowed: 1..100 -> 1..10"}, reasons)
+	bump, _ = ClassifySchemaChange(ranged("1..10"), ranged("1..100"))
+	assert.Equal(t, BumpPatch, bump)
+}
+
+// TestCompareTestDevices compares the sample models sharing the same YANG module at two
+// revisions, which require a major bump
+func TestCompareTestDevices(t *testing.T) {
+	old, err := ModelSchemaTree("../../models/testdevice-1.0.x")
+	assert.NoError(t, err)
+	new, err := ModelSchemaTree("../../models/testdevice-2.0.x")
+	assert.NoError(t, err)
+
+	r := compat.Compare(old, new)
+	assert.NotEmpty(t, r.Changes)
+	assert.Empty(t, compat.Compare(new, new).Changes)
+	for _, c := range r.Changes {
+		assert.NotEmpty(t, c.Path)
+		assert.NotEmpty(t, c.Kind)
+	}
+
+	oldRO, oldRW := path.ExtractPaths(old)
+	newRO, newRW := path.ExtractPaths(new)
+	assert.Empty(t, compat.ComparePaths(oldRO, oldRW, oldRO, oldRW).Changes)
+	assert.NotEmpty(t, compat.ComparePaths(oldRO, oldRW, newRO, newRW).Changes)
+
+	bump, _ := ClassifySchemaChange(&SchemaSnapshot{ReadOnly: oldRO, ReadWrite: oldRW}, &SchemaSnapshot{ReadOnly: newRO, ReadWrite: newRW})
+	assert.Equal(t, BumpMajor, bump)
+}
+
+func TestCheckVersionBump(t *testing.T) {
+	tests := []struct {
+		old, new string
+		bump     Bump
+		ok       bool
+	}{
+		{"1.2.3", "1.2.3", BumpNone, true},
+		{"1.2.3", "1.2.3", BumpPatch, false},
+		{"1.2.3", "1.2.4-dev", BumpPatch, true},
+		{"1.2.3", "1.2.4", BumpMinor, false},
+		{"1.2.3", "1.3.0", BumpMinor, true},
+		{"1.2.3", "1.3.0", BumpMajor, false},
+		{"1.2.3", "2.0.0-dev", BumpMajor, true},
+		{"0.5.17-dev", "0.6.0-dev", BumpMajor, true},
+		{"0.5.17-dev", "0.5.18-dev", BumpMajor, false},
+	}
+	for _, tt := range tests {
+		err := CheckVersionBump(tt.old, tt.new, tt.bump)
+		if tt.ok {
+			assert.NoError(t, err, "%s to %s for %s", tt.old, tt.new, tt.bump)
+		} else {
+			assert.Error(t, err, "%s to %s for %s", tt.old, tt.new, tt.bump)
+		}
+	}
+	assert.EqualError(t, CheckVersionBump("1.0", "1.1.0", BumpMinor), "1.0 is not a semantic version")
+}
+
+func TestCheckModelVersion(t *testing.T) {
+	model := "../../models/testdevice-2.0.x"
+	snapshot, err := TakeSchemaSnapshot(model)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, snapshot.ReadWrite)
+	assert.NotEmpty(t, snapshot.ReadOnly)
+
+	dir, err := ioutil.TempDir("", "version-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "snapshot.json")
+	assert.NoError(t, SaveSchemaSnapshot(snapshot, file))
+	loaded, err := LoadSchemaSnapshot(file)
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+	assert.NoError(t, CheckModelVersion(model, loaded, &out))
+	assert.Equal(t, model+": no schema change since "+snapshot.Version+"\n", out.String())
+
+	// A path removed since the snapshot requires a major bump
+	loaded.ReadWrite = append(loaded.ReadWrite, &admin.ReadWritePath{Path: "/removed"})
+	out.Reset()
+	assert.Error(t, CheckModelVersion(model, loaded, &out))
+	assert.Contains(t, out.String(), "major: /removed removed")
+
+	previous, err := GitSchemaSnapshot(model, "HEAD")
+	assert.NoError(t, err)
+	bump, _ := ClassifySchemaChange(previous, snapshot)
+	assert.Equal(t, BumpNone, bump)
+}
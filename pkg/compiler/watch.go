@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"github.com/fsnotify/fsnotify"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// defaultWatchDelay is how long Watch waits for the edited files to settle by default
+const defaultWatchDelay = 200 * time.Millisecond
+
+// WatchOptions are the options of Watch
+type WatchOptions struct {
+	// SkipTests disables running the tests of the model after each compilation
+	SkipTests bool
+	// Delay is how long to wait after a change for the files being edited to settle before
+	// compiling the model, by default 200ms
+	Delay time.Duration
+}
+
+// watchChange tells what is to be done after a change of the files of a model; the
+// greater changes imply the smaller ones
+type watchChange int
+
+const (
+	watchNone watchChange = iota
+	// watchTest means the test data changed, so the tests are to be run again
+	watchTest
+	// watchCompile means the model inputs changed, so it is to be compiled and tested
+	watchCompile
+)
+
+// Watch compiles the model at path and runs its tests, then does it again each time its
+// meta-data, VERSION, YANG files, template overrides, test cases or test data change, until ctx is done.
+// As stages whose inputs did not change are skipped, only the artifacts depending on the
+// edited files are generated again; the test data changes just run the tests again. The
+// diagnostics and test results are written to out.
+func (c *ModelCompiler) Watch(ctx context.Context, path string, opts WatchOptions, out io.Writer) error {
+	if opts.Delay <= 0 {
+		opts.Delay = defaultWatchDelay
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	c.rebuild(path, watchCompile, opts, out)
+	if err := c.watchDirs(watcher, path); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(out, "Watching %s for changes\n", path)
+
+	timer := time.NewTimer(opts.Delay)
+	timer.Stop()
+	pending := watchNone
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			change := c.watchedChange(path, event.Name)
+			if change == watchNone {
+				continue
+			}
+			if change > pending {
+				pending = change
+			}
+			// Wait for the editor to be done writing the files
+			timer.Reset(opts.Delay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			_, _ = fmt.Fprintf(out, "Unable to watch %s: %v\n", path, err)
+		case <-timer.C:
+			c.rebuild(path, pending, opts, out)
+			pending = watchNone
+			// The directories may have been created, and the template overrides changed
+			if err := c.watchDirs(watcher, path); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchDirs watches the directories holding the inputs and the test data of the model
+func (c *ModelCompiler) watchDirs(watcher *fsnotify.Watcher, path string) error {
+	dirs := []string{path, filepath.Join(path, "yang"), filepath.Join(path, testdataDir)}
+	if c.metaData != nil {
+		for _, file := range c.metaData.TemplateOverrides {
+			dirs = append(dirs, filepath.Dir(filepath.Join(path, file)))
+		}
+	}
+	for _, dir := range dirs {
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			return fmt.Errorf("unable to watch %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// watchedChange returns what the change of the given file implies for the model at path;
+// the files written by the compiler, and the temporary files of the editors, imply nothing
+func (c *ModelCompiler) watchedChange(path string, file string) watchChange {
+	rel, err := filepath.Rel(path, file)
+	if err != nil {
+		return watchNone
+	}
+	dir, name := filepath.Split(filepath.ToSlash(rel))
+	switch {
+	case dir == "" && (name == versionFile || strings.HasPrefix(name, "metadata.")):
+		return watchCompile
+	case dir == "yang/" && filepath.Ext(name) == ".yang":
+		return watchCompile
+	case dir == testdataDir+"/" && name == testCasesFile:
+		return watchCompile
+	case dir == testdataDir+"/" && filepath.Ext(name) == ".json":
+		return watchTest
+	}
+	if c.metaData != nil {
+		for _, template := range c.metaData.TemplateOverrides {
+			if filepath.Clean(filepath.Join(path, template)) == filepath.Clean(file) {
+				return watchCompile
+			}
+		}
+	}
+	return watchNone
+}
+
+// rebuild compiles the model at path when its inputs changed, then runs its tests unless
+// they are skipped or the compilation failed, writing the outcome to out
+func (c *ModelCompiler) rebuild(path string, change watchChange, opts WatchOptions, out io.Writer) {
+	if change == watchCompile {
+		start := time.Now()
+		_, _ = fmt.Fprintf(out, "Compiling %s\n", path)
+		err := c.Compile(path)
+		for _, d := range c.Diagnostics() {
+			_, _ = fmt.Fprintln(out, d)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(out, "Compilation FAILED: %s\n", firstLine(err.Error()))
+			return
+		}
+		generated := make([]string, 0)
+		for _, r := range c.results {
+			if !r.Skipped && !r.Cached && !r.Failed {
+				generated = append(generated, r.Stage)
+			}
+		}
+		_, _ = fmt.Fprintf(out, "Compiled in %s, stages run: %s\n", time.Since(start).Round(time.Millisecond), stageList(generated))
+	}
+	if opts.SkipTests {
+		return
+	}
+	start := time.Now()
+	_, _ = fmt.Fprintf(out, "Testing %s\n", path)
+	if err := runModelTests(path, out); err != nil {
+		_, _ = fmt.Fprintf(out, "Tests FAILED: %v\n", err)
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Tests passed in %s\n", time.Since(start).Round(time.Millisecond))
+}
+
+// runModelTests runs the tests of the model at path, e.g. the must and unmarshal tests of
+// its api package against the test data, writing their output to out
+func runModelTests(path string, out io.Writer) error {
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = path
+	cmd.Stdout = out
+	cmd.Stderr = out
+	return cmd.Run()
+}
+
+// stageList returns the names of the stages separated by commas, or none
+func stageList(stages []string) string {
+	if len(stages) == 0 {
+		return "none"
+	}
+	return strings.Join(stages, ", ")
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchedChange(t *testing.T) {
+	c := NewCompiler()
+	c.metaData = &MetaData{TemplateOverrides: map[string]string{"main.go.tpl": "templates/main.go.tpl"}}
+	for file, expected := range map[string]watchChange{
+		"/model/metadata.yaml":                  watchCompile,
+		"/model/VERSION":                        watchCompile,
+		"/model/yang/test@2022-01-01.yang":      watchCompile,
+		"/model/yang/.test@2022-01-01.yang.swp": watchNone,
+		"/model/yang/test@2022-01-01.yang~":     watchNone,
+		"/model/templates/main.go.tpl":          watchCompile,
+		"/model/templates/model.go.tpl":         watchNone,
+		"/model/testdata/sample-config.json":    watchTest,
+		"/model/testdata/cases.yaml":            watchCompile,
+		"/model/openapi.yaml":                   watchNone,
+		"/model/api/generated.go":               watchNone,
+		"/other/metadata.yaml":                  watchNone,
+	} {
+		assert.Equal(t, expected, c.watchedChange("/model", file), file)
+	}
+}
+
+// syncBuffer is a bytes.Buffer which may be written and read concurrently
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, copyModelInputs("../../models/testdevice-1.0.x", dir))
+
+	c := NewCompiler()
+	c.SelectStages([]string{StageTree}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	var out syncBuffer
+	done := make(chan error)
+	go func() {
+		done <- c.Watch(ctx, dir, WatchOptions{SkipTests: true, Delay: 10 * time.Millisecond}, &out)
+	}()
+	assert.Eventually(t, func() bool { return strings.Contains(out.String(), "Watching") }, 10*time.Second, 10*time.Millisecond)
+	assert.Contains(t, out.String(), "stages run: tree")
+
+	// Editing a YANG file compiles the model again
+	yangFile := filepath.Join(dir, "yang", "onf-test1-extra@2021-04-01.yang")
+	yang, err := ioutil.ReadFile(yangFile)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(yangFile, bytes.Replace(yang, []byte("prefix t1e;"), []byte("prefix t1e;\n    leaf watched { type string; }"), 1), 0640))
+	assert.Eventually(t, func() bool { return strings.Count(out.String(), "Compiled in") == 2 }, 10*time.Second, 10*time.Millisecond)
+	tree, err := ioutil.ReadFile(filepath.Join(dir, "testdevice.tree"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(tree), "watched")
+
+	// The diagnostics of a broken model are reported, and it is compiled again once fixed
+	assert.NoError(t, ioutil.WriteFile(yangFile, bytes.Replace(yang, []byte("prefix t1e;"), []byte("prefix t1e;\n    leaf broken { type t1:missing; }"), 1), 0640))
+	assert.Eventually(t, func() bool { return strings.Contains(out.String(), "Compilation FAILED") }, 10*time.Second, 10*time.Millisecond)
+	assert.Contains(t, out.String(), yangFile+":4:")
+	assert.NoError(t, ioutil.WriteFile(yangFile, yang, 0640))
+	assert.Eventually(t, func() bool { return strings.Count(out.String(), "Compiled in") == 3 }, 10*time.Second, 10*time.Millisecond)
+
+	// The generated files do not trigger a compilation
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 3, strings.Count(out.String(), "Compiled in"))
+
+	cancel()
+	assert.NoError(t, <-done)
+}
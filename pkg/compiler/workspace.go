@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Workspace lists the directories of the models compiled together
+type Workspace struct {
+	// Models are model directories or glob patterns, relative to the workspace file
+	Models []string `yaml:"models"`
+}
+
+// LoadWorkspace reads a workspace file and returns the model directories it lists
+func LoadWorkspace(file string) ([]string, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	workspace := &Workspace{}
+	if err := yaml.Unmarshal(content, workspace); err != nil {
+		return nil, fmt.Errorf("unable to read workspace %s: %w", file, err)
+	}
+	patterns := make([]string, 0, len(workspace.Models))
+	for _, pattern := range workspace.Models {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(file), pattern)
+		}
+		patterns = append(patterns, pattern)
+	}
+	models, err := ExpandModelPaths(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("workspace %s: %w", file, err)
+	}
+	return models, nil
+}
+
+// ExpandModelPaths expands the glob patterns of model directories in to the sorted list of
+// the matching directories containing model meta-data; a pattern matching no model is an error
+func ExpandModelPaths(patterns []string) ([]string, error) {
+	seen := make(map[string]bool)
+	models := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, match := range matches {
+			metaDataFiles, err := filepath.Glob(filepath.Join(match, "metadata.*"))
+			if err != nil {
+				return nil, err
+			}
+			if len(metaDataFiles) == 0 {
+				continue
+			}
+			found = true
+			if !seen[match] {
+				seen[match] = true
+				models = append(models, match)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no model found in %s", pattern)
+		}
+	}
+	sort.Strings(models)
+	return models, nil
+}
+
+// ModelReport is the outcome of the compilation of one of the models of a workspace
+type ModelReport struct {
+	Path     string
+	Duration time.Duration
+	Err      error
+	// Build is the build report of the model, if it could be compiled at all
+	Build *BuildReport
+}
+
+// CompileModels compiles the given models with at most jobs compilations running at the
+// same time, each one with the stages and search paths of c; when check is set the models
+// are checked instead, writing the differences to out. A report is returned per model, in
+// the order of paths.
+func (c *ModelCompiler) CompileModels(paths []string, jobs int, check bool, out io.Writer) []ModelReport {
+	if jobs < 1 {
+		jobs = 1
+	}
+	reports := make([]ModelReport, len(paths))
+	var outLock sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, jobs)
+	for i, path := range paths {
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			mc := &ModelCompiler{
+				onlyStages:  c.onlyStages,
+				skipStages:  c.skipStages,
+				searchPaths: c.searchPaths,
+				force:       c.force,
+			}
+			start := time.Now()
+			var err error
+			if check {
+				// Keep the diff of each model in one piece
+				var diff bytes.Buffer
+				err = mc.Check(path, &diff)
+				outLock.Lock()
+				_, _ = out.Write(diff.Bytes())
+				outLock.Unlock()
+			} else {
+				err = mc.Compile(path)
+			}
+			reports[i] = ModelReport{Path: path, Duration: time.Since(start), Err: err, Build: mc.Report()}
+		}(i, path)
+	}
+	wg.Wait()
+	return reports
+}
+
+// WriteModelReports writes a table of the outcome of the compilation of each model to out
+// and returns an error if any of them failed
+func WriteModelReports(out io.Writer, reports []ModelReport) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "MODEL\tRESULT\tDURATION\tERROR")
+	failed := 0
+	for _, r := range reports {
+		result, msg := "ok", ""
+		if r.Err != nil {
+			failed++
+			result, msg = "FAILED", firstLine(r.Err.Error())
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Path, result, r.Duration.Round(time.Millisecond), msg)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d models failed", failed, len(reports))
+	}
+	return nil
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + " ..."
+	}
+	return s
+}
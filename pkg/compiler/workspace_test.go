@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadWorkspace(t *testing.T) {
+	models, err := LoadWorkspace("../../models/workspace.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"../../models/devicesim-1.0.x",
+		"../../models/e2node-1.x",
+		"../../models/ric-1.x",
+		"../../models/sdn-fabric-0.1.x",
+		"../../models/testdevice-1.0.x",
+		"../../models/testdevice-2.0.x",
+	}, models)
+
+	dir, err := ioutil.TempDir("", "workspace-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	modelsDir, err := filepath.Abs("../../models")
+	assert.NoError(t, err)
+	file := filepath.Join(dir, "workspace.yaml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("models:\n  - "+modelsDir+"/testdevice-*\n"), 0640))
+	models, err = LoadWorkspace(file)
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("models:\n  - missing\n"), 0640))
+	_, err = LoadWorkspace(file)
+	assert.EqualError(t, err, "workspace "+file+": no model found in "+filepath.Join(dir, "missing"))
+}
+
+func TestCompileModels(t *testing.T) {
+	c := NewCompiler()
+	c.SelectStages([]string{StageTree}, nil)
+	paths := []string{"../../models/testdevice-1.0.x", "../../models/testdevice-2.0.x", "../../test/metadata"}
+	reports := c.CompileModels(paths, 2, true, &bytes.Buffer{})
+	assert.Len(t, reports, 3)
+	for i, r := range reports {
+		assert.Equal(t, paths[i], r.Path)
+	}
+	assert.NoError(t, reports[0].Err)
+	assert.NoError(t, reports[1].Err)
+	assert.Error(t, reports[2].Err)
+}
+
+func TestWriteModelReports(t *testing.T) {
+	var out bytes.Buffer
+	err := WriteModelReports(&out, []ModelReport{
+		{Path: "models/a", Duration: 1500 * time.Millisecond},
+		{Path: "models/bb", Duration: time.Second, Err: errors.New("stage bindings failed:\ndetails")},
+	})
+	assert.EqualError(t, err, "1 of 2 models failed")
+	assert.Equal(t, "MODEL      RESULT  DURATION  ERROR\n"+
+		"models/a   ok      1.5s      \n"+
+		"models/bb  FAILED  1s        stage bindings failed: ...\n", out.String())
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package compiler
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// goyang reports errors prefixed with the location of the offending statement
+var locationRegex = regexp.MustCompile(`(?s)^(.+?):(\d+):(\d+): (.*)$`)
+
+// the module or submodule statement at the start of a YANG file
+var moduleHeaderRegex = regexp.MustCompile(`(?m)^\s*(?:sub)?module\s+"?([A-Za-z0-9_.-]+)"?`)
+
+// readYangModules parses the given root YANG files found in yangDir, resolving their
+// imports and includes from the same directory. Any problem found while reading or
+// processing the modules is returned as an error diagnostic; the modules are only
+// usable when no error diagnostic is returned.
+func readYangModules(yangDir string, files []string) (*yang.Modules, []Diagnostic) {
+	ms := yang.NewModules()
+	ms.AddPath(yangDir)
+
+	diags := make([]Diagnostic, 0)
+	for _, file := range files {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(yangDir, file)
+		}
+		if err := ms.Read(file); err != nil {
+			diags = append(diags, errorDiagnostic(err))
+		}
+	}
+	if len(diags) > 0 {
+		return ms, diags
+	}
+
+	// Resolve the imports and includes explicitly so that each unresolved one can be
+	// reported against the statement which references it; reading a module may pull
+	// in new ones, so repeat until no new module shows up
+	index := indexYangDir(yangDir)
+	resolve := func(n yang.Node) bool {
+		if ms.FindModule(n) != nil {
+			return true
+		}
+		// The file name does not follow the module[@revision].yang convention
+		if file, ok := index[n.NName()]; ok && ms.Read(file) == nil {
+			return ms.FindModule(n) != nil
+		}
+		return false
+	}
+	checked := make(map[*yang.Module]bool)
+	for {
+		pending := make([]*yang.Module, 0)
+		for _, m := range sortedModules(ms) {
+			if !checked[m] {
+				pending = append(pending, m)
+			}
+		}
+		if len(pending) == 0 {
+			break
+		}
+		for _, m := range pending {
+			checked[m] = true
+			for _, i := range m.Import {
+				if !resolve(i) {
+					diags = append(diags, newDiagnostic(i.Source, SeverityError, ruleUnresolvedImport,
+						"unable to resolve import of module %q", i.Name))
+				}
+			}
+			for _, i := range m.Include {
+				if !resolve(i) {
+					diags = append(diags, newDiagnostic(i.Source, SeverityError, ruleUnresolvedImport,
+						"unable to resolve include of submodule %q", i.Name))
+				}
+			}
+		}
+	}
+	if len(diags) > 0 {
+		return ms, diags
+	}
+
+	for _, err := range ms.Process() {
+		diags = append(diags, errorDiagnostic(err))
+	}
+	return ms, diags
+}
+
+// yangModuleSet holds the modules read by readYangModules during a compilation, keyed by
+// their directory and root files, so that the stages needing the same modules, e.g. lint,
+// features and tree, parse and process them once; it is dropped with the compilation, so
+// that the modules edited between two compilations are read again. A nil set reads the
+// modules every time. The modules it returns must not be modified.
+type yangModuleSet struct {
+	read map[string]*yangModuleSetEntry
+}
+
+type yangModuleSetEntry struct {
+	ms    *yang.Modules
+	diags []Diagnostic
+}
+
+func newYangModuleSet() *yangModuleSet {
+	return &yangModuleSet{read: make(map[string]*yangModuleSetEntry)}
+}
+
+// modules returns the modules read by readYangModules from the given root files of yangDir,
+// reading them on first use
+func (s *yangModuleSet) modules(yangDir string, files []string) (*yang.Modules, []Diagnostic) {
+	if s == nil {
+		return readYangModules(yangDir, files)
+	}
+	key := strings.Join(append([]string{yangDir}, files...), "\x00")
+	if entry, ok := s.read[key]; ok {
+		return entry.ms, append([]Diagnostic(nil), entry.diags...)
+	}
+	ms, diags := readYangModules(yangDir, files)
+	s.read[key] = &yangModuleSetEntry{ms: ms, diags: append([]Diagnostic(nil), diags...)}
+	return ms, diags
+}
+
+// indexYangDir maps the name of the module or submodule declared in each YANG file
+// of dir to the file
+func indexYangDir(dir string) map[string]string {
+	index := make(map[string]string)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return index
+	}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".yang" {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		if m := moduleHeaderRegex.FindSubmatch(content); m != nil {
+			if _, ok := index[string(m[1])]; !ok {
+				index[string(m[1])] = path
+			}
+		}
+	}
+	return index
+}
+
+// sortedModules returns every module and submodule known to ms exactly once, ordered by name
+func sortedModules(ms *yang.Modules) []*yang.Module {
+	seen := make(map[*yang.Module]bool)
+	modules := make([]*yang.Module, 0, len(ms.Modules)+len(ms.SubModules))
+	for _, mods := range []map[string]*yang.Module{ms.Modules, ms.SubModules} {
+		for _, m := range mods {
+			if !seen[m] {
+				seen[m] = true
+				modules = append(modules, m)
+			}
+		}
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].FullName() < modules[j].FullName()
+	})
+	return modules
+}
+
+// findYangModule returns the module or submodule with the given name
+func findYangModule(ms *yang.Modules, name string) (*yang.Module, error) {
+	if m, ok := ms.Modules[name]; ok {
+		return m, nil
+	}
+	if m, ok := ms.SubModules[name]; ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("module %s not found", name)
+}
+
+// errorDiagnostic converts an error returned by goyang in to a diagnostic, extracting
+// the location when the error carries one
+func errorDiagnostic(err error) Diagnostic {
+	d := Diagnostic{Severity: SeverityError, Rule: ruleSyntax, Message: err.Error()}
+	if m := locationRegex.FindStringSubmatch(err.Error()); m != nil {
+		d.File = m[1]
+		d.Line, _ = strconv.Atoi(m[2])
+		d.Column, _ = strconv.Atoi(m[3])
+		d.Message = m[4]
+	}
+	return d
+}
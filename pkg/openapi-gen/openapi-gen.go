@@ -80,12 +80,16 @@ func (settings *ApiGenSettings) ApplyDefaults() {
 }
 
 func BuildOpenapi(yangSchema *ytypes.Schema, settings *ApiGenSettings) (*openapi3.Swagger, error) {
+	return BuildOpenapiFromEntry(yangSchema.RootSchema(), settings)
+}
+
+// BuildOpenapiFromEntry builds the OpenAPI specs from the YANG entry at the root of the schema
+func BuildOpenapiFromEntry(topEntry *yang.Entry, settings *ApiGenSettings) (*openapi3.Swagger, error) {
 	settings.ApplyDefaults()
 
 	pathPrefix = fmt.Sprintf("/%s/v%s/{%s}", strings.ToLower(settings.ModelType), settings.ModelVersion, settings.TargetAlias)
 	targetParameter = targetParam(settings.TargetAlias)
 
-	topEntry := yangSchema.SchemaTree["Device"]
 	paths, components, err := buildSchema(topEntry, yang.TSFalse, "", settings.TargetAlias)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
 	"sort"
 	"strings"
 )
@@ -25,7 +26,7 @@ var rwPaths []*admin.ReadWritePath
 // ExtractPaths parse the schema entries out in to flat paths
 func ExtractPaths(entries map[string]*yang.Entry) ([]*admin.ReadOnlyPath, []*admin.ReadWritePath) {
 	var err error
-	roPaths, rwPaths, err = extractPaths(entries["Device"], yang.TSUnset, "", "")
+	roPaths, rwPaths, err = Extract(entries)
 	if err != nil {
 		log.Errorf(err.Error())
 		panic(err)
@@ -33,6 +34,34 @@ func ExtractPaths(entries map[string]*yang.Entry) ([]*admin.ReadOnlyPath, []*adm
 	return roPaths, rwPaths
 }
 
+// Extract parses the schema entries out in to flat paths like ExtractPaths, but returns an
+// error rather than panicking on the types it does not handle; the paths and sub-paths are
+// sorted so that the same schema always gives the same lists
+func Extract(entries map[string]*yang.Entry) ([]*admin.ReadOnlyPath, []*admin.ReadWritePath, error) {
+	ro, rw, err := extractPaths(rootEntry(entries), yang.TSUnset, "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(ro, func(i, j int) bool { return ro[i].Path < ro[j].Path })
+	for _, p := range ro {
+		subPaths := p.SubPath
+		sort.Slice(subPaths, func(i, j int) bool { return subPaths[i].SubPath < subPaths[j].SubPath })
+	}
+	sort.Slice(rw, func(i, j int) bool { return rw[i].Path < rw[j].Path })
+	return ro, rw, nil
+}
+
+// rootEntry returns the fake root of the schema, which is named after the fakeRootName
+// used when generating the bindings
+func rootEntry(entries map[string]*yang.Entry) *yang.Entry {
+	for _, entry := range entries {
+		if util.IsFakeRoot(entry) {
+			return entry
+		}
+	}
+	return entries["Device"]
+}
+
 // extractPaths - recursive function that walks the YGOT tree to extract paths
 func extractPaths(deviceEntry *yang.Entry, parentState yang.TriState, parentPath string,
 	subpathPrefix string) ([]*admin.ReadOnlyPath, []*admin.ReadWritePath, error) {
@@ -62,7 +91,7 @@ func extractPaths(deviceEntry *yang.Entry, parentState yang.TriState, parentPath
 			var enum map[int]string
 			if dirEntry.Type.Kind == yang.Yidentityref {
 				enum = handleIdentity(dirEntry.Type)
-				fmt.Println(enum)
+				log.Debugf("Identities of %s: %v", itemPath, enum)
 			}
 			// Check to see if this attribute is a key in a list
 			if dirEntry.Parent.IsList() {
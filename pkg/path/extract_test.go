@@ -577,3 +577,22 @@ var (
 		0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xf8, 0x5b, 0x38, 0xe1, 0xd3, 0x00, 0x00,
 	}
 )
+
+func Test_Extract(t *testing.T) {
+	schemaTree, err := ygot.GzipToSchema(testdevice10XSchema)
+	assert.NoError(t, err)
+	ro, rw, err := Extract(schemaTree)
+	assert.NoError(t, err)
+	assert.Equal(t, "/cont1a/cont2a/leaf2c", ro[0].Path)
+	assert.Equal(t, "/cont1b-state", ro[1].Path)
+	assert.Equal(t, "/list2b[index=*]/leaf3c", ro[1].SubPath[2].SubPath)
+	assert.Equal(t, "/cont1a/cont2a/leaf2a", rw[0].Path)
+	assert.Equal(t, "/leafAtTopLevel", rw[len(rw)-1].Path)
+
+	// Unsupported types are reported rather than panicking
+	leaf := &yang.Entry{Name: "leaf", Kind: yang.LeafEntry, Type: &yang.YangType{Name: "bad", Kind: yang.Ynone}}
+	root := &yang.Entry{Name: "device", Kind: yang.DirectoryEntry, Dir: map[string]*yang.Entry{"leaf": leaf}}
+	leaf.Parent = root
+	_, _, err = Extract(map[string]*yang.Entry{"Device": root})
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateDelta applies the changes, updates and deletes, to the base configuration given in
+// JSON and validates the result like Validate, but only where the changes may have an effect:
+// the containers and list entries holding the changed nodes are checked against the schema,
+// and the must and when statements, references and lists are evaluated as per
+// navigator.WithChanges. The whole base configuration is still unmarshalled, and the whole
+// tree annotated and walked by the navigator, so that the cost of a change remains linear in
+// the size of the base configuration; only the schema validation of the untouched nodes and
+// the evaluation of the statements the changes cannot affect are saved. The base
+// configuration is expected to be valid, the violations it already has elsewhere are not
+// reported. The changes which cannot be applied, e.g. a value of the wrong type, are reported
+// as schema violations. The schema is the one of the model, with an empty root.
+func ValidateDelta(schema *ytypes.Schema, base []byte, changes []*configapi.PathValue, opts ...ygot.ValidationOption) ([]*Violation, error) {
+	device, ok := schema.Root.(ygot.ValidatedGoStruct)
+	if !ok {
+		return nil, fmt.Errorf("the root %T of the schema cannot be validated", schema.Root)
+	}
+	if len(base) > 0 {
+		if err := schema.Unmarshal(base, device); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal the base configuration: %w", err)
+		}
+	}
+	root := schema.RootSchema()
+
+	violations := make([]*Violation, 0)
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		if err := applyChange(root, device, change); err != nil {
+			// The errors of ytypes are statuses
+			violations = append(violations, &Violation{Kind: KindSchema, Path: change.Path, ErrorMessage: status.Convert(err).Message()})
+			continue
+		}
+		paths = append(paths, change.Path)
+	}
+	for _, holder := range holders(root, device, paths) {
+		violations = append(violations, schemaViolations(root.Name, holder, opts)...)
+	}
+	navigatorViolations, err := walk(root, device, paths)
+	if err != nil {
+		return nil, err
+	}
+	return append(violations, navigatorViolations...), nil
+}
+
+// applyChange sets the value of the change in the device, creating the containers and list
+// entries it needs, or deletes the node of the change
+func applyChange(root *yang.Entry, device ygot.GoStruct, change *configapi.PathValue) error {
+	path, err := ygot.StringToStructuredPath(change.Path)
+	if err != nil {
+		return err
+	}
+	if change.Deleted {
+		return ytypes.DeleteNode(root, device, path)
+	}
+	value, err := gnmiValue(&change.Value)
+	if err != nil {
+		return err
+	}
+	return ytypes.SetNode(root, device, path, value, &ytypes.InitMissingElements{})
+}
+
+// holders returns the containers and list entries of the device which hold the nodes at the
+// given paths, the closest ones which still exist after the changes, once each
+func holders(root *yang.Entry, device ygot.ValidatedGoStruct, paths []string) []ygot.ValidatedGoStruct {
+	found := make(map[string]bool)
+	structs := make([]ygot.ValidatedGoStruct, 0)
+	for _, p := range paths {
+		path, err := ygot.StringToStructuredPath(p)
+		if err != nil {
+			continue
+		}
+		for n := len(path.Elem) - 1; n >= 0; n-- {
+			holderPath := &gnmi.Path{Elem: path.Elem[:n]}
+			nodes, err := ytypes.GetNode(root, device, holderPath)
+			if err != nil || len(nodes) != 1 {
+				continue
+			}
+			holder, ok := nodes[0].Data.(ygot.ValidatedGoStruct)
+			if !ok {
+				continue
+			}
+			if key, err := ygot.PathToString(holderPath); err == nil && !found[key] {
+				found[key] = true
+				structs = append(structs, holder)
+			}
+			break
+		}
+	}
+	return structs
+}
+
+// gnmiValue converts the value of a change in to the gNMI value ygot sets
+func gnmiValue(tv *configapi.TypedValue) (*gnmi.TypedValue, error) {
+	switch tv.Type {
+	case configapi.ValueType_EMPTY:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}}, nil
+	case configapi.ValueType_STRING:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: (*configapi.TypedString)(tv).String()}}, nil
+	case configapi.ValueType_INT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: int64((*configapi.TypedInt)(tv).Int())}}, nil
+	case configapi.ValueType_UINT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: uint64((*configapi.TypedUint)(tv).Uint())}}, nil
+	case configapi.ValueType_BOOL:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: (*configapi.TypedBool)(tv).Bool()}}, nil
+	case configapi.ValueType_DECIMAL:
+		digits, precision := (*configapi.TypedDecimal)(tv).Decimal64()
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{
+			DecimalVal: &gnmi.Decimal64{Digits: digits, Precision: uint32(precision)}}}, nil
+	case configapi.ValueType_FLOAT:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_FloatVal{FloatVal: (*configapi.TypedFloat)(tv).Float32()}}, nil
+	case configapi.ValueType_BYTES:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: (*configapi.TypedBytes)(tv).ByteArray()}}, nil
+	}
+
+	elements := make([]*gnmi.TypedValue, 0)
+	switch tv.Type {
+	case configapi.ValueType_LEAFLIST_STRING:
+		for _, v := range (*configapi.TypedLeafListString)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_INT:
+		values, _ := (*configapi.TypedLeafListInt)(tv).List()
+		for _, v := range values {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_UINT:
+		values, _ := (*configapi.TypedLeafListUint)(tv).List()
+		for _, v := range values {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_BOOL:
+		for _, v := range (*configapi.TypedLeafListBool)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_DECIMAL:
+		values, precision := (*configapi.TypedLeafListDecimal)(tv).List()
+		for _, v := range values {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{
+				DecimalVal: &gnmi.Decimal64{Digits: v, Precision: uint32(precision)}}})
+		}
+	case configapi.ValueType_LEAFLIST_FLOAT:
+		for _, v := range (*configapi.TypedLeafListFloat)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_FloatVal{FloatVal: v}})
+		}
+	case configapi.ValueType_LEAFLIST_BYTES:
+		for _, v := range (*configapi.TypedLeafListBytes)(tv).List() {
+			elements = append(elements, &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: v}})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", tv.Type)
+	}
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{Element: elements}}}, nil
+}
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: onos/config/validation/delta.proto
+
+package validation
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	v2 "github.com/onosproject/onos-api/go/onos/config/v2"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidateConfigDeltaRequest is a change set to be validated against a base configuration
+type ValidateConfigDeltaRequest struct {
+	// json is the base configuration, expected to be valid
+	Json []byte `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	// changes are the updates and deletes applied to the base configuration
+	Changes []*v2.PathValue `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *ValidateConfigDeltaRequest) Reset()         { *m = ValidateConfigDeltaRequest{} }
+func (m *ValidateConfigDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateConfigDeltaRequest) ProtoMessage()    {}
+func (*ValidateConfigDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7343484319a8f9ab, []int{0}
+}
+func (m *ValidateConfigDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateConfigDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateConfigDeltaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateConfigDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateConfigDeltaRequest.Merge(m, src)
+}
+func (m *ValidateConfigDeltaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateConfigDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateConfigDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateConfigDeltaRequest proto.InternalMessageInfo
+
+func (m *ValidateConfigDeltaRequest) GetJson() []byte {
+	if m != nil {
+		return m.Json
+	}
+	return nil
+}
+
+func (m *ValidateConfigDeltaRequest) GetChanges() []*v2.PathValue {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// ValidateConfigDeltaResponse is the outcome of the validation of a valid change set
+type ValidateConfigDeltaResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *ValidateConfigDeltaResponse) Reset()         { *m = ValidateConfigDeltaResponse{} }
+func (m *ValidateConfigDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateConfigDeltaResponse) ProtoMessage()    {}
+func (*ValidateConfigDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7343484319a8f9ab, []int{1}
+}
+func (m *ValidateConfigDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateConfigDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateConfigDeltaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateConfigDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateConfigDeltaResponse.Merge(m, src)
+}
+func (m *ValidateConfigDeltaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateConfigDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateConfigDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateConfigDeltaResponse proto.InternalMessageInfo
+
+func (m *ValidateConfigDeltaResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ValidateConfigDeltaRequest)(nil), "onos.config.validation.ValidateConfigDeltaRequest")
+	proto.RegisterType((*ValidateConfigDeltaResponse)(nil), "onos.config.validation.ValidateConfigDeltaResponse")
+}
+
+func init() {
+	proto.RegisterFile("onos/config/validation/delta.proto", fileDescriptor_7343484319a8f9ab)
+}
+
+var fileDescriptor_7343484319a8f9ab = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x6b, 0xfe, 0x65, 0x98, 0x0c, 0xaa, 0x4a, 0x90, 0xac, 0x2a, 0x53, 0x17, 0x1c, 0x29,
+	0x79, 0x03, 0x60, 0x45, 0x42, 0x41, 0xca, 0xc0, 0x96, 0x26, 0x97, 0x24, 0x25, 0xf5, 0x0d, 0xb1,
+	0xd3, 0x91, 0x67, 0x60, 0xe4, 0x91, 0x18, 0x3b, 0x32, 0xa2, 0xe4, 0x45, 0x50, 0x6c, 0xfe, 0x2a,
+	0x85, 0x81, 0xcd, 0x96, 0xbe, 0x73, 0xce, 0xbd, 0xf7, 0x50, 0x17, 0x25, 0x2a, 0x2f, 0x41, 0x79,
+	0x5f, 0x64, 0xde, 0x2a, 0x2e, 0x8b, 0x34, 0xd6, 0x05, 0x4a, 0x2f, 0x85, 0x52, 0xc7, 0xa2, 0xaa,
+	0x51, 0x23, 0x1b, 0xf7, 0x8c, 0xb0, 0x8c, 0xf8, 0x61, 0x1c, 0x67, 0x43, 0xeb, 0xf7, 0xf2, 0x06,
+	0xac, 0xc6, 0x05, 0xea, 0x44, 0x96, 0x84, 0x4b, 0x03, 0x5c, 0xf5, 0x86, 0x21, 0x3c, 0x36, 0xa0,
+	0x34, 0x63, 0x74, 0x67, 0xa1, 0x50, 0x4e, 0xc8, 0x94, 0xcc, 0x8e, 0x42, 0xf3, 0x66, 0x01, 0xdd,
+	0x4f, 0xf2, 0x58, 0x66, 0xa0, 0x26, 0x5b, 0xd3, 0xed, 0xd9, 0xa1, 0x7f, 0x2a, 0x36, 0x72, 0x7d,
+	0x71, 0x13, 0xeb, 0x3c, 0xea, 0x33, 0xc2, 0x2f, 0xd2, 0x0d, 0xe8, 0xd9, 0x60, 0x8c, 0xaa, 0x50,
+	0x2a, 0x60, 0x27, 0x74, 0xd7, 0xcc, 0x6b, 0x82, 0x0e, 0x42, 0xfb, 0xf1, 0x5f, 0x08, 0x1d, 0x1b,
+	0x2e, 0xfa, 0xde, 0xe5, 0x16, 0xea, 0x55, 0x91, 0x00, 0x7b, 0xa2, 0xc7, 0x03, 0x7e, 0xcc, 0x17,
+	0xc3, 0x27, 0x10, 0x7f, 0xef, 0xe8, 0x04, 0xff, 0xd2, 0xd8, 0x81, 0x2f, 0xae, 0x5f, 0x5b, 0x4e,
+	0xd6, 0x2d, 0x27, 0xef, 0x2d, 0x27, 0xcf, 0x1d, 0x1f, 0xad, 0x3b, 0x3e, 0x7a, 0xeb, 0xf8, 0xe8,
+	0x2e, 0xc8, 0x0a, 0x9d, 0x37, 0x73, 0x91, 0xe0, 0xd2, 0xeb, 0x8d, 0xab, 0x1a, 0x17, 0x90, 0xe8,
+	0xcf, 0xf3, 0x9f, 0x2f, 0x31, 0x85, 0x52, 0x79, 0xd5, 0xc3, 0xef, 0x16, 0xe7, 0x7b, 0xa6, 0x8c,
+	0xe0, 0x63, 0x00, 0xe7, 0x9c, 0xb8, 0x8d, 0xe6, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DeltaValidationServiceClient is the client API for DeltaValidationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DeltaValidationServiceClient interface {
+	// ValidateConfigDelta validates the changes to a base configuration, as per ValidateDelta; the
+	// violations are returned in the details of the error status, as per Status
+	ValidateConfigDelta(ctx context.Context, in *ValidateConfigDeltaRequest, opts ...grpc.CallOption) (*ValidateConfigDeltaResponse, error)
+}
+
+type deltaValidationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDeltaValidationServiceClient(cc *grpc.ClientConn) DeltaValidationServiceClient {
+	return &deltaValidationServiceClient{cc}
+}
+
+func (c *deltaValidationServiceClient) ValidateConfigDelta(ctx context.Context, in *ValidateConfigDeltaRequest, opts ...grpc.CallOption) (*ValidateConfigDeltaResponse, error) {
+	out := new(ValidateConfigDeltaResponse)
+	err := c.cc.Invoke(ctx, "/onos.config.validation.DeltaValidationService/ValidateConfigDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeltaValidationServiceServer is the server API for DeltaValidationService service.
+type DeltaValidationServiceServer interface {
+	// ValidateConfigDelta validates the changes to a base configuration, as per ValidateDelta; the
+	// violations are returned in the details of the error status, as per Status
+	ValidateConfigDelta(context.Context, *ValidateConfigDeltaRequest) (*ValidateConfigDeltaResponse, error)
+}
+
+// UnimplementedDeltaValidationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDeltaValidationServiceServer struct {
+}
+
+func (*UnimplementedDeltaValidationServiceServer) ValidateConfigDelta(ctx context.Context, req *ValidateConfigDeltaRequest) (*ValidateConfigDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfigDelta not implemented")
+}
+
+func RegisterDeltaValidationServiceServer(s *grpc.Server, srv DeltaValidationServiceServer) {
+	s.RegisterService(&_DeltaValidationService_serviceDesc, srv)
+}
+
+func _DeltaValidationService_ValidateConfigDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeltaValidationServiceServer).ValidateConfigDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.config.validation.DeltaValidationService/ValidateConfigDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeltaValidationServiceServer).ValidateConfigDelta(ctx, req.(*ValidateConfigDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DeltaValidationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "onos.config.validation.DeltaValidationService",
+	HandlerType: (*DeltaValidationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateConfigDelta",
+			Handler:    _DeltaValidationService_ValidateConfigDelta_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "onos/config/validation/delta.proto",
+}
+
+func (m *ValidateConfigDeltaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateConfigDeltaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateConfigDeltaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelta(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintDelta(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateConfigDeltaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateConfigDeltaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateConfigDeltaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelta(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelta(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidateConfigDeltaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovDelta(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovDelta(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateConfigDeltaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func sovDelta(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelta(x uint64) (n int) {
+	return sovDelta(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidateConfigDeltaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateConfigDeltaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateConfigDeltaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelta
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = append(m.Json[:0], dAtA[iNdEx:postIndex]...)
+			if m.Json == nil {
+				m.Json = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &v2.PathValue{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateConfigDeltaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelta
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateConfigDeltaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateConfigDeltaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelta(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelta
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelta(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelta
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelta
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelta
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelta
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelta
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelta
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelta        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelta          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelta = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// deviceYtypesSchema returns the schema of an empty device, whose base configuration is
+// unmarshalled by field name
+func deviceYtypesSchema() *ytypes.Schema {
+	return &ytypes.Schema{
+		Root:       &device{},
+		SchemaTree: map[string]*yang.Entry{"device": deviceSchema()},
+		Unmarshal: func(b []byte, s ygot.GoStruct, _ ...ytypes.UnmarshalOpt) error {
+			return json.Unmarshal(b, s)
+		},
+	}
+}
+
+func Test_ValidateDelta(t *testing.T) {
+	base := []byte(`{"Cont1a": {"Leaf1a": 1, "Leaf1b": "b", "Leaf1c": "b"}}`)
+	tests := []struct {
+		name     string
+		changes  []*configapi.PathValue
+		expected []*Violation
+	}{
+		{
+			name:    "must and when depending on the change",
+			changes: []*configapi.PathValue{{Path: "/cont1a/leaf1a", Value: *configapi.NewTypedValueInt(10, configapi.WidthSixtyFour)}},
+			expected: []*Violation{
+				{Kind: KindMust, Path: "/cont1a", Expression: "number(./leaf1a) < 5", ErrorMessage: "leaf1a is too large", ErrorAppTag: "leaf1a-too-large"},
+				{Kind: KindWhen, Path: "/cont1a/leaf1b", Expression: "../leaf1a < 5", ErrorMessage: "the node is present while its when statement is false"},
+			},
+		},
+		{
+			name:    "dangling leafref",
+			changes: []*configapi.PathValue{{Path: "/cont1a/leaf1c", Value: *configapi.NewTypedValueString("c")}},
+			expected: []*Violation{
+				{Kind: KindReference, Path: "/cont1a/leaf1c", ErrorMessage: "the value c is missing from ../leaf1b", ErrorAppTag: "instance-required", Target: "../leaf1b"},
+			},
+		},
+		{
+			name:    "deleted leafref target",
+			changes: []*configapi.PathValue{{Path: "/cont1a/leaf1b", Deleted: true}},
+			expected: []*Violation{
+				{Kind: KindReference, Path: "/cont1a/leaf1c", ErrorMessage: "the value b is missing from ../leaf1b", ErrorAppTag: "instance-required", Target: "../leaf1b"},
+			},
+		},
+		{
+			name:    "too many elements",
+			changes: []*configapi.PathValue{{Path: "/cont1a/leaf1d", Value: *configapi.NewLeafListIntTv([]int64{1, 2}, configapi.WidthSixteen)}},
+			expected: []*Violation{
+				{Kind: KindList, Path: "/cont1a/leaf1d", Expression: "max-elements 1", ErrorMessage: "2 element(s), more than 1", ErrorAppTag: "too-many-elements"},
+			},
+		},
+		{
+			name:    "value of the wrong type",
+			changes: []*configapi.PathValue{{Path: "/cont1a/leaf1a", Value: *configapi.NewTypedValueString("ten")}},
+			expected: []*Violation{
+				{Kind: KindSchema, Path: "/cont1a/leaf1a", ErrorMessage: "failed to update struct field Leaf1a in *validation.device_Cont1a with value string_val:\"ten\"; failed to unmarshal &{ten} into int64"},
+			},
+		},
+		{
+			name:     "valid change",
+			changes:  []*configapi.PathValue{{Path: "/cont1a/leaf1a", Value: *configapi.NewTypedValueInt(2, configapi.WidthSixtyFour)}},
+			expected: []*Violation{},
+		},
+	}
+	for _, test := range tests {
+		violations, err := ValidateDelta(deviceYtypesSchema(), base, test.changes)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, violations, test.name)
+	}
+
+	// The violations of the base configuration which do not depend on the changes are not
+	// reported
+	invalidBase := []byte(`{"Cont1a": {"Leaf1a": 10, "Leaf1c": "b"}}`)
+	violations, err := ValidateDelta(deviceYtypesSchema(), invalidBase, []*configapi.PathValue{
+		{Path: "/cont1a/leaf1d", Value: *configapi.NewLeafListIntTv([]int64{1}, configapi.WidthSixteen)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*Violation{
+		{Kind: KindMust, Path: "/cont1a", Expression: "number(./leaf1a) < 5", ErrorMessage: "leaf1a is too large", ErrorAppTag: "leaf1a-too-large"},
+	}, violations)
+
+	_, err = ValidateDelta(deviceYtypesSchema(), []byte("{"), nil)
+	assert.Error(t, err)
+}
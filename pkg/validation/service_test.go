@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"context"
+	configapi "github.com/onosproject/onos-api/go/onos/config/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+type deltaServer struct {
+	UnimplementedDeltaValidationServiceServer
+}
+
+func (s *deltaServer) ValidateConfigDelta(ctx context.Context, request *ValidateConfigDeltaRequest) (*ValidateConfigDeltaResponse, error) {
+	violations, err := ValidateDelta(deviceYtypesSchema(), request.Json, request.Changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		return nil, Status(violations).Err()
+	}
+	return &ValidateConfigDeltaResponse{Valid: true}, nil
+}
+
+func Test_DeltaValidationService(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterDeltaValidationServiceServer(s, &deltaServer{})
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+	client := NewDeltaValidationServiceClient(conn)
+
+	base := []byte(`{"Cont1a": {"Leaf1a": 1, "Leaf1b": "b", "Leaf1c": "b"}}`)
+	response, err := client.ValidateConfigDelta(context.Background(), &ValidateConfigDeltaRequest{
+		Json:    base,
+		Changes: []*configapi.PathValue{{Path: "/cont1a/leaf1a", Value: *configapi.NewTypedValueInt(2, configapi.WidthSixtyFour)}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, response.Valid)
+
+	_, err = client.ValidateConfigDelta(context.Background(), &ValidateConfigDeltaRequest{
+		Json: base,
+		Changes: []*configapi.PathValue{
+			{Path: "/cont1a/leaf1b", Deleted: true},
+			{Path: "/cont1a/leaf1d", Value: *configapi.NewLeafListIntTv([]int64{1, 2}, configapi.WidthSixteen)},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, []*Violation{
+		{Kind: KindReference, Path: "/cont1a/leaf1c", ErrorMessage: "the value b is missing from ../leaf1b", ErrorAppTag: "instance-required", Target: "../leaf1b"},
+		{Kind: KindList, Path: "/cont1a/leaf1d", Expression: "max-elements 1", ErrorMessage: "2 element(s), more than 1", ErrorAppTag: "too-many-elements"},
+	}, Violations(err))
+}
+
+func Test_ValidateConfigDeltaRequest(t *testing.T) {
+	request := &ValidateConfigDeltaRequest{
+		Json: []byte(`{}`),
+		Changes: []*configapi.PathValue{
+			{Path: "/a/b", Value: *configapi.NewTypedValueString("c")},
+			{Path: "/a/d", Deleted: true},
+		},
+	}
+	data, err := request.Marshal()
+	assert.NoError(t, err)
+	decoded := &ValidateConfigDeltaRequest{}
+	assert.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, request, decoded)
+
+	assert.Error(t, decoded.Unmarshal([]byte{0x0a, 0x05}))
+}
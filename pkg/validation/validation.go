@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation collects every schema, must, when, reference and list violation of a configuration,
+// and carries them in the details of a gRPC status
+package validation
+
+import (
+	"fmt"
+	"github.com/onosproject/config-models/pkg/xpath/navigator"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"strings"
+)
+
+// Kinds of violations
+const (
+	// KindSchema is a violation of the schema: a type, range, length or pattern
+	KindSchema = "schema"
+	// KindMust is a must statement which is false
+	KindMust = "must"
+	// KindWhen is a node present while a when statement applying to it is false
+	KindWhen = "when"
+	// KindReference is a leafref or instance-identifier whose target does not exist
+	KindReference = "reference"
+	// KindList is a list or leaf-list breaking its min-elements, max-elements or unique
+	// statement
+	KindList = "list"
+)
+
+// whenErrorMessage is the description of the when violations
+const whenErrorMessage = "the node is present while its when statement is false"
+
+// Domain is the domain of the ErrorInfo details describing the violations in a status
+const Domain = "config-models.onosproject.org"
+
+// Error application tags of RFC 7950 section 15
+const (
+	appTagTooManyElements  = "too-many-elements"
+	appTagTooFewElements   = "too-few-elements"
+	appTagInstanceRequired = "instance-required"
+	appTagDataNotUnique    = "data-not-unique"
+)
+
+// elementsErrorRegex matches the min-elements and max-elements errors of ygot, which are
+// reported as list violations instead
+var elementsErrorRegex = regexp.MustCompile(`contains (more than max|fewer than min) allowed elements`)
+
+// Keys of the ErrorInfo metadata of a violation
+const (
+	metaKind         = "kind"
+	metaPath         = "path"
+	metaExpression   = "expression"
+	metaErrorMessage = "error-message"
+	metaErrorAppTag  = "error-app-tag"
+	metaTarget       = "target"
+)
+
+// Violation is a part of a configuration which is not valid
+type Violation struct {
+	// Kind is one of KindSchema, KindMust, KindWhen, KindReference or KindList
+	Kind string
+	// Path is the path of the offending data, e.g. /cont1a/list2a[name=l2a1]; it is the
+	// schema path, without keys, of the schema violations not located in the data
+	Path string
+	// Expression is the must or when statement which is false, or the min-elements,
+	// max-elements or unique statement which is broken, e.g. max-elements 4
+	Expression string
+	// ErrorMessage is the error-message of the must statement, or the description of the
+	// schema, when, reference or list violation
+	ErrorMessage string
+	// ErrorAppTag is the error-app-tag of the must statement, or the tag RFC 7950 gives to
+	// the schema violation, if any
+	ErrorAppTag string
+	// Target is the path of the leafref, or the instance-identifier, of a reference violation
+	Target string
+}
+
+func (v *Violation) Error() string {
+	msg := v.ErrorMessage
+	if v.Path != "" {
+		msg = fmt.Sprintf("%s: %s", v.Path, msg)
+	}
+	if v.Expression != "" {
+		msg = fmt.Sprintf("%s (%s '%s')", msg, v.Kind, v.Expression)
+	}
+	return msg
+}
+
+// Validate checks the device against the schema, then evaluates the must and when
+// statements of root, resolves its references and checks the number of elements and the
+// unique statements of its lists, returning all the violations rather than the first one. The error is only returned when the validation itself fails, e.g. on a
+// must statement which does not compile.
+func Validate(root *yang.Entry, device ygot.ValidatedGoStruct, opts ...ygot.ValidationOption) ([]*Violation, error) {
+	violations := schemaViolations(root.Name, device, opts)
+	navigatorViolations, err := walk(root, device, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(violations, navigatorViolations...), nil
+}
+
+// schemaViolations checks the device, or one of its containers or list entries, against the
+// schema
+func schemaViolations(fakeRoot string, s ygot.ValidatedGoStruct, opts []ygot.ValidationOption) []*Violation {
+	violations := make([]*Violation, 0)
+	// The leafrefs are resolved by the navigator, which honours require-instance and reports
+	// the target of the dangling ones
+	opts = append(opts, &ytypes.LeafrefOptions{IgnoreMissingData: true})
+	if err := s.Validate(opts...); err != nil {
+		for _, schemaErr := range flatten(err) {
+			if elementsErrorRegex.MatchString(schemaErr.Error()) {
+				continue
+			}
+			violations = append(violations, schemaViolation(fakeRoot, schemaErr.Error()))
+		}
+	}
+	return violations
+}
+
+// walk evaluates the must and when statements of root, resolves its references and checks its
+// lists, only where the changes made at the given data paths may have an effect unless they
+// are nil
+func walk(root *yang.Entry, device ygot.ValidatedGoStruct, changes []string) ([]*Violation, error) {
+	nn, ok := navigator.NewYangNodeNavigator(root, device, true).(*navigator.YangNodeNavigator)
+	if !ok {
+		return nil, fmt.Errorf("cannot cast NodeNavigator to YangNodeNavigator")
+	}
+	if changes != nil {
+		if err := nn.WithChanges(changes...); err != nil {
+			return nil, err
+		}
+	}
+	violations := make([]*Violation, 0)
+	mustViolations, err := nn.Copy().(*navigator.YangNodeNavigator).WalkAndCollectMust()
+	if err != nil {
+		return nil, err
+	}
+	for _, mv := range mustViolations {
+		violations = append(violations, &Violation{
+			Kind:         KindMust,
+			Path:         mv.Path,
+			Expression:   mv.Expression,
+			ErrorMessage: mv.ErrorMessage,
+			ErrorAppTag:  mv.ErrorAppTag,
+		})
+	}
+	whenViolations, err := nn.Copy().(*navigator.YangNodeNavigator).WalkAndCollectWhen()
+	if err != nil {
+		return nil, err
+	}
+	for _, wv := range whenViolations {
+		violations = append(violations, &Violation{
+			Kind:         KindWhen,
+			Path:         wv.Path,
+			Expression:   wv.Expression,
+			ErrorMessage: whenErrorMessage,
+		})
+	}
+	referenceViolations, err := nn.Copy().(*navigator.YangNodeNavigator).WalkAndCollectReferences()
+	if err != nil {
+		return nil, err
+	}
+	for _, rv := range referenceViolations {
+		violation := &Violation{
+			Kind:         KindReference,
+			Path:         rv.Path,
+			ErrorMessage: fmt.Sprintf("the value %s is missing from %s", rv.Value, rv.Target),
+			ErrorAppTag:  appTagInstanceRequired,
+			Target:       rv.Target,
+		}
+		if rv.InstanceIdentifier {
+			violation.ErrorMessage = fmt.Sprintf("%s does not exist", rv.Target)
+		}
+		violations = append(violations, violation)
+	}
+	listViolations, err := nn.Copy().(*navigator.YangNodeNavigator).WalkAndCollectLists()
+	if err != nil {
+		return nil, err
+	}
+	for _, lv := range listViolations {
+		violations = append(violations, listViolation(lv))
+	}
+	return violations, nil
+}
+
+// flatten returns the errors held in the util.Errors err, however deep
+func flatten(err error) []error {
+	errs, ok := err.(util.Errors)
+	if !ok {
+		return []error{err}
+	}
+	flat := make([]error, 0, len(errs))
+	for _, e := range errs {
+		flat = append(flat, flatten(e)...)
+	}
+	return flat
+}
+
+// schemaViolation parses the message of a ygot validation error, such as
+// "/device/cont1a: /device/cont1a/list5: schema "key2": unsigned integer value 1 is outside
+// specified ranges", in to the path of the data, without the fake root, and the description
+func schemaViolation(fakeRoot string, msg string) *Violation {
+	var path string
+	for strings.HasPrefix(msg, "/") {
+		i := strings.Index(msg, ": ")
+		if i < 0 {
+			break
+		}
+		path, msg = msg[:i], msg[i+2:]
+	}
+	if strings.HasPrefix(msg, `schema "`) {
+		if i := strings.Index(msg, `": `); i > 0 {
+			path = path + "/" + msg[len(`schema "`):i]
+			msg = msg[i+3:]
+		}
+	} else if i := strings.Index(msg, "schema path /"); i >= 0 {
+		// e.g. field name Fkey1 value six (string ptr) schema path /device/cont1a/list4/list4a/fkey1 has leafref path ...
+		path = strings.Fields(msg[i+len("schema path "):])[0]
+	}
+	if strings.HasPrefix(path, "/"+fakeRoot+"/") {
+		path = strings.TrimPrefix(path, "/"+fakeRoot)
+	}
+
+	violation := &Violation{Kind: KindSchema, Path: path, ErrorMessage: msg}
+	if strings.Contains(msg, "leafref path") {
+		violation.ErrorAppTag = appTagInstanceRequired
+	}
+	return violation
+}
+
+// listViolation describes the list or leaf-list breaking a min-elements, max-elements or
+// unique statement, with the entries which are not unique
+func listViolation(lv *navigator.ListViolation) *Violation {
+	violation := &Violation{
+		Kind:       KindList,
+		Path:       lv.Path,
+		Expression: fmt.Sprintf("%s %s", lv.Statement, lv.Argument),
+	}
+	switch lv.Statement {
+	case "min-elements":
+		violation.ErrorMessage = fmt.Sprintf("%d element(s), fewer than %s", lv.Count, lv.Argument)
+		violation.ErrorAppTag = appTagTooFewElements
+	case "max-elements":
+		violation.ErrorMessage = fmt.Sprintf("%d element(s), more than %s", lv.Count, lv.Argument)
+		violation.ErrorAppTag = appTagTooManyElements
+	default:
+		violation.ErrorMessage = fmt.Sprintf("the entries %s are not unique", strings.Join(lv.Entries, ", "))
+		violation.ErrorAppTag = appTagDataNotUnique
+	}
+	return violation
+}
+
+// Status returns an InvalidArgument status carrying the violations in its details: a
+// BadRequest with a field violation per violation, for the generic clients, and an
+// ErrorInfo per violation, of the Domain, holding its path, expression, error-message,
+// error-app-tag and target in its metadata
+func Status(violations []*Violation) *status.Status {
+	if len(violations) == 0 {
+		return status.New(codes.OK, "")
+	}
+	msgs := make([]string, 0, len(violations))
+	badRequest := &errdetails.BadRequest{}
+	infos := make([]*errdetails.ErrorInfo, 0, len(violations))
+	for _, v := range violations {
+		msgs = append(msgs, v.Error())
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Path,
+			Description: v.Error(),
+		})
+		infos = append(infos, &errdetails.ErrorInfo{
+			Reason: strings.ToUpper(v.Kind) + "_VIOLATION",
+			Domain: Domain,
+			Metadata: map[string]string{
+				metaKind:         v.Kind,
+				metaPath:         v.Path,
+				metaExpression:   v.Expression,
+				metaErrorMessage: v.ErrorMessage,
+				metaErrorAppTag:  v.ErrorAppTag,
+				metaTarget:       v.Target,
+			},
+		})
+	}
+
+	st, err := status.New(codes.InvalidArgument, fmt.Sprintf("%d validation error(s): %s", len(violations), strings.Join(msgs, "; "))).
+		WithDetails(badRequest)
+	if err != nil {
+		return status.New(codes.InvalidArgument, strings.Join(msgs, "; "))
+	}
+	for _, info := range infos {
+		if withInfo, err := st.WithDetails(info); err == nil {
+			st = withInfo
+		}
+	}
+	return st
+}
+
+// Violations returns the violations carried in the details of the status of err, as
+// returned by Status, or nil if there are none
+func Violations(err error) []*Violation {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+	var violations []*Violation
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != Domain {
+			continue
+		}
+		violations = append(violations, &Violation{
+			Kind:         info.Metadata[metaKind],
+			Path:         info.Metadata[metaPath],
+			Expression:   info.Metadata[metaExpression],
+			ErrorMessage: info.Metadata[metaErrorMessage],
+			ErrorAppTag:  info.Metadata[metaErrorAppTag],
+			Target:       info.Metadata[metaTarget],
+		})
+	}
+	return violations
+}
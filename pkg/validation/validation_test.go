@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+// device is a fake root holding a container whose leaf is checked by a must statement, with
+// another leaf depending on a when statement, a leafref to it and a leaf-list of at most one
+// element, and whose schema errors are given
+type device struct {
+	Cont1a *device_Cont1a `path:"cont1a"`
+	errs   util.Errors
+}
+
+type device_Cont1a struct {
+	Leaf1a *int64  `path:"leaf1a"`
+	Leaf1b *string `path:"leaf1b"`
+	Leaf1c *string `path:"leaf1c"`
+	Leaf1d []int16 `path:"leaf1d"`
+}
+
+func (d *device) Validate(...ygot.ValidationOption) error {
+	if len(d.errs) == 0 {
+		return nil
+	}
+	return d.errs
+}
+
+func (*device) IsYANGGoStruct()                         {}
+func (*device) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*device) ΛBelongingModule() string                { return "" }
+
+func deviceSchema() *yang.Entry {
+	root := &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"cont1a": {
+				Name: "cont1a",
+				Kind: yang.DirectoryEntry,
+				Extra: map[string][]interface{}{"must": {map[string]interface{}{
+					"Name":         "number(./leaf1a) < 5",
+					"ErrorMessage": map[string]interface{}{"Name": "leaf1a is too large"},
+					"ErrorAppTag":  map[string]interface{}{"Name": "leaf1a-too-large"},
+				}}},
+				Dir: map[string]*yang.Entry{
+					"leaf1a": {Name: "leaf1a", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Yint64}},
+					"leaf1b": {Name: "leaf1b", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Ystring}, Extra: map[string][]interface{}{"when": {
+						map[string]interface{}{"Name": "../leaf1a < 5"},
+					}}},
+					"leaf1c": {Name: "leaf1c", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Yleafref, Path: "../leaf1b"}},
+					"leaf1d": {Name: "leaf1d", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.Yint16}, ListAttr: &yang.ListAttr{MaxElements: 1}},
+				},
+			},
+		},
+	}
+	// The parents are needed by ygot to resolve the leafrefs when setting them
+	cont1a := root.Dir["cont1a"]
+	cont1a.Parent = root
+	for _, leaf := range cont1a.Dir {
+		leaf.Parent = cont1a
+	}
+	return root
+}
+
+func Test_schemaViolation(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected *Violation
+	}{
+		{
+			msg: "/device/cont1a: /device/cont1a/list5: schema \"key2\": unsigned integer value 1 is outside specified ranges",
+			expected: &Violation{Kind: KindSchema, Path: "/cont1a/list5/key2",
+				ErrorMessage: "unsigned integer value 1 is outside specified ranges"},
+		},
+		{
+			msg: "field name Fkey1 value six (string ptr) schema path /device/cont1a/list4/list4a/fkey1 has leafref path /cont1a/list5/key1 not equal to any target nodes",
+			expected: &Violation{Kind: KindSchema, Path: "/cont1a/list4/list4a/fkey1", ErrorAppTag: "instance-required",
+				ErrorMessage: "field name Fkey1 value six (string ptr) schema path /device/cont1a/list4/list4a/fkey1 has leafref path /cont1a/list5/key1 not equal to any target nodes"},
+		},
+		{
+			msg:      "something unexpected",
+			expected: &Violation{Kind: KindSchema, ErrorMessage: "something unexpected"},
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, schemaViolation("device", test.msg), test.msg)
+	}
+}
+
+func Test_Validate(t *testing.T) {
+	leaf1a := int64(10)
+	leaf1b := "b"
+	leaf1c := "c"
+	d := &device{
+		Cont1a: &device_Cont1a{Leaf1a: &leaf1a, Leaf1b: &leaf1b, Leaf1c: &leaf1c, Leaf1d: []int16{1, 2}},
+		errs: util.Errors{
+			fmt.Errorf("/device/cont1a: schema \"leaf1a\": signed integer value 10 is outside specified ranges"),
+			// Reported by the navigator rather than by ygot
+			util.Errors{fmt.Errorf("/device/cont1a: /device/cont1a/leaf1d: list leaf1d contains more than max allowed elements: 2 > 1")},
+		},
+	}
+	violations, err := Validate(deviceSchema(), d)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Violation{
+		{Kind: KindSchema, Path: "/cont1a/leaf1a", ErrorMessage: "signed integer value 10 is outside specified ranges"},
+		{Kind: KindMust, Path: "/cont1a", Expression: "number(./leaf1a) < 5", ErrorMessage: "leaf1a is too large", ErrorAppTag: "leaf1a-too-large"},
+		{Kind: KindWhen, Path: "/cont1a/leaf1b", Expression: "../leaf1a < 5", ErrorMessage: "the node is present while its when statement is false"},
+		{Kind: KindReference, Path: "/cont1a/leaf1c", ErrorMessage: "the value c is missing from ../leaf1b", ErrorAppTag: "instance-required", Target: "../leaf1b"},
+		{Kind: KindList, Path: "/cont1a/leaf1d", Expression: "max-elements 1", ErrorMessage: "2 element(s), more than 1", ErrorAppTag: "too-many-elements"},
+	}, violations)
+
+	leaf1a = 1
+	violations, err = Validate(deviceSchema(), &device{Cont1a: &device_Cont1a{Leaf1a: &leaf1a, Leaf1b: &leaf1b, Leaf1c: &leaf1b}})
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func Test_Status(t *testing.T) {
+	violations := []*Violation{
+		{Kind: KindList, Path: "/cont1a/list2a", Expression: "max-elements 4", ErrorMessage: "6 element(s), more than 4", ErrorAppTag: "too-many-elements"},
+		{Kind: KindMust, Path: "/cont1a", Expression: "number(./leaf1a) < 5", ErrorMessage: "leaf1a is too large", ErrorAppTag: "must-violation"},
+		{Kind: KindReference, Path: "/cont1a/leaf1c", ErrorMessage: "the value c is missing from ../leaf1b", ErrorAppTag: "instance-required", Target: "../leaf1b"},
+	}
+	err := Status(violations).Err()
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "3 validation error(s): /cont1a/list2a: 6 element(s), more than 4 (list 'max-elements 4'); "+
+		"/cont1a: leaf1a is too large (must 'number(./leaf1a) < 5'); /cont1a/leaf1c: the value c is missing from ../leaf1b", st.Message())
+	// The BadRequest, then an ErrorInfo per violation
+	assert.Len(t, st.Details(), 4)
+	assert.Equal(t, violations, Violations(err))
+
+	assert.NoError(t, Status(nil).Err())
+	assert.Nil(t, Violations(fmt.Errorf("not a status")))
+}
@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"github.com/SeanCondon/xpath"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ygot"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 )
@@ -37,6 +39,9 @@ type XpathEvaluate struct {
 type YangNodeNavigator struct {
 	root, curr, this *yang.Entry
 	ignoreNamespace  bool
+	// changes are the data paths given with WithChanges, or nil to walk all the nodes
+	changes      []*gnmi.Path
+	changedNames map[string]bool
 }
 
 var log = logging.GetLogger("config-model", "navigator")
@@ -68,6 +73,9 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 	if ok {
 		dir.Annotation["must"] = extractMust(mustStmnt)
 	}
+	if whens := extractWhen(dir); len(whens) > 0 {
+		dir.Annotation["when"] = whens
+	}
 
 	// Create a new entry per list index
 	if dir.IsList() {
@@ -114,6 +122,8 @@ func addGoStructToYangEntry(dir *yang.Entry, yangStruct interface{}) map[string]
 	}
 	childMap := make(map[string]*yang.Entry)
 	for k, v := range dir.Dir {
+		// The when statements of the parent apply to the child
+		v.Parent = dir
 		structVal := reflect.ValueOf(yangStruct)
 		switch structVal.Kind() {
 		case reflect.Ptr:
@@ -196,6 +206,60 @@ func extractMust(mustStmnt []interface{}) *yang.Must {
 	return mustStruct
 }
 
+// whenCondition is a when statement applying to a node. The when statements of the augment
+// and uses statements apply to the nodes they add, but are evaluated with the parent of the
+// nodes, i.e. the augmented node or the node holding the uses statement, as context node.
+type whenCondition struct {
+	expression    string
+	parentContext bool
+}
+
+// extractWhen - gathers the when statements of the entry, crammed in to the Extra field like
+// the must statements, along with those of the augment and uses statements adding it to its
+// parent
+func extractWhen(dir *yang.Entry) []*whenCondition {
+	whens := make([]*whenCondition, 0)
+	for _, expr := range extraNames(dir.Extra["when"]) {
+		whens = append(whens, &whenCondition{expression: expr})
+	}
+	if dir.Parent == nil {
+		return whens
+	}
+	for _, augment := range dir.Parent.Augmented {
+		if _, ok := augment.Dir[dir.Name]; ok {
+			for _, expr := range extraNames(augment.Extra["when"]) {
+				whens = append(whens, &whenCondition{expression: expr, parentContext: true})
+			}
+		}
+	}
+	for _, uses := range dir.Parent.Uses {
+		if uses.Uses == nil || uses.Uses.When == nil || uses.Grouping == nil {
+			continue
+		}
+		if _, ok := uses.Grouping.Dir[dir.Name]; ok {
+			whens = append(whens, &whenCondition{expression: uses.Uses.When.Name, parentContext: true})
+		}
+	}
+	return whens
+}
+
+// extraNames returns the arguments of the statements of an Extra field, which are maps once
+// the schema is unmarshalled
+func extraNames(stmts []interface{}) []string {
+	names := make([]string, 0, len(stmts))
+	for _, s := range stmts {
+		switch v := s.(type) {
+		case map[string]interface{}:
+			if name, ok := v["Name"].(string); ok {
+				names = append(names, name)
+			}
+		case *yang.Value:
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
+
 func deepCopyDir(dir *yang.Entry) *yang.Entry {
 	newDir := &yang.Entry{
 		Parent:      dir.Parent,
@@ -247,9 +311,657 @@ func deepCopyDir(dir *yang.Entry) *yang.Entry {
 	return newDir
 }
 
+// defaultMustErrorAppTag is the error-app-tag of the must statements which have none, as
+// per RFC 7950 section 15.2
+const defaultMustErrorAppTag = "must-violation"
+
+// MustViolation is a must statement which is false for a node of the configuration
+type MustViolation struct {
+	// Path is the path of the node, with the keys of the list entries
+	Path         string
+	Expression   string
+	ErrorMessage string
+	ErrorAppTag  string
+	// Items are the attributes, or else the children, of the node, as name=value
+	Items []string
+}
+
+func (v *MustViolation) Error() string {
+	return fmt.Sprintf("%s. Must statement '%v' to true. Container(s): %v",
+		v.ErrorMessage, v.Expression, v.Items)
+}
+
 // WalkAndValidateMust - walk through the YNN and validate any Must statements
 // This goes down first and then across
 func (x *YangNodeNavigator) WalkAndValidateMust() error {
+	var first error
+	err := x.walkMust(func(violation *MustViolation) bool {
+		first = violation
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return first
+}
+
+// WalkAndCollectMust - walk through the YNN like WalkAndValidateMust, but rather than
+// stopping at the first Must statement which is false return all of them
+func (x *YangNodeNavigator) WalkAndCollectMust() ([]*MustViolation, error) {
+	violations := make([]*MustViolation, 0)
+	err := x.walkMust(func(violation *MustViolation) bool {
+		violations = append(violations, violation)
+		return true
+	})
+	return violations, err
+}
+
+// walkMust - walk through the YNN and report the Must statements which are false, until
+// report returns false
+func (x *YangNodeNavigator) walkMust(report func(violation *MustViolation) bool) error {
+	return x.walk(func() (bool, error) {
+		mustIf, ok := x.curr.Annotation["must"]
+		if !ok {
+			return true, nil
+		}
+		mustStruct, okMustStruct := mustIf.(*yang.Must)
+		if !okMustStruct {
+			return true, nil
+		}
+		if !x.affected(mustStruct.Name) {
+			return true, nil
+		}
+		mustExpr, err := xpath.Compile(mustStruct.Name)
+		if err != nil {
+			return false, err
+		}
+		x1 := x.Copy().(*YangNodeNavigator)
+		result := mustExpr.Evaluate(x1)
+		resultBool, resultOk := result.(bool)
+		if !resultOk {
+			return false, fmt.Errorf("result of %s cannot be evaluated as bool %v",
+				mustExpr.String(), result)
+		}
+		log.Debugf("Checking Must rule %s: %v", mustExpr.String(), resultBool)
+		if resultBool {
+			return true, nil
+		}
+		items := x1.generateMustError("@*")
+		if len(items) == 0 {
+			items = x1.generateMustError("*")
+		}
+		violation := &MustViolation{
+			Path:        dataPath(x.curr),
+			Expression:  mustStruct.Name,
+			ErrorAppTag: defaultMustErrorAppTag,
+			Items:       items,
+		}
+		if mustStruct.ErrorMessage != nil {
+			violation.ErrorMessage = mustStruct.ErrorMessage.Name
+		}
+		if mustStruct.ErrorAppTag != nil {
+			violation.ErrorAppTag = mustStruct.ErrorAppTag.Name
+		}
+		return report(violation), nil
+	})
+}
+
+// WhenViolation is a node of the configuration which is present while a when statement
+// applying to it is false
+type WhenViolation struct {
+	// Path is the path of the node, with the keys of the list entries
+	Path       string
+	Expression string
+}
+
+func (v *WhenViolation) Error() string {
+	return fmt.Sprintf("%s is present while its when statement '%s' is false", v.Path, v.Expression)
+}
+
+// WalkAndValidateWhen - walk through the YNN and validate the When statements of the
+// nodes present in the configuration, returning the first one which is false
+func (x *YangNodeNavigator) WalkAndValidateWhen() error {
+	var first error
+	err := x.walkWhen(func(violation *WhenViolation) bool {
+		first = violation
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return first
+}
+
+// WalkAndCollectWhen - walk through the YNN like WalkAndValidateWhen, but return all the
+// When statements which are false
+func (x *YangNodeNavigator) WalkAndCollectWhen() ([]*WhenViolation, error) {
+	violations := make([]*WhenViolation, 0)
+	err := x.walkWhen(func(violation *WhenViolation) bool {
+		violations = append(violations, violation)
+		return true
+	})
+	return violations, err
+}
+
+// walkWhen - walk through the YNN and report the When statements which are false, until
+// report returns false
+func (x *YangNodeNavigator) walkWhen(report func(violation *WhenViolation) bool) error {
+	return x.walk(func() (bool, error) {
+		whens, ok := x.curr.Annotation["when"].([]*whenCondition)
+		if !ok {
+			return true, nil
+		}
+		for _, when := range whens {
+			if !x.affected(when.expression) {
+				continue
+			}
+			// As per RFC 7950 section 7.21.5 the result is converted with boolean()
+			whenExpr, err := xpath.Compile(fmt.Sprintf("boolean(%s)", when.expression))
+			if err != nil {
+				return false, err
+			}
+			x1 := x.Copy().(*YangNodeNavigator)
+			if when.parentContext {
+				x1.MoveToParent()
+			}
+			resultBool, resultOk := whenExpr.Evaluate(x1).(bool)
+			if !resultOk {
+				return false, fmt.Errorf("result of %s cannot be evaluated as bool", when.expression)
+			}
+			log.Debugf("Checking When rule %s: %v", when.expression, resultBool)
+			if !resultBool && !report(&WhenViolation{Path: dataPath(x.curr), Expression: when.expression}) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// ReferenceViolation is a leafref or instance-identifier of the configuration whose
+// target does not exist
+type ReferenceViolation struct {
+	// Path is the path of the referring node, with the keys of the list entries
+	Path string
+	// Target is the path of the leafref, or the instance-identifier
+	Target string
+	// Value is the value of the referring node
+	Value string
+	// InstanceIdentifier tells if the referring node is an instance-identifier rather than
+	// a leafref
+	InstanceIdentifier bool
+}
+
+func (v *ReferenceViolation) Error() string {
+	if v.InstanceIdentifier {
+		return fmt.Sprintf("%s refers to %s which does not exist", v.Path, v.Target)
+	}
+	return fmt.Sprintf("%s refers to %s which has no value %s", v.Path, v.Target, v.Value)
+}
+
+// WalkAndValidateReferences - walk through the YNN and check that the targets of the leafrefs,
+// unless their instances are not required, and of the instance-identifiers exist, returning
+// the first one which does not
+func (x *YangNodeNavigator) WalkAndValidateReferences() error {
+	var first error
+	err := x.walkReferences(func(violation *ReferenceViolation) bool {
+		first = violation
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return first
+}
+
+// WalkAndCollectReferences - walk through the YNN like WalkAndValidateReferences, but return
+// all the dangling references
+func (x *YangNodeNavigator) WalkAndCollectReferences() ([]*ReferenceViolation, error) {
+	violations := make([]*ReferenceViolation, 0)
+	err := x.walkReferences(func(violation *ReferenceViolation) bool {
+		violations = append(violations, violation)
+		return true
+	})
+	return violations, err
+}
+
+// walkReferences - walk through the YNN and report the leafrefs and instance-identifiers whose
+// target does not exist, until report returns false
+func (x *YangNodeNavigator) walkReferences(report func(violation *ReferenceViolation) bool) error {
+	return x.walk(func() (bool, error) {
+		if x.curr.Type == nil || x.curr.Type.OptionalInstance || !(x.curr.IsLeaf() || x.curr.IsLeafList()) {
+			return true, nil
+		}
+		var target string
+		switch x.curr.Type.Kind {
+		case yang.Yleafref:
+			target = x.leafrefQuery(x.curr.Type.Path)
+		case yang.YinstanceIdentifier:
+			if x.curr.IsLeaf() {
+				target = x.withoutPrefixes(instanceIdentifierQuery(x.Value()))
+			}
+		}
+		if target == "" || !x.affected(target) {
+			return true, nil
+		}
+		targetExpr, err := xpath.Compile(target)
+		if err != nil {
+			return false, fmt.Errorf("unable to compile the reference %s of %s: %w", target, dataPath(x.curr), err)
+		}
+		if x.curr.Type.Kind == yang.Yleafref {
+			target = x.curr.Type.Path
+		}
+		targetValues := make(map[string]bool)
+		targetIter := targetExpr.Select(x.Copy())
+		for targetIter.MoveNext() {
+			targetValues[targetIter.Current().Value()] = true
+		}
+		instanceIdentifier := x.curr.Type.Kind == yang.YinstanceIdentifier
+		for _, value := range x.referenceValues() {
+			found := targetValues[value]
+			if instanceIdentifier {
+				// The instance-identifier is the path of its target
+				found, target = len(targetValues) > 0, value
+			}
+			log.Debugf("Checking reference %s to %s: %v", value, target, found)
+			violation := &ReferenceViolation{Path: dataPath(x.curr), Target: target, Value: value, InstanceIdentifier: instanceIdentifier}
+			if !found && !report(violation) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// referenceValues returns the value of the current leaf, or the values of the current
+// leaf-list
+func (x *YangNodeNavigator) referenceValues() []string {
+	if x.curr.IsLeaf() {
+		return []string{x.Value()}
+	}
+	values := make([]string, 0)
+	list := reflect.ValueOf(x.curr.Annotation[goStruct])
+	if list.Kind() != reflect.Slice {
+		return values
+	}
+	for i := 0; i < list.Len(); i++ {
+		values = append(values, fmt.Sprint(reflect.Indirect(list.Index(i)).Interface()))
+	}
+	return values
+}
+
+// keyPredicateRegex matches the key predicates of an instance-identifier, e.g. [name='l2a1'],
+// or of the path of a leafref
+var keyPredicateRegex = regexp.MustCompile(`\[\s*([A-Za-z_][\w.:-]*)\s*=`)
+
+// instanceIdentifierQuery returns the XPath query selecting the node an instance-identifier
+// refers to; the keys of the lists are attributes for the navigator
+func instanceIdentifierQuery(instanceIdentifier string) string {
+	return keyPredicateRegex.ReplaceAllString(instanceIdentifier, "[@$1=")
+}
+
+// leafrefQuery returns the XPath query selecting the nodes the path of the leafref of the
+// current node refers to. The steps and the predicates referring to the keys of the lists
+// are turned in to attributes, and current(), the origin of the query, in to $this.
+func (x *YangNodeNavigator) leafrefQuery(path string) string {
+	entry := x.curr
+	if strings.HasPrefix(path, "/") {
+		entry = x.root
+	}
+	steps := splitSteps(path)
+	for i, step := range steps {
+		if entry == nil {
+			break
+		}
+		name := step
+		if j := strings.Index(name, "["); j >= 0 {
+			name = name[:j]
+		}
+		name = strings.TrimSpace(name)
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			entry = entry.Parent
+			continue
+		}
+		if j := strings.Index(name, ":"); j >= 0 {
+			name = name[j+1:]
+		}
+		child := entry.Dir[name]
+		if child != nil && entry.IsList() && isKey(entry, name) {
+			steps[i] = "@" + strings.TrimSpace(step)
+		}
+		entry = child
+	}
+	query := keyPredicateRegex.ReplaceAllString(strings.Join(steps, "/"), "[@$1=")
+	return x.withoutPrefixes(strings.ReplaceAll(query, "current()", "$this"))
+}
+
+// prefixRegex matches the prefixes of the node names of a path
+var prefixRegex = regexp.MustCompile(`[A-Za-z_][\w.-]*:([A-Za-z_])`)
+
+// withoutPrefixes removes the prefixes of the node names of the query if the navigator
+// ignores the namespaces, as the nodes would not match them otherwise
+func (x *YangNodeNavigator) withoutPrefixes(query string) string {
+	if !x.ignoreNamespace {
+		return query
+	}
+	return prefixRegex.ReplaceAllString(query, "$1")
+}
+
+// isKey tells if name is one of the keys of the list
+func isKey(list *yang.Entry, name string) bool {
+	for _, key := range strings.Fields(list.Key) {
+		if key == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitSteps splits the path in to its steps, the slashes of the predicates excepted
+func splitSteps(path string) []string {
+	steps := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range path {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				steps = append(steps, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(steps, path[start:])
+}
+
+// ListViolation is a list or leaf-list of the configuration breaking its min-elements,
+// max-elements or unique statement
+type ListViolation struct {
+	// Path is the path of the list or leaf-list, with the keys of the list entries holding it
+	Path string
+	// Statement is the statement which is broken: min-elements, max-elements or unique
+	Statement string
+	// Argument is the argument of the statement, e.g. 4, or vlan-id for a unique statement
+	Argument string
+	// Count is the number of elements of the list or leaf-list
+	Count int
+	// Entries are the paths of the entries sharing the values of a unique statement, or of
+	// the entries of a list having more than max-elements
+	Entries []string
+}
+
+func (v *ListViolation) Error() string {
+	switch v.Statement {
+	case "min-elements":
+		return fmt.Sprintf("%s has %d element(s), fewer than min-elements %s", v.Path, v.Count, v.Argument)
+	case "max-elements":
+		return fmt.Sprintf("%s has %d element(s), more than max-elements %s", v.Path, v.Count, v.Argument)
+	}
+	return fmt.Sprintf("%s entries %v have the same values of unique '%s'", v.Path, v.Entries, v.Argument)
+}
+
+// WalkAndValidateLists - walk through the YNN and check the min-elements, max-elements and
+// unique statements of the lists and leaf-lists of the containers and list entries present
+// in the configuration, returning the first one which is broken
+func (x *YangNodeNavigator) WalkAndValidateLists() error {
+	var first error
+	err := x.walkLists(func(violation *ListViolation) bool {
+		first = violation
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return first
+}
+
+// WalkAndCollectLists - walk through the YNN like WalkAndValidateLists, but return all the
+// broken statements
+func (x *YangNodeNavigator) WalkAndCollectLists() ([]*ListViolation, error) {
+	violations := make([]*ListViolation, 0)
+	err := x.walkLists(func(violation *ListViolation) bool {
+		violations = append(violations, violation)
+		return true
+	})
+	return violations, err
+}
+
+// walkLists - walk through the YNN, from the root included, and report the broken
+// min-elements, max-elements and unique statements until report returns false
+func (x *YangNodeNavigator) walkLists(report func(violation *ListViolation) bool) error {
+	if !checkLists(x.curr, report) {
+		return nil
+	}
+	return x.walk(func() (bool, error) {
+		if x.curr.IsLeaf() || x.curr.IsLeafList() || !x.related(x.curr) {
+			return true, nil
+		}
+		return checkLists(x.curr, report), nil
+	})
+}
+
+// checkLists reports the broken statements of the lists and leaf-lists of the container or
+// list entry, returning false as soon as report does
+func checkLists(parent *yang.Entry, report func(violation *ListViolation) bool) bool {
+	for _, name := range sortedSchemaDir(parent) {
+		child := parent.Dir[name]
+		if child.ListAttr == nil {
+			continue
+		}
+		path := dataPath(parent) + "/" + name
+		if parent.Parent == nil {
+			path = "/" + name
+		}
+		entries := listEntries(parent, child)
+		count := len(entries)
+		if values := reflect.ValueOf(getGoStruct(child.Annotation)); child.IsLeafList() && values.Kind() == reflect.Slice {
+			count = values.Len()
+		}
+		if count < int(child.ListAttr.MinElements) {
+			if !report(&ListViolation{Path: path, Statement: "min-elements",
+				Argument: fmt.Sprint(child.ListAttr.MinElements), Count: count}) {
+				return false
+			}
+		}
+		if child.ListAttr.MaxElements > 0 && uint64(count) > child.ListAttr.MaxElements {
+			violation := &ListViolation{Path: path, Statement: "max-elements",
+				Argument: fmt.Sprint(child.ListAttr.MaxElements), Count: count}
+			for _, entry := range entries {
+				violation.Entries = append(violation.Entries, dataPath(entry))
+			}
+			if !report(violation) {
+				return false
+			}
+		}
+		for _, unique := range extraNames(child.Extra["unique"]) {
+			for _, conflict := range uniqueConflicts(entries, strings.Fields(unique)) {
+				if !report(&ListViolation{Path: path, Statement: "unique", Argument: unique,
+					Count: count, Entries: conflict}) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// sortedSchemaDir returns the names of the children of the entry in its schema, i.e. without
+// the entries of its lists
+func sortedSchemaDir(entry *yang.Entry) []string {
+	names := make([]string, 0, len(entry.Dir))
+	for name := range entry.Dir {
+		if !strings.Contains(name, "__") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// listEntries returns the entries of the list present in the container or list entry,
+// sorted by key
+func listEntries(parent *yang.Entry, list *yang.Entry) []*yang.Entry {
+	if !list.IsList() {
+		return nil
+	}
+	names := make([]string, 0)
+	for name, entry := range parent.Dir {
+		if strings.HasPrefix(name, list.Name+"__") && getGoStruct(entry.Annotation) != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	entries := make([]*yang.Entry, 0, len(names))
+	for _, name := range names {
+		entries = append(entries, parent.Dir[name])
+	}
+	return entries
+}
+
+// uniqueConflicts returns the paths of the entries sharing the values of the leaves of a
+// unique statement, by group of entries; the entries lacking one of the leaves are ignored
+// as per RFC 7950 section 7.8.3
+func uniqueConflicts(entries []*yang.Entry, leaves []string) [][]string {
+	groups := make(map[string][]string)
+	order := make([]string, 0)
+	for _, entry := range entries {
+		values := make([]string, 0, len(leaves))
+		for _, leaf := range leaves {
+			node := entry
+			for _, step := range strings.Split(leaf, "/") {
+				if j := strings.Index(step, ":"); j >= 0 {
+					step = step[j+1:]
+				}
+				if node = node.Dir[step]; node == nil || getGoStruct(node.Annotation) == nil {
+					break
+				}
+			}
+			if node == nil || getGoStruct(node.Annotation) == nil {
+				values = nil
+				break
+			}
+			values = append(values, (&YangNodeNavigator{curr: node}).Value())
+		}
+		if values == nil {
+			continue
+		}
+		key := strings.Join(values, "\x00")
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], dataPath(entry))
+	}
+	conflicts := make([][]string, 0)
+	for _, key := range order {
+		if len(groups[key]) > 1 {
+			conflicts = append(conflicts, groups[key])
+		}
+	}
+	return conflicts
+}
+
+// WithChanges restricts the walks of the YNN to the nodes which the changes made at the
+// given data paths, e.g. /cont1a/list2a[name=l2a1]/tx-power, may affect, so that a change set
+// is validated without evaluating all the statements of the configuration. A must or when
+// statement, or a reference, is evaluated when its node is, holds or is held by a changed
+// node, or when its expression refers outside of its node and names a changed node or one
+// of their ancestors; such expressions with a wildcard are always evaluated. The lists are checked in the containers
+// and list entries which are, hold or are held by a changed node.
+func (x *YangNodeNavigator) WithChanges(paths ...string) error {
+	changes := make([]*gnmi.Path, 0, len(paths))
+	changedNames := make(map[string]bool)
+	for _, path := range paths {
+		changed, err := ygot.StringToStructuredPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid change path %s: %w", path, err)
+		}
+		for _, elem := range changed.Elem {
+			elem.Name = localName(elem.Name)
+			changedNames[elem.Name] = true
+		}
+		changes = append(changes, changed)
+	}
+	x.changes, x.changedNames = changes, changedNames
+	return nil
+}
+
+// nameRegex matches the names of the nodes in an XPath expression, with their prefix
+var nameRegex = regexp.MustCompile(`[A-Za-z_][\w.-]*(:[A-Za-z_][\w.-]*)?`)
+
+// outsideRegex matches the XPath expressions which may refer outside of their node: with a
+// parent step, an absolute path or a deref()
+var outsideRegex = regexp.MustCompile(`\.\.|(^|[\s(\[,=<>!+|])/|deref\(`)
+
+// affected tells if the statement of the current node, whose expression is given, may be
+// affected by the changes given with WithChanges, if any
+func (x *YangNodeNavigator) affected(expression string) bool {
+	if x.changes == nil || x.related(x.curr) {
+		return true
+	}
+	if !outsideRegex.MatchString(expression) {
+		return false
+	}
+	if strings.Contains(expression, "*") || strings.Contains(expression, "//") {
+		return true
+	}
+	for _, name := range nameRegex.FindAllString(expression, -1) {
+		if x.changedNames[localName(name)] {
+			return true
+		}
+	}
+	return false
+}
+
+// related tells if the entry is a node changed as per WithChanges, holds one or is held by
+// one; all the entries are when no changes are given
+func (x *YangNodeNavigator) related(entry *yang.Entry) bool {
+	if x.changes == nil {
+		return true
+	}
+	path, err := ygot.StringToStructuredPath(dataPath(entry))
+	if err != nil {
+		return true
+	}
+	for _, changed := range x.changes {
+		if samePrefix(path.Elem, changed.Elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// samePrefix tells if the path elements a and b are the same up to the shortest of them; a
+// key missing from one of them, e.g. when a whole list is changed, matches any value
+func samePrefix(a []*gnmi.PathElem, b []*gnmi.PathElem) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if localName(a[i].Name) != localName(b[i].Name) {
+			return false
+		}
+		for key, value := range a[i].Key {
+			if other, ok := b[i].Key[key]; ok && other != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// localName returns the name without its prefix
+func localName(name string) string {
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// walk - walk through the YNN, down first and then across, calling visit on each node
+// until it returns false
+func (x *YangNodeNavigator) walk(visit func() (bool, error)) error {
 	for {
 		if x.MoveToChild() ||
 			x.MoveToNext() ||
@@ -258,33 +970,8 @@ func (x *YangNodeNavigator) WalkAndValidateMust() error {
 			(x.MoveToParent() && x.MoveToNext()) ||
 			(x.MoveToParent() && x.MoveToNext()) ||
 			(x.MoveToParent() && x.MoveToNext()) {
-
-			mustIf, ok := x.curr.Annotation["must"]
-			if ok {
-				mustStruct, okMustStruct := mustIf.(*yang.Must)
-				if okMustStruct {
-					mustExpr, err := xpath.Compile(mustStruct.Name)
-					if err != nil {
-						return err
-					}
-					x1 := x.Copy().(*YangNodeNavigator)
-					result := mustExpr.Evaluate(x1)
-					resultBool, resultOk := result.(bool)
-					if !resultOk {
-						return fmt.Errorf("result of %s cannot be evaluated as bool %v",
-							mustExpr.String(), result)
-					}
-					if !resultBool {
-						items := x1.generateMustError("@*")
-						if len(items) == 0 {
-							items = x1.generateMustError("*")
-						}
-						return fmt.Errorf("%s. Must statement '%v' to true. Container(s): %v",
-							mustStruct.ErrorMessage.Name,
-							mustStruct.Name, items)
-					}
-					log.Infof("Checking Must rule %s: %v", mustExpr.String(), resultBool)
-				}
+			if next, err := visit(); err != nil || !next {
+				return err
 			}
 			continue
 		}
@@ -292,6 +979,27 @@ func (x *YangNodeNavigator) WalkAndValidateMust() error {
 	}
 }
 
+// dataPath returns the path of the node of the configuration an entry stands for, the
+// list entries being identified by their keys
+func dataPath(entry *yang.Entry) string {
+	elems := make([]string, 0)
+	for e := entry; e != nil && e.Parent != nil; e = e.Parent {
+		elem := e.Name
+		if e.IsList() {
+			for _, key := range strings.Fields(e.Key) {
+				keyEntry, ok := e.Dir[key]
+				if !ok || getGoStruct(keyEntry.Annotation) == nil {
+					continue
+				}
+				keyNav := &YangNodeNavigator{curr: keyEntry}
+				elem = fmt.Sprintf("%s[%s=%s]", elem, key, keyNav.Value())
+			}
+		}
+		elems = append([]string{elem}, elems...)
+	}
+	return "/" + strings.Join(elems, "/")
+}
+
 func (x *YangNodeNavigator) generateMustError(expr string) []string {
 	currentExpr, currentErr := xpath.Compile(expr)
 	if currentErr != nil {
@@ -407,6 +1115,8 @@ func (x *YangNodeNavigator) Copy() xpath.NodeNavigator {
 		curr:            x.curr,
 		this:            x.this,
 		ignoreNamespace: x.ignoreNamespace,
+		changes:         x.changes,
+		changedNames:    x.changedNames,
 	}
 
 	return &ynnCopy
@@ -6,9 +6,11 @@ package navigator
 
 import (
 	"github.com/SeanCondon/xpath"
+	"github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ygot"
 	"github.com/stretchr/testify/assert"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -347,3 +349,351 @@ func Test_generateMustError(t *testing.T) {
 	assert.Equal(t, "a=test1", parts[0])
 	assert.Equal(t, "b=10", parts[1])
 }
+
+type testDevice_item struct {
+	Name *string `path:"name"`
+	V    *int    `path:"v"`
+}
+
+// mustTestEntry returns the schema of a testDevice whose testStruct holds a list a, keyed by
+// name, with must statements on testStruct and on the entries of a
+func mustTestEntry() *yang.Entry {
+	must := func(expr string, extra map[string]interface{}) []interface{} {
+		stmt := map[string]interface{}{"Name": expr}
+		for k, v := range extra {
+			stmt[k] = v
+		}
+		return []interface{}{stmt}
+	}
+	return &yang.Entry{
+		Name: "testDevice",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"testStruct": {
+				Name: "testStruct",
+				Kind: yang.DirectoryEntry,
+				Extra: map[string][]interface{}{"must": must("number(./b) < 5", map[string]interface{}{
+					"ErrorMessage": map[string]interface{}{"Name": "b is too large"},
+					"ErrorAppTag":  map[string]interface{}{"Name": "b-too-large"},
+				})},
+				Dir: map[string]*yang.Entry{
+					"a": {
+						Name:     "a",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: &yang.ListAttr{},
+						Key:      "name",
+						Extra: map[string][]interface{}{"must": must("number(./v) < 10", map[string]interface{}{
+							"ErrorMessage": map[string]interface{}{"Name": "v is too large"},
+						})},
+						Dir: map[string]*yang.Entry{
+							"name": {Name: "name", Kind: yang.LeafEntry},
+							"v":    {Name: "v", Kind: yang.LeafEntry},
+						},
+					},
+					"b": {Name: "b", Kind: yang.LeafEntry},
+				},
+			},
+		},
+	}
+}
+
+func mustTestDevice() *testDevice {
+	items := make(map[string]*testDevice_item)
+	for name, v := range map[string]int{"one": 5, "two": 12, "three": 20} {
+		name, v := name, v
+		items[name] = &testDevice_item{Name: &name, V: &v}
+	}
+	b := 10
+	return &testDevice{TestStruct: &testDevice_testStruct{A: items, B: &b}}
+}
+
+func Test_WalkAndCollectMust(t *testing.T) {
+	nn := NewYangNodeNavigator(mustTestEntry(), mustTestDevice(), false)
+	violations, err := nn.(*YangNodeNavigator).WalkAndCollectMust()
+	assert.NoError(t, err)
+	assert.Equal(t, []*MustViolation{
+		{Path: "/testStruct", Expression: "number(./b) < 5", ErrorMessage: "b is too large", ErrorAppTag: "b-too-large", Items: []string{"a=value of a", "a=value of a", "a=value of a", "b=10"}},
+		{Path: "/testStruct/a[name=three]", Expression: "number(./v) < 10", ErrorMessage: "v is too large", ErrorAppTag: "must-violation", Items: []string{"name=three"}},
+		{Path: "/testStruct/a[name=two]", Expression: "number(./v) < 10", ErrorMessage: "v is too large", ErrorAppTag: "must-violation", Items: []string{"name=two"}},
+	}, violations)
+
+	// Only the first violation is returned when validating
+	nn = NewYangNodeNavigator(mustTestEntry(), mustTestDevice(), false)
+	err = nn.(*YangNodeNavigator).WalkAndValidateMust()
+	assert.EqualError(t, err, "b is too large. Must statement 'number(./b) < 5' to true. Container(s): [a=value of a a=value of a a=value of a b=10]")
+}
+
+func Test_WalkAndCollectWhen(t *testing.T) {
+	when := func(expr string) map[string][]interface{} {
+		return map[string][]interface{}{"when": {map[string]interface{}{"Name": expr}}}
+	}
+	entry := &yang.Entry{
+		Name: "testDevice",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"testStruct": {
+				Name: "testStruct",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"a": {
+						Name:     "a",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: &yang.ListAttr{},
+						Key:      "name",
+						Dir: map[string]*yang.Entry{
+							"name": {Name: "name", Kind: yang.LeafEntry},
+							"v":    {Name: "v", Kind: yang.LeafEntry},
+						},
+					},
+					"b": {Name: "b", Kind: yang.LeafEntry},
+					"c": {Name: "c", Kind: yang.LeafEntry, Extra: when("../b > 5")},
+					"d": {Name: "d", Kind: yang.LeafEntry, Extra: when("../b < 5")},
+				},
+				// The nodes added by augment and uses statements are evaluated with their parent
+				Augmented: []*yang.Entry{{
+					Name:  "augment",
+					Extra: when("b = 3"),
+					Dir:   map[string]*yang.Entry{"c": {Name: "c"}},
+				}},
+				Uses: []*yang.UsesStmt{{
+					Uses:     &yang.Uses{Name: "g", When: &yang.Value{Name: "b = 4"}},
+					Grouping: &yang.Entry{Name: "g", Dir: map[string]*yang.Entry{"a": {Name: "a"}}},
+				}},
+			},
+		},
+	}
+	items := make(map[string]*testDevice_item)
+	for _, name := range []string{"one", "two"} {
+		name := name
+		items[name] = &testDevice_item{Name: &name}
+	}
+	b := 10
+	c := "c"
+	d := true
+	device := &testDevice{TestStruct: &testDevice_testStruct{A: items, B: &b, C: &c, D: &d}}
+
+	nn := NewYangNodeNavigator(entry, device, false)
+	violations, err := nn.(*YangNodeNavigator).WalkAndCollectWhen()
+	assert.NoError(t, err)
+	assert.Equal(t, []*WhenViolation{
+		{Path: "/testStruct/a[name=one]", Expression: "b = 4"},
+		{Path: "/testStruct/a[name=two]", Expression: "b = 4"},
+		{Path: "/testStruct/c", Expression: "b = 3"},
+		{Path: "/testStruct/d", Expression: "../b < 5"},
+	}, violations)
+
+	nn = NewYangNodeNavigator(entry, device, false)
+	err = nn.(*YangNodeNavigator).WalkAndValidateWhen()
+	assert.EqualError(t, err, "/testStruct/a[name=one] is present while its when statement 'b = 4' is false")
+
+	// The when statements are true once b is 4, but for c
+	b = 4
+	nn = NewYangNodeNavigator(entry, device, false)
+	violations, err = nn.(*YangNodeNavigator).WalkAndCollectWhen()
+	assert.NoError(t, err)
+	assert.Equal(t, []*WhenViolation{
+		{Path: "/testStruct/c", Expression: "../b > 5"},
+		{Path: "/testStruct/c", Expression: "b = 3"},
+	}, violations)
+}
+
+func Test_WalkAndCollectReferences(t *testing.T) {
+	leafref := &yang.YangType{Kind: yang.Yleafref, Path: "../a/name"}
+	predicateLeafref := &yang.YangType{Kind: yang.Yleafref, Path: "../t1:a[t1:name = current()/../t1:b]/t1:v"}
+	entry := &yang.Entry{
+		Name: "testDevice",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"testStruct": {
+				Name: "testStruct",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"a": {
+						Name:     "a",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: &yang.ListAttr{},
+						Key:      "name",
+						Dir: map[string]*yang.Entry{
+							"name": {Name: "name", Kind: yang.LeafEntry},
+							"v":    {Name: "v", Kind: yang.LeafEntry},
+						},
+					},
+					"b": {Name: "b", Kind: yang.LeafEntry, Type: leafref},
+					"c": {Name: "c", Kind: yang.LeafEntry, Type: predicateLeafref},
+					"d": {Name: "d", Kind: yang.LeafEntry, Type: &yang.YangType{Kind: yang.YinstanceIdentifier}},
+				},
+			},
+		},
+	}
+	items := make(map[string]*testDevice_item)
+	for name, v := range map[string]int{"one": 5, "two": 6} {
+		name, v := name, v
+		items[name] = &testDevice_item{Name: &name, V: &v}
+	}
+	b := "three"
+	c := 5
+	d := "/t1:testStruct/t1:a[t1:name='four']"
+	device := &testDevice{TestStruct: &testDevice_testStruct{A: items, B: &b, C: &c, D: &d}}
+
+	nn := NewYangNodeNavigator(entry, device, true)
+	violations, err := nn.(*YangNodeNavigator).WalkAndCollectReferences()
+	assert.NoError(t, err)
+	assert.Equal(t, []*ReferenceViolation{
+		{Path: "/testStruct/b", Target: "../a/name", Value: "three"},
+		{Path: "/testStruct/c", Target: "../t1:a[t1:name = current()/../t1:b]/t1:v", Value: "5"},
+		{Path: "/testStruct/d", Target: "/t1:testStruct/t1:a[t1:name='four']", Value: "/t1:testStruct/t1:a[t1:name='four']", InstanceIdentifier: true},
+	}, violations)
+
+	nn = NewYangNodeNavigator(entry, device, true)
+	err = nn.(*YangNodeNavigator).WalkAndValidateReferences()
+	assert.EqualError(t, err, "/testStruct/b refers to ../a/name which has no value three")
+
+	// The leafref of b, and the one of c through b, are resolved
+	b = "one"
+	d = "/t1:testStruct/t1:a[t1:name='two']"
+	nn = NewYangNodeNavigator(entry, device, true)
+	violations, err = nn.(*YangNodeNavigator).WalkAndCollectReferences()
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+
+	// The instances are only checked when they are required
+	c = 7
+	nn = NewYangNodeNavigator(entry, device, true)
+	violations, err = nn.(*YangNodeNavigator).WalkAndCollectReferences()
+	assert.NoError(t, err)
+	assert.Len(t, violations, 1)
+	predicateLeafref.OptionalInstance = true
+	nn = NewYangNodeNavigator(entry, device, true)
+	violations, err = nn.(*YangNodeNavigator).WalkAndCollectReferences()
+	assert.NoError(t, err)
+	assert.Empty(t, violations)
+}
+
+func Test_leafrefQuery(t *testing.T) {
+	assert.Equal(t, []string{"", "a", "b[c = current()/../d]", "e"}, splitSteps("/a/b[c = current()/../d]/e"))
+	assert.Equal(t, "/testStruct/a[@name='x']", instanceIdentifierQuery("/testStruct/a[name='x']"))
+}
+
+func Test_WalkAndCollectLists(t *testing.T) {
+	// The navigator adds the list entries to the schema, which is thus built for each walk
+	schema := func() *yang.Entry {
+		return &yang.Entry{
+			Name: "testDevice",
+			Kind: yang.DirectoryEntry,
+			Dir: map[string]*yang.Entry{
+				"testStruct": {
+					Name: "testStruct",
+					Kind: yang.DirectoryEntry,
+					Dir: map[string]*yang.Entry{
+						"a": {
+							Name:     "a",
+							Kind:     yang.DirectoryEntry,
+							ListAttr: &yang.ListAttr{MinElements: 1, MaxElements: 2},
+							Key:      "name",
+							Extra:    map[string][]interface{}{"unique": {map[string]interface{}{"Name": "t1:v"}}},
+							Dir: map[string]*yang.Entry{
+								"name": {Name: "name", Kind: yang.LeafEntry},
+								"v":    {Name: "v", Kind: yang.LeafEntry},
+							},
+						},
+						"c": {Name: "c", Kind: yang.LeafEntry, ListAttr: &yang.ListAttr{MinElements: 2, MaxElements: math.MaxUint64}},
+						"d": {
+							Name:     "d",
+							Kind:     yang.DirectoryEntry,
+							ListAttr: &yang.ListAttr{MinElements: 1, MaxElements: math.MaxUint64},
+							Key:      "name",
+							Dir: map[string]*yang.Entry{
+								"name": {Name: "name", Kind: yang.LeafEntry},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	items := make(map[string]*testDevice_item)
+	for name, v := range map[string]int{"one": 5, "two": 6, "three": 5} {
+		name, v := name, v
+		items[name] = &testDevice_item{Name: &name, V: &v}
+	}
+	device := &testDevice{TestStruct: &testDevice_testStruct{A: items, C: []string{"x"}}}
+
+	nn := NewYangNodeNavigator(schema(), device, true)
+	violations, err := nn.(*YangNodeNavigator).WalkAndCollectLists()
+	assert.NoError(t, err)
+	assert.Equal(t, []*ListViolation{
+		{Path: "/testStruct/a", Statement: "max-elements", Argument: "2", Count: 3,
+			Entries: []string{"/testStruct/a[name=one]", "/testStruct/a[name=three]", "/testStruct/a[name=two]"}},
+		{Path: "/testStruct/a", Statement: "unique", Argument: "t1:v", Count: 3,
+			Entries: []string{"/testStruct/a[name=one]", "/testStruct/a[name=three]"}},
+		{Path: "/testStruct/c", Statement: "min-elements", Argument: "2", Count: 1},
+		{Path: "/testStruct/d", Statement: "min-elements", Argument: "1", Count: 0},
+	}, violations)
+
+	nn = NewYangNodeNavigator(schema(), device, true)
+	err = nn.(*YangNodeNavigator).WalkAndValidateLists()
+	assert.EqualError(t, err, "/testStruct/a has 3 element(s), more than max-elements 2")
+
+	// The entries lacking the leaves of a unique statement are not compared
+	delete(items, "three")
+	items["two"].V = nil
+	nn = NewYangNodeNavigator(schema(), device, true)
+	violations, err = nn.(*YangNodeNavigator).WalkAndCollectLists()
+	assert.NoError(t, err)
+	assert.Len(t, violations, 2)
+}
+
+func Test_WithChanges(t *testing.T) {
+	collect := func(entry *yang.Entry, device *testDevice, changes ...string) []string {
+		nn := NewYangNodeNavigator(entry, device, true).(*YangNodeNavigator)
+		assert.NoError(t, nn.WithChanges(changes...))
+		paths := make([]string, 0)
+		mustViolations, err := nn.Copy().(*YangNodeNavigator).WalkAndCollectMust()
+		assert.NoError(t, err)
+		for _, v := range mustViolations {
+			paths = append(paths, v.Path)
+		}
+		referenceViolations, err := nn.Copy().(*YangNodeNavigator).WalkAndCollectReferences()
+		assert.NoError(t, err)
+		for _, v := range referenceViolations {
+			paths = append(paths, v.Path)
+		}
+		return paths
+	}
+
+	// The statements of the changed entry of a, and of testStruct holding it, are evaluated
+	assert.Equal(t, []string{"/testStruct", "/testStruct/a[name=two]"},
+		collect(mustTestEntry(), mustTestDevice(), "/testStruct/a[name=two]/v"))
+	assert.Equal(t, []string{"/testStruct"}, collect(mustTestEntry(), mustTestDevice(), "/testStruct/a[name=one]/v"))
+	assert.Empty(t, collect(mustTestEntry(), mustTestDevice()))
+
+	// The leafref of b refers outside of b, to the names of the entries of a
+	referencesEntry := func() *yang.Entry {
+		entry := mustTestEntry()
+		entry.Dir["testStruct"].Extra = nil
+		entry.Dir["testStruct"].Dir["a"].Extra = nil
+		entry.Dir["testStruct"].Dir["b"].Type = &yang.YangType{Kind: yang.Yleafref, Path: "../t1:a/t1:name"}
+		return entry
+	}
+	b := "four"
+	device := &testDevice{TestStruct: &testDevice_testStruct{A: mustTestDevice().TestStruct.A, B: &b}}
+	assert.Equal(t, []string{"/testStruct/b"}, collect(referencesEntry(), device, "/testStruct/a[name=one]/name"))
+	assert.Empty(t, collect(referencesEntry(), device, "/testStruct/c"))
+	assert.Equal(t, []string{"/testStruct/b"}, collect(referencesEntry(), device, "/testStruct"))
+
+	nn := NewYangNodeNavigator(mustTestEntry(), mustTestDevice(), true).(*YangNodeNavigator)
+	assert.Error(t, nn.WithChanges("/testStruct/a[name]"))
+}
+
+func Test_samePrefix(t *testing.T) {
+	path := func(p string) []*gnmi.PathElem {
+		parsed, err := ygot.StringToStructuredPath(p)
+		assert.NoError(t, err)
+		return parsed.Elem
+	}
+	assert.True(t, samePrefix(path("/a/b[k=1]/c"), path("/a/b[k=1]")))
+	assert.True(t, samePrefix(path("/a/b"), path("/a/b[k=1]/c")))
+	assert.True(t, samePrefix(path("/"), path("/a")))
+	assert.False(t, samePrefix(path("/a/b[k=1]/c"), path("/a/b[k=2]/c")))
+	assert.False(t, samePrefix(path("/a/b"), path("/a/c")))
+}
@@ -11,6 +11,10 @@ ENV GO111MODULE=on
 COPY go.mod go.sum /models/{{ .Name }}/
 COPY api /models/{{ .Name }}/api
 COPY plugin /models/{{ .Name }}/plugin
+{{- if .ConfigModelsDir }}
+# config-models is replaced by the repository, vendored by make mod-update
+COPY vendor /models/{{ .Name }}/vendor
+{{- end }}
 RUN cd /models/{{ .Name }} && go build -o _bin/{{ .Name }} ./plugin
 
 FROM alpine:3.14
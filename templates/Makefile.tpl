@@ -52,10 +52,6 @@ image: mod-update # @HELP Build the docker image (available parameters: DOCKER_R
 	docker build ${PLATFORM} $(DOCKER_BUILD_ARGS) -t ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${VERSION} .
 	docker tag ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${VERSION} ${DOCKER_REPOSITORY}{{ .ArtifactName }}:${LATEST_VERSION}
 
-.PHONY: openapi
-openapi: mod-update # @HELP Generate OpenApi specs
-	go run openapi/openapi-gen.go -o openapi.yaml
-
 {{/* the gNMI client generator is on hold at the moment, disabling it for now */}}
 {{/*.PHONY: gnmi-gen*/}}
 {{/*gnmi-gen: mod-update # @HELP Generate gNMI Client*/}}
@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2022-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import "embed"
+
+//go:embed *.tpl
+var Templates embed.FS
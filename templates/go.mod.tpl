@@ -16,3 +16,7 @@ require (
 	google.golang.org/grpc v1.41.0
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
+{{- with .ConfigModelsDir }}
+
+replace github.com/onosproject/config-models => {{ . }}
+{{- end }}